@@ -0,0 +1,43 @@
+// cmd/goedge/command.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+// Command pairs a topic's action with its own flag set, so each topic can
+// accept options without affecting the others
+type Command struct {
+	Flags  *flag.FlagSet
+	action func()
+}
+
+// NewCommand creates a command named name whose flags are declared by
+// define (which may be nil for a command without options)
+func NewCommand(name string, define func(fs *flag.FlagSet), action func()) *Command {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	if define != nil {
+		define(fs)
+	}
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: go run ./cmd/goedge %s [flags]\n", name)
+		fs.PrintDefaults()
+	}
+	return &Command{Flags: fs, action: action}
+}
+
+// Run parses args with the command's flag set and then runs the action.
+// On -h or -help it prints usage and returns flag.ErrHelp without running.
+func (c *Command) Run(args []string) error {
+	if err := c.Flags.Parse(args); err != nil {
+		return err
+	}
+	if c.Flags.NArg() > 0 {
+		return fmt.Errorf("%s: unexpected arguments: %v", c.Flags.Name(), c.Flags.Args())
+	}
+	c.action()
+	return nil
+}
@@ -0,0 +1,65 @@
+// cmd/goedge/command_test.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestCommandParsesItsOwnFlags(t *testing.T) {
+	var indent int
+	var sorted bool
+	ran := false
+	cmd := NewCommand("json", func(fs *flag.FlagSet) {
+		fs.IntVar(&indent, "indent", 2, "indent")
+		fs.BoolVar(&sorted, "sort-keys", false, "sort")
+	}, func() { ran = true })
+
+	testutil.AssertNoError(t, cmd.Run([]string{"--indent=4", "--sort-keys"}))
+	testutil.AssertEqual(t, indent, 4)
+	testutil.AssertEqual(t, sorted, true)
+	testutil.AssertEqual(t, ran, true)
+
+	// another command does not know json's flags
+	other := NewCommand("maps", nil, func() {})
+	other.Flags.SetOutput(&bytes.Buffer{})
+	if err := other.Run([]string{"--indent=4"}); err == nil {
+		t.Error("maps accepted the json-only --indent flag")
+	}
+	testutil.AssertEqual(t, indent, 4)
+}
+
+func TestCommandHelpDoesNotRun(t *testing.T) {
+	var usage bytes.Buffer
+	ran := false
+	cmd := NewCommand("data", func(fs *flag.FlagSet) {
+		fs.String("format", "text", "output format")
+	}, func() { ran = true })
+	cmd.Flags.SetOutput(&usage)
+
+	testutil.AssertErrorIs(t, cmd.Run([]string{"-h"}), flag.ErrHelp)
+	testutil.AssertEqual(t, ran, false)
+	testutil.AssertContains(t, usage.String(), "Usage: go run ./cmd/goedge data [flags]")
+	testutil.AssertContains(t, usage.String(), "-format")
+}
+
+func TestRegisteredTopicFlags(t *testing.T) {
+	registry := NewRegistry()
+	registerTopics(registry)
+
+	jsonTopic, _ := registry.Lookup("json")
+	cmd := jsonTopic.Command()
+	testutil.AssertNoError(t, cmd.Flags.Parse([]string{"--indent=4", "--sort-keys"}))
+	testutil.AssertEqual(t, cmd.Flags.Lookup("indent").Value.String(), "4")
+
+	// a fresh command for a flagless topic rejects them
+	mapsTopic, _ := registry.Lookup("maps")
+	mapsCmd := mapsTopic.Command()
+	mapsCmd.Flags.SetOutput(&bytes.Buffer{})
+	if err := mapsCmd.Flags.Parse([]string{"--sort-keys"}); err == nil {
+		t.Error("maps accepted --sort-keys")
+	}
+}
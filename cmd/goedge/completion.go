@@ -0,0 +1,81 @@
+// cmd/goedge/completion.go
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// completionShells lists the shells CompletionScript can generate for
+var completionShells = []string{"bash", "zsh"}
+
+// completionWords returns every first-argument word: topic names, their
+// aliases and the built-in commands
+func (r *Registry) completionWords() []string {
+	var words []string
+	for _, topic := range r.topics {
+		words = append(words, topic.Name)
+		words = append(words, topic.Aliases...)
+	}
+	return append(words, "all", "completion")
+}
+
+// CompletionScript renders a completion script for shell built from the
+// registered topics, so it never drifts from what the CLI accepts.
+// Load it with: source <(goedge completion bash)
+func (r *Registry) CompletionScript(shell string) (string, error) {
+	switch shell {
+	case "bash":
+		return r.bashCompletion(), nil
+	case "zsh":
+		return r.zshCompletion(), nil
+	default:
+		return "", fmt.Errorf("unsupported shell %q (want one of: %s)", shell, strings.Join(completionShells, ", "))
+	}
+}
+
+func (r *Registry) bashCompletion() string {
+	var b strings.Builder
+	b.WriteString("# bash completion for goedge\n")
+	b.WriteString("_goedge() {\n")
+	b.WriteString(`    local cur="${COMP_WORDS[COMP_CWORD]}"` + "\n")
+	b.WriteString(`    if [ "$COMP_CWORD" -eq 1 ]; then` + "\n")
+	fmt.Fprintf(&b, "        COMPREPLY=( $(compgen -W %q -- \"$cur\") )\n", strings.Join(r.completionWords(), " "))
+	b.WriteString(`    elif [ "$COMP_CWORD" -eq 2 ] && [ "${COMP_WORDS[1]}" = "completion" ]; then` + "\n")
+	fmt.Fprintf(&b, "        COMPREPLY=( $(compgen -W %q -- \"$cur\") )\n", strings.Join(completionShells, " "))
+	b.WriteString("    fi\n")
+	b.WriteString("}\n")
+	b.WriteString("complete -F _goedge goedge\n")
+	return b.String()
+}
+
+func (r *Registry) zshCompletion() string {
+	// _describe entries are "name:description"; colons in names must be escaped
+	entry := func(name, desc string) string {
+		name = strings.ReplaceAll(name, ":", `\:`)
+		return "'" + strings.ReplaceAll(name+":"+desc, "'", `'\''`) + "'"
+	}
+
+	var b strings.Builder
+	b.WriteString("#compdef goedge\n")
+	b.WriteString("_goedge() {\n")
+	b.WriteString("    local -a topics\n")
+	b.WriteString("    topics=(\n")
+	for _, topic := range r.topics {
+		fmt.Fprintf(&b, "        %s\n", entry(topic.Name, topic.Desc))
+		for _, alias := range topic.Aliases {
+			fmt.Fprintf(&b, "        %s\n", entry(alias, topic.Desc))
+		}
+	}
+	fmt.Fprintf(&b, "        %s\n", entry("all", "Run all examples"))
+	fmt.Fprintf(&b, "        %s\n", entry("completion", "Print a shell completion script"))
+	b.WriteString("    )\n")
+	b.WriteString("    if (( CURRENT == 2 )); then\n")
+	b.WriteString("        _describe 'topic' topics\n")
+	b.WriteString("    elif (( CURRENT == 3 )) && [[ $words[2] == completion ]]; then\n")
+	fmt.Fprintf(&b, "        _values 'shell' %s\n", strings.Join(completionShells, " "))
+	b.WriteString("    fi\n")
+	b.WriteString("}\n")
+	b.WriteString("compdef _goedge goedge\n")
+	return b.String()
+}
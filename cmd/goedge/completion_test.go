@@ -0,0 +1,45 @@
+// cmd/goedge/completion_test.go
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestCompletionScriptsListEveryTopic(t *testing.T) {
+	registry := NewRegistry()
+	registerTopics(registry)
+
+	for _, shell := range completionShells {
+		script, err := registry.CompletionScript(shell)
+		testutil.AssertNoError(t, err)
+		if strings.TrimSpace(script) == "" {
+			t.Fatalf("%s script is empty", shell)
+		}
+		for _, topic := range registry.Topics() {
+			testutil.AssertContains(t, script, topic.Name)
+			for _, alias := range topic.Aliases {
+				testutil.AssertContains(t, script, alias)
+			}
+		}
+		testutil.AssertContains(t, script, "completion")
+	}
+
+	bash, _ := registry.CompletionScript("bash")
+	testutil.AssertContains(t, bash, "complete -F _goedge goedge")
+	zsh, _ := registry.CompletionScript("zsh")
+	testutil.AssertContains(t, zsh, "#compdef goedge")
+}
+
+func TestCompletionScriptEscaping(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("a:b", "it's quoted", func() {})
+	zsh, err := registry.CompletionScript("zsh")
+	testutil.AssertNoError(t, err)
+	testutil.AssertContains(t, zsh, `'a\:b:it'\''s quoted'`)
+
+	_, err = registry.CompletionScript("fish")
+	testutil.AssertContains(t, err.Error(), `unsupported shell "fish"`)
+}
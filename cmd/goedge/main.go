@@ -2,8 +2,11 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal"
 )
@@ -15,221 +18,201 @@ import (
 //	date    = "unknown"
 //)
 
+// registerTopics is the single place where example topics are wired up
+func registerTopics(r *Registry) {
+	r.Register("pointers", "Pointer examples", internal.RunPointerExamples).
+		WithTitle("🔗", "Pointer")
+	r.Register("functions", "Function examples", internal.RunFunctionExamples).
+		WithTitle("🔧", "Function")
+	r.Register("arrays", "Array & Slice examples", internal.RunArraySliceExamples).
+		WithTitle("📊", "Array & Slice")
+	r.Register("arrays-pro", "Professional Array & Slice examples", internal.RunArraySliceProfessionalExamples).
+		WithTitle("🚀", "Professional Array & Slice").
+		WithAliases("arrays-professional")
+	r.Register("value-reference", "Value vs Reference passing examples", internal.RunValueReferenceExamples).
+		WithTitle("🔄", "Value vs Reference").
+		WithAliases("pass-by-value", "pass-by-reference")
+	r.Register("maps", "Map examples", internal.RunMapExamples).
+		WithTitle("🗺️", "Map")
+	r.Register("defer", "Defer/Panic/Recover examples", internal.RunDeferPanicRecoverExamples).
+		WithTitle("🔄", "Defer/Panic/Recover")
+	r.Register("strings", "String formatting examples", internal.RunStringFormattingExamples).
+		WithTitle("📝", "String Formatting")
+	r.Register("structs", "Structs examples", internal.RunStructureExamples).
+		WithTitle("📦", "Structs")
+	r.Register("methods", "Method examples", internal.RunMethodExamples).
+		WithTitle("📦", "Method")
+	r.Register("interfaces", "Interface examples", internal.RunInterfaceExamples).
+		WithTitle("🔌", "Interface")
+	r.Register("errors", "Errors examples", internal.RunErrorHandlingExamples).
+		WithTitle("🔌", "Errors")
+	r.Register("goroutines", "Goroutine examples", internal.RunGoroutineExamples).
+		WithTitle("🚀", "Goroutine")
+	r.Register("channels", "Channel examples", internal.RunChannelExamples).
+		WithTitle("📺", "Channel")
+	r.Register("packages", "Package System & Imports examples", internal.RunPackageSystemExamples).
+		WithTitle("📦", "Package System")
+	r.Register("embedding", "Embedding & Composition examples", internal.RunEmbeddingCompositionExamples).
+		WithTitle("🧩", "Embedding & Composition")
+	r.Register("reflection", "Reflection examples", internal.RunReflectionExamples).
+		WithTitle("🔍", "Reflection")
+	r.Register("context", "Context Package examples", internal.RunContextExamples).
+		WithTitle("🌐", "Context")
+	jsonOpts := internal.JSONOptions{Indent: 2}
+	r.Register("json", "JSON & Serialization examples", internal.RunJSONSerializationExamples).
+		WithTitle("📋", "JSON & Serialization").
+		WithSetup(func() { internal.SetJSONOptions(jsonOpts) }).
+		WithFlags(func(fs *flag.FlagSet) {
+			fs.IntVar(&jsonOpts.Indent, "indent", jsonOpts.Indent, "spaces per indentation level in printed JSON")
+			fs.BoolVar(&jsonOpts.SortKeys, "sort-keys", false, "sort object keys in printed JSON")
+		})
+	r.Register("fileio", "File I/O & Readers/Writers examples", internal.RunFileIOExamples).
+		WithTitle("📁", "File I/O & Readers/Writers")
+	r.Register("os", "OS Package examples", internal.RunOSExamples).
+		WithTitle("🖥️", "OS Package")
+	r.Register("io", "IO Package examples", internal.RunIOExamples).
+		WithTitle("📄", "IO Package")
+	r.Register("ioutil", "IO/ioutil Package examples", internal.RunIOUtilExamples).
+		WithTitle("📁", "IO/ioutil Package")
+	r.Register("system", "System Interaction examples", internal.RunOSPackageExamples).
+		WithTitle("🖥️", "System Interaction")
+	r.Register("streams", "I/O Streams examples", internal.RunIOPackageExamples).
+		WithTitle("📄", "I/O Streams")
+	renderer := internal.Renderer(internal.TextRenderer{})
+	r.Register("data", "Example datasets rendered as text, table or json", func() {
+		runDatasets(renderer)
+	}).
+		WithTitle("🧾", "Dataset").
+		WithFlags(func(fs *flag.FlagSet) {
+			fs.Func("format", "output format: "+strings.Join(internal.RenderFormats, ", ")+" (default text)", func(format string) error {
+				r, err := internal.NewRenderer(format)
+				if err != nil {
+					return err
+				}
+				renderer = r
+				return nil
+			})
+		})
+	r.Register("colors", "Color examples", internal.ColorExamples).
+		ExcludeFromAll()
+}
+
 func main() {
+	registry := NewRegistry()
+	registerTopics(registry)
+
 	if len(os.Args) < 2 {
-		showHelp()
+		showHelp(registry)
 		return
 	}
 
 	topic := os.Args[1]
 
-	switch topic {
-	//case "version", "-v", "--version":
+	//if topic == "version" || topic == "-v" || topic == "--version" {
 	//	fmt.Printf("GoEdge v%s\n", version)
 	//	fmt.Printf("Commit: %s\n", commit)
 	//	fmt.Printf("Built: %s\n", date)
 	//	return
-	case "pointers":
-		fmt.Println(internal.Header("🔗 Running Pointer Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunPointerExamples()
-	case "functions":
-		fmt.Println(internal.Header("🔧 Running Function Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunFunctionExamples()
-	case "arrays":
-		fmt.Println(internal.Header("📊 Running Array & Slice Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunArraySliceExamples()
-	case "arrays-pro", "arrays-professional":
-		fmt.Println(internal.Header("🚀 Running Professional Array & Slice Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunArraySliceProfessionalExamples()
-	case "value-reference", "pass-by-value", "pass-by-reference":
-		fmt.Println(internal.Header("🔄 Running Value vs Reference Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunValueReferenceExamples()
-	case "maps":
-		fmt.Println(internal.Header("🗺️ Running Map Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunMapExamples()
-	case "defer":
-		fmt.Println(internal.Header("🔄 Running Defer/Panic/Recover Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunDeferPanicRecoverExamples()
-	case "strings":
-		fmt.Println(internal.Header("📝 Running String Formatting Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunStringFormattingExamples()
-	case "methods":
-		fmt.Println(internal.Header("📦 Running Method Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunMethodExamples()
-	case "structs":
-		fmt.Println(internal.Header("📦 Running Structs Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunStructureExamples()
-	case "interfaces":
-		fmt.Println(internal.Header("🔌 Running Interface Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunInterfaceExamples()
-	case "errors":
-		fmt.Println(internal.Header("🔌 Running Errors Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunErrorHandlingExamples()
-	case "goroutines":
-		fmt.Println(internal.Header("🚀 Running Goroutine Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunGoroutineExamples()
-	case "channels":
-		fmt.Println(internal.Header("📺 Running Channel Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunChannelExamples()
-	case "packages":
-		fmt.Println(internal.Header("📦 Running Package System Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunPackageSystemExamples()
-	case "embedding":
-		fmt.Println(internal.Header("🧩 Running Embedding & Composition Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunEmbeddingCompositionExamples()
-	case "reflection":
-		fmt.Println(internal.Header("🔍 Running Reflection Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunReflectionExamples()
-	case "context":
-		fmt.Println(internal.Header("🌐 Running Context Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunContextExamples()
-	case "json":
-		fmt.Println(internal.Header("📋 Running JSON & Serialization Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunJSONSerializationExamples()
-	case "fileio":
-		fmt.Println(internal.Header("📁 Running File I/O & Readers/Writers Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunFileIOExamples()
-	case "os":
-		fmt.Println(internal.Header("🖥️ Running OS Package Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunOSExamples()
-	case "io":
-		fmt.Println(internal.Header("📄 Running IO Package Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunIOExamples()
-	case "ioutil":
-		fmt.Println(internal.Header("📁 Running IO/ioutil Package Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunIOUtilExamples()
-	case "system":
-		fmt.Println(internal.Header("🖥️ Running System Interaction Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunOSPackageExamples()
-	case "streams":
-		fmt.Println(internal.Header("📄 Running I/O Streams Examples:"))
-		fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-		internal.RunIOPackageExamples()
-	case "colors":
-		internal.ColorExamples()
-	case "all":
-		runAllExamples()
-	default:
-		fmt.Println(internal.ErrorText(fmt.Sprintf("Unknown topic: %s", topic)))
-		showHelp()
+	//}
+
+	if topic == "completion" {
+		if len(os.Args) < 3 {
+			fmt.Println(internal.ErrorText("Usage: go run ./cmd/goedge completion bash|zsh"))
+			os.Exit(1)
+		}
+		script, err := registry.CompletionScript(os.Args[2])
+		if err != nil {
+			fmt.Println(internal.ErrorText(err.Error()))
+			os.Exit(1)
+		}
+		fmt.Print(script)
+		return
 	}
-}
 
-func showHelp() {
-	fmt.Println(internal.Header("🐹 Golang Review Project"))
-	//fmt.Printf("Version: %s (commit: %s)\n", version, commit)
-	fmt.Println(internal.Cyan("=" + repeat("=", 40)))
-	fmt.Println(internal.Bold("Usage:"), "go run ./cmd/goedge <topic>")
-	fmt.Println("\n" + internal.Subtitle("Available topics:"))
+	if topic == "--list-examples" {
+		if len(os.Args) < 3 {
+			fmt.Println(internal.ErrorText("Usage: go run ./cmd/goedge --list-examples <topic>"))
+			os.Exit(1)
+		}
+		listExamples(registry, os.Args[2])
+		return
+	}
 
-	topics := []struct {
-		name, desc string
-	}{
-		{"pointers", "Pointer examples"},
-		{"functions", "Function examples"},
-		{"arrays", "Array & Slice examples"},
-		{"arrays-pro", "Professional Array & Slice examples"},
-		{"value-reference", "Value vs Reference passing examples"},
-		{"maps", "Map examples"},
-		{"defer", "Defer/Panic/Recover examples"},
-		{"strings", "String formatting examples"},
-		{"structs", "Structs examples"},
-		{"methods", "Method examples"},
-		{"interfaces", "Interface examples"},
-		{"errors", "Errors examples"},
-		{"goroutines", "Goroutine examples"},
-		{"channels", "Channel examples"},
-		{"packages", "Package System & Imports examples"},
-		{"embedding", "Embedding & Composition examples"},
-		{"reflection", "Reflection examples"},
-		{"context", "Context Package examples"},
-		{"json", "JSON & Serialization examples"},
-		{"fileio", "File I/O & Readers/Writers examples"},
-		{"os", "OS Package examples"},
-		{"io", "IO Package examples"},
-		{"ioutil", "IO/ioutil Package examples"},
-		{"system", "System Interaction examples"},
-		{"streams", "I/O Streams examples"},
-		{"colors", "Color examples"},
-		{"all", "Run all examples"},
+	if topic == "all" {
+		registry.RunAll()
+		return
 	}
 
-	for _, topic := range topics {
-		fmt.Printf("  %s - %s\n",
-			internal.Yellow(topic.name),
-			topic.desc)
+	err := registry.Run(topic, os.Args[2:])
+	switch {
+	case err == nil:
+	case errors.Is(err, flag.ErrHelp):
+	case errors.Is(err, errUnknownTopic):
+		fmt.Println(internal.ErrorText(fmt.Sprintf("Unknown topic: %s", topic)))
+		showHelp(registry)
+	default:
+		fmt.Println(internal.ErrorText(err.Error()))
+		os.Exit(2)
 	}
+}
 
-	fmt.Println("\n" + internal.InfoText("Example: go run ./cmd/goedge json"))
+// runDatasets renders every example dataset with renderer
+func runDatasets(renderer internal.Renderer) {
+	datasets, err := internal.ExampleDatasets()
+	if err != nil {
+		fmt.Println(internal.ErrorText(err.Error()))
+		return
+	}
+	for _, dataset := range datasets {
+		fmt.Println(internal.Subtitle(dataset.Name))
+		if err := renderer.Render(os.Stdout, dataset.Data); err != nil {
+			fmt.Println(internal.ErrorText(err.Error()))
+		}
+		fmt.Println()
+	}
 }
 
-func runAllExamples() {
-	topics := []struct {
-		name string
-		fn   func()
-	}{
-		{"🔗 Pointers", internal.RunPointerExamples},
-		{"🔧 Functions", internal.RunFunctionExamples},
-		{"📊 Arrays & Slices", internal.RunArraySliceExamples},
-		{"🚀 Professional Arrays & Slices", internal.RunArraySliceProfessionalExamples},
-		{"🔄 Value vs Reference", internal.RunValueReferenceExamples},
-		{"🗺️ Maps", internal.RunMapExamples},
-		{"🔄 Defer/Panic/Recover", internal.RunDeferPanicRecoverExamples},
-		{"📝 String Formatting", internal.RunStringFormattingExamples},
-		{"📦 Methods", internal.RunMethodExamples},
-		{"📦 Structs", internal.RunStructureExamples},
-		{"🔌 Interfaces", internal.RunInterfaceExamples},
-		{"🔌 Errors", internal.RunErrorHandlingExamples},
-		{"🚀 Goroutines", internal.RunGoroutineExamples},
-		{"📺 Channels", internal.RunChannelExamples},
-		{"📦 Package System", internal.RunPackageSystemExamples},
-		{"🧩 Embedding & Composition", internal.RunEmbeddingCompositionExamples},
-		{"🔍 Reflection", internal.RunReflectionExamples},
-		{"🌐 Context", internal.RunContextExamples},
-		{"📋 JSON & Serialization", internal.RunJSONSerializationExamples},
-		{"📁 File I/O & Readers/Writers", internal.RunFileIOExamples},
-		{"🖥️ OS Package", internal.RunOSExamples},
-		{"📄 IO Package", internal.RunIOExamples},
-		{"📁 IO/ioutil Package", internal.RunIOUtilExamples},
-		{"🖥️ System Interaction", internal.RunOSPackageExamples},
-		{"📄 I/O Streams", internal.RunIOPackageExamples},
+func listExamples(registry *Registry, name string) {
+	topic, ok := registry.Lookup(name)
+	if !ok {
+		fmt.Println(internal.ErrorText(fmt.Sprintf("Unknown topic: %s", name)))
+		os.Exit(1)
 	}
 
-	for i, topic := range topics {
-		fmt.Printf("\n%s Examples:\n", internal.Header(topic.name))
-		fmt.Println(internal.Cyan("=" + repeat("=", 50)))
-		topic.fn()
+	names, err := internal.ExampleNames(topic.Name)
+	if err != nil {
+		fmt.Println(internal.ErrorText(err.Error()))
+		os.Exit(1)
+	}
 
-		if i < len(topics)-1 {
-			fmt.Println("\n" + internal.Dim(repeat("-", 50)))
-		}
+	fmt.Println(internal.Subtitle(fmt.Sprintf("Examples in %s:", topic.Name)))
+	for _, example := range names {
+		fmt.Printf("  %s\n", internal.Yellow(example))
 	}
 }
 
+func showHelp(registry *Registry) {
+	fmt.Println(internal.Header("🐹 Golang Review Project"))
+	//fmt.Printf("Version: %s (commit: %s)\n", version, commit)
+	fmt.Println(internal.Cyan("=" + repeat("=", 40)))
+	fmt.Println(internal.Bold("Usage:"), "go run ./cmd/goedge <topic> [flags]")
+	fmt.Println(internal.Bold("       "), "go run ./cmd/goedge <topic> --only <example>")
+	fmt.Println(internal.Bold("       "), "go run ./cmd/goedge --list-examples <topic>")
+	fmt.Println("\n" + internal.Subtitle("Available topics:"))
+
+	for _, topic := range registry.Topics() {
+		fmt.Printf("  %s - %s\n",
+			internal.Yellow(topic.Name),
+			topic.Desc)
+	}
+	fmt.Printf("  %s - %s\n", internal.Yellow("all"), "Run all examples")
+	fmt.Printf("  %s - %s\n", internal.Yellow("completion bash|zsh"), "Print a shell completion script")
+
+	fmt.Println("\n" + internal.InfoText("Example: go run ./cmd/goedge json --indent=4 --sort-keys"))
+	fmt.Println(internal.InfoText("Example: go run ./cmd/goedge data --format=table"))
+	fmt.Println(internal.InfoText("Run a topic with -h to see its flags"))
+}
+
 func repeat(s string, count int) string {
 	result := ""
 	for i := 0; i < count; i++ {
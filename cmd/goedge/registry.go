@@ -0,0 +1,195 @@
+// cmd/goedge/registry.go
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal"
+)
+
+// errUnknownTopic is returned when no topic matches the requested name
+var errUnknownTopic = errors.New("unknown topic")
+
+// Topic is one runnable group of examples
+type Topic struct {
+	Name    string
+	Desc    string
+	Icon    string
+	Title   string
+	Aliases []string
+	Run     func()
+
+	defineFlags    func(fs *flag.FlagSet)
+	setup          func()
+	excludeFromAll bool
+}
+
+// WithTitle sets the heading shown when the topic runs
+func (t *Topic) WithTitle(icon, title string) *Topic {
+	t.Icon, t.Title = icon, title
+	return t
+}
+
+// WithAliases adds alternative names the topic can be run by
+func (t *Topic) WithAliases(aliases ...string) *Topic {
+	t.Aliases = append(t.Aliases, aliases...)
+	return t
+}
+
+// WithFlags declares topic-specific flags; define binds them on the topic's
+// flag set, typically to variables read by a wrapper around Run
+func (t *Topic) WithFlags(define func(fs *flag.FlagSet)) *Topic {
+	t.defineFlags = define
+	return t
+}
+
+// WithSetup sets a function run after the topic's flags are parsed and
+// before its examples, whether the whole topic runs or just one --only
+// example. It is where flag values get applied.
+func (t *Topic) WithSetup(setup func()) *Topic {
+	t.setup = setup
+	return t
+}
+
+// Command builds a fresh Command for the topic. Besides the topic's own
+// flags it accepts --only <example>, which runs a single named sub-example
+// instead of the whole topic; unknown names are rejected while parsing.
+func (t *Topic) Command() *Command {
+	var only string
+	define := func(fs *flag.FlagSet) {
+		if t.defineFlags != nil {
+			t.defineFlags(fs)
+		}
+		fs.Func("only", "run only the named example (see --list-examples)", func(example string) error {
+			names, err := internal.ExampleNames(t.Name)
+			if err != nil {
+				return err
+			}
+			if !slices.Contains(names, example) {
+				return fmt.Errorf("no example %q (available: %s)", example, strings.Join(names, ", "))
+			}
+			only = example
+			return nil
+		})
+	}
+	return NewCommand(t.Name, define, func() {
+		t.prepare()
+		if only != "" {
+			internal.RunExample(t.Name, only) // the name was checked by the flag
+			return
+		}
+		t.Run()
+	})
+}
+
+// prepare runs the topic's setup, if any
+func (t *Topic) prepare() {
+	if t.setup != nil {
+		t.setup()
+	}
+}
+
+// ExcludeFromAll keeps the topic out of the "all" runner
+func (t *Topic) ExcludeFromAll() *Topic {
+	t.excludeFromAll = true
+	return t
+}
+
+// Registry holds every topic in registration order; help, single-topic runs
+// and the "all" runner all read from it
+type Registry struct {
+	topics []*Topic
+	byName map[string]*Topic
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{byName: make(map[string]*Topic)}
+}
+
+// Register adds a topic. It panics on an empty name or description, a nil
+// fn or a duplicate name, so wiring mistakes show up on the first run.
+func (r *Registry) Register(name, desc string, fn func()) *Topic {
+	switch {
+	case name == "":
+		panic("registry: topic name must not be empty")
+	case desc == "":
+		panic(fmt.Sprintf("registry: topic %q has no description", name))
+	case fn == nil:
+		panic(fmt.Sprintf("registry: topic %q has no function", name))
+	}
+	if _, exists := r.byName[name]; exists {
+		panic(fmt.Sprintf("registry: topic %q registered twice", name))
+	}
+
+	topic := &Topic{Name: name, Desc: desc, Run: fn}
+	r.topics = append(r.topics, topic)
+	r.byName[name] = topic
+	return topic
+}
+
+// Topics returns the registered topics in registration order
+func (r *Registry) Topics() []*Topic {
+	return r.topics
+}
+
+// Lookup finds a topic by name or alias
+func (r *Registry) Lookup(name string) (*Topic, bool) {
+	if topic, ok := r.byName[name]; ok {
+		return topic, true
+	}
+	for _, topic := range r.topics {
+		for _, alias := range topic.Aliases {
+			if alias == name {
+				return topic, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Run runs a single topic by name or alias, parsing args with the topic's
+// own flag set
+func (r *Registry) Run(name string, args []string) error {
+	topic, ok := r.Lookup(name)
+	if !ok {
+		return fmt.Errorf("%w: %s", errUnknownTopic, name)
+	}
+
+	cmd := topic.Command()
+	if topic.Title != "" {
+		// Print the heading only once flags are known to be valid
+		action := cmd.action
+		cmd.action = func() {
+			fmt.Println(internal.Header(fmt.Sprintf("%s Running %s Examples:", topic.Icon, topic.Title)))
+			fmt.Println(internal.Cyan("=" + repeat("=", 40)))
+			action()
+		}
+	}
+	return cmd.Run(args)
+}
+
+// RunAll runs every topic not excluded from the "all" runner
+func (r *Registry) RunAll() {
+	var topics []*Topic
+	for _, topic := range r.topics {
+		if !topic.excludeFromAll {
+			topics = append(topics, topic)
+		}
+	}
+
+	for i, topic := range topics {
+		fmt.Printf("\n%s Examples:\n", internal.Header(topic.Icon+" "+topic.Title))
+		fmt.Println(internal.Cyan("=" + repeat("=", 50)))
+		topic.prepare()
+		topic.Run()
+
+		if i < len(topics)-1 {
+			fmt.Println("\n" + internal.Dim(repeat("-", 50)))
+		}
+	}
+}
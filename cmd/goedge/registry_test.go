@@ -0,0 +1,144 @@
+// cmd/goedge/registry_test.go
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal"
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestRegisteredTopicsAreComplete(t *testing.T) {
+	registry := NewRegistry()
+	registerTopics(registry)
+
+	if len(registry.Topics()) == 0 {
+		t.Fatal("no topics registered")
+	}
+	seen := make(map[string]bool)
+	for _, topic := range registry.Topics() {
+		if topic.Desc == "" {
+			t.Errorf("topic %q has no description", topic.Name)
+		}
+		if topic.Run == nil {
+			t.Errorf("topic %q has no function", topic.Name)
+		}
+		for _, name := range append([]string{topic.Name}, topic.Aliases...) {
+			if seen[name] {
+				t.Errorf("name %q is used twice", name)
+			}
+			seen[name] = true
+		}
+	}
+}
+
+func TestRegistryLookupAndRun(t *testing.T) {
+	registry := NewRegistry()
+	runs := 0
+	registry.Register("demo", "Demo examples", func() { runs++ }).WithAliases("sample")
+
+	topic, ok := registry.Lookup("sample")
+	testutil.AssertEqual(t, ok, true)
+	testutil.AssertEqual(t, topic.Name, "demo")
+
+	testutil.AssertNoError(t, registry.Run("demo", nil))
+	testutil.AssertEqual(t, runs, 1)
+	testutil.AssertErrorIs(t, registry.Run("missing", nil), errUnknownTopic)
+
+	if err := registry.Run("demo", []string{"extra"}); err == nil {
+		t.Error("Run accepted unexpected arguments")
+	}
+	testutil.AssertEqual(t, runs, 1)
+}
+
+func TestRegistryRejectsBadWiring(t *testing.T) {
+	tests := []struct {
+		name, desc string
+		fn         func()
+	}{
+		{"", "desc", func() {}},
+		{"topic", "", func() {}},
+		{"topic", "desc", nil},
+		{"dup", "desc", func() {}},
+	}
+	for _, tt := range tests {
+		registry := NewRegistry()
+		registry.Register("dup", "first", func() {})
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Register(%q, %q) did not panic", tt.name, tt.desc)
+				}
+			}()
+			registry.Register(tt.name, tt.desc, tt.fn)
+		}()
+	}
+}
+
+// captureStdout returns what fn prints to os.Stdout
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	testutil.AssertNoError(t, err)
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	done := make(chan string)
+	go func() {
+		var out bytes.Buffer
+		io.Copy(&out, r)
+		done <- out.String()
+	}()
+	fn()
+	w.Close()
+	return <-done
+}
+
+func TestRunOnlyAppliesTopicFlags(t *testing.T) {
+	registry := NewRegistry()
+	registerTopics(registry)
+	t.Cleanup(func() { internal.SetJSONOptions(internal.JSONOptions{Indent: 2}) })
+
+	// --only may come before or after the topic's own flags
+	for _, args := range [][]string{
+		{"--indent=4", "--only", "struct-tags"},
+		{"--only=struct-tags", "--indent=4"},
+	} {
+		var err error
+		out := captureStdout(t, func() { err = registry.Run("json", args) })
+		testutil.AssertNoError(t, err)
+		testutil.AssertContains(t, out, "\n    \"name\": \"Bob Johnson\"")
+		if strings.Contains(out, "Basic JSON Marshaling") {
+			t.Errorf("%v ran more than the struct-tags example", args)
+		}
+	}
+}
+
+func TestRunOnlyRejectsUnknownExamples(t *testing.T) {
+	registry := NewRegistry()
+	registerTopics(registry)
+	runs := 0
+	registry.Register("demo", "Demo examples", func() { runs++ })
+
+	for _, tt := range []struct {
+		topic, example, want string
+	}{
+		{"json", "nope", `no example "nope" (available: basic-marshaling, `},
+		{"demo", "x", `topic "demo" has no named sub-examples`},
+	} {
+		topic, _ := registry.Lookup(tt.topic)
+		cmd := topic.Command()
+		cmd.Flags.SetOutput(io.Discard)
+		err := cmd.Run([]string{"--only", tt.example})
+		if err == nil {
+			t.Fatalf("%s --only %s succeeded", tt.topic, tt.example)
+		}
+		testutil.AssertContains(t, err.Error(), tt.want)
+	}
+	testutil.AssertEqual(t, runs, 0)
+}
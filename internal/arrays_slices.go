@@ -3,6 +3,7 @@ package internal
 
 import (
 	"fmt"
+	"math/rand"
 	"sort"
 )
 
@@ -239,6 +240,17 @@ func sliceAdvancedExample() {
 	})
 	fmt.Printf("After age sort: %v\n", people)
 
+	// Reproducible shuffling and sampling: the same seed gives the same result
+	deck := []string{"A♠", "K♠", "Q♠", "J♠", "10♠", "9♠", "8♠", "7♠"}
+	first := append([]string(nil), deck...)
+	second := append([]string(nil), deck...)
+	Shuffle(first, rand.New(rand.NewSource(42)))
+	Shuffle(second, rand.New(rand.NewSource(42)))
+	fmt.Printf("Shuffled (seed 42): %v\n", first)
+	fmt.Printf("Same seed again:    %v\n", second)
+	fmt.Printf("Sample of 3 (seed 7): %v\n", Sample(deck, 3, rand.New(rand.NewSource(7))))
+	fmt.Printf("Sample of 20 takes all %d\n", len(Sample(deck, 20, rand.New(rand.NewSource(7)))))
+
 	fmt.Println()
 }
 
@@ -2,8 +2,8 @@ package internal
 
 import (
 	"fmt"
+	"math/rand"
 	"reflect"
-	"runtime"
 	"sync"
 	"time"
 	"unsafe"
@@ -102,7 +102,7 @@ func demonstrateSliceHeader(s []int) {
 	fmt.Printf("\nSlice header analysis:\n")
 	fmt.Printf("Slice value: %v\n", s)
 	fmt.Printf("Slice header size: %d bytes\n", unsafe.Sizeof(s))
-	fmt.Printf("Slice pointer: %p\n", (*reflect.SliceHeader)(unsafe.Pointer(&s)).Data)
+	fmt.Printf("Slice pointer: %#x\n", (*reflect.SliceHeader)(unsafe.Pointer(&s)).Data)
 	fmt.Printf("Slice length: %d\n", (*reflect.SliceHeader)(unsafe.Pointer(&s)).Len)
 	fmt.Printf("Slice capacity: %d\n", (*reflect.SliceHeader)(unsafe.Pointer(&s)).Cap)
 }
@@ -137,27 +137,25 @@ func demonstrateSliceGrowth() {
 
 func demonstratePreAllocation() {
 	const size = 1000000
+	const runs = 5
 
-	// Without pre-allocation
-	start := time.Now()
-	var slice1 []int
-	for i := 0; i < size; i++ {
-		slice1 = append(slice1, i)
-	}
-	withoutPreAlloc := time.Since(start)
+	withoutPreAlloc := Benchmark("Without pre-allocation", runs, func() {
+		var slice []int
+		for i := 0; i < size; i++ {
+			slice = append(slice, i)
+		}
+	})
 
-	// With pre-allocation
-	start = time.Now()
-	slice2 := make([]int, 0, size)
-	for i := 0; i < size; i++ {
-		slice2 = append(slice2, i)
-	}
-	withPreAlloc := time.Since(start)
+	withPreAlloc := Benchmark("With pre-allocation", runs, func() {
+		slice := make([]int, 0, size)
+		for i := 0; i < size; i++ {
+			slice = append(slice, i)
+		}
+	})
 
-	fmt.Printf("Without pre-allocation: %v\n", withoutPreAlloc)
-	fmt.Printf("With pre-allocation: %v\n", withPreAlloc)
-	fmt.Printf("Performance improvement: %.2fx\n",
-		float64(withoutPreAlloc)/float64(withPreAlloc))
+	fmt.Println(withoutPreAlloc)
+	fmt.Println(withPreAlloc)
+	fmt.Println(CompareBench(withoutPreAlloc, withPreAlloc))
 }
 
 // ==============================================================================
@@ -329,6 +327,19 @@ func demonstrateAdvancedTechniques() {
 	numbers = numbers[:len(numbers)-1]
 	fmt.Printf("After O(1) removal: %v\n", numbers)
 
+	// Order-preserving removal by value or predicate
+	tags := []string{"go", "rust", "go", "zig", "python", "go"}
+	fmt.Printf("RemoveValue(go): %v\n", RemoveValue(append([]string(nil), tags...), "go"))
+	fmt.Printf("RemoveAll(go): %v\n", RemoveAll(append([]string(nil), tags...), "go"))
+	fmt.Printf("RemoveValue(java): %v (unchanged)\n", RemoveValue(append([]string(nil), tags...), "java"))
+	fmt.Printf("RemoveFunc(len > 2): %v\n", RemoveFunc(append([]string(nil), tags...), func(t string) bool { return len(t) > 2 }))
+
+	// The removed tail is zeroed, so dropped pointers can be collected
+	directory := EmployeeDirectory()
+	people := []*Employee{&directory[0], &directory[1], &directory[2]}
+	kept := RemoveFunc(people, func(e *Employee) bool { return e.Position == "Designer" })
+	fmt.Printf("Kept %d employees; slot past the end is nil: %v\n", len(kept), people[2] == nil)
+
 	// 2. Efficient insertion at beginning
 	numbers = append([]int{0}, numbers...)
 	fmt.Printf("After prepend: %v\n", numbers)
@@ -346,20 +357,35 @@ func demonstrateAdvancedTechniques() {
 	sum := Reduce([]int{1, 2, 3, 4, 5}, 0, func(acc, n int) int { return acc + n })
 	fmt.Printf("Sum: %d\n", sum)
 
+	// 5. Generic comparison and sorting
+	scores := []int{42, 7, 19, 7, 88}
+	fmt.Printf("Sorted? %v\n", IsSorted(scores))
+	SortSlice(scores)
+	fmt.Printf("After SortSlice: %v (sorted? %v)\n", scores, IsSorted(scores))
+	fmt.Printf("Equal to [7 7 19 42 88]? %v; to [7 19 42 88]? %v\n",
+		SliceEqual(scores, []int{7, 7, 19, 42, 88}), SliceEqual(scores, []int{7, 19, 42, 88}))
+
+	// The func variant is stable: within a department, names stay in the
+	// alphabetical order of the previous pass
+	staff := EmployeeDirectory()
+	byName := func(a, b Employee) bool { return a.Name < b.Name }
+	SortSliceFunc(staff, byName)
+	SortSliceFunc(staff, func(a, b Employee) bool { return a.Department < b.Department })
+	fmt.Printf("By department, then name: %v\n", Map(staff, func(e Employee) string { return e.Department + "/" + e.Name }))
+	fmt.Printf("Still sorted by name overall? %v\n", IsSortedFunc(staff, byName))
+
 	fmt.Println()
 }
 
-var slicePool = sync.Pool{
-	New: func() interface{} {
-		return make([]int, 0, 100)
-	},
-}
+var slicePool = NewPool(func() []int {
+	return make([]int, 0, 100)
+})
 
 func demonstrateSlicePooling() {
 	fmt.Println("\nSlice pooling demonstration:")
 
 	// Get slice from pool
-	slice := slicePool.Get().([]int)
+	slice := slicePool.Get()
 	defer slicePool.Put(slice[:0]) // Reset length and return to pool
 
 	// Use the slice
@@ -378,46 +404,45 @@ func compareSlicePerformance() {
 	fmt.Println(InfoText("=== PERFORMANCE COMPARISON ==="))
 
 	const size = 1000000
+	const runs = 5
 
 	// Test 1: Append vs Pre-allocation
-	start := time.Now()
-	var slice1 []int
-	for i := 0; i < size; i++ {
-		slice1 = append(slice1, i)
-	}
-	appendTime := time.Since(start)
+	appendResult := Benchmark("Append", runs, func() {
+		var slice []int
+		for i := 0; i < size; i++ {
+			slice = append(slice, i)
+		}
+	})
 
-	start = time.Now()
-	slice2 := make([]int, size)
-	for i := 0; i < size; i++ {
-		slice2[i] = i
-	}
-	indexTime := time.Since(start)
+	indexResult := Benchmark("Direct indexing", runs, func() {
+		slice := make([]int, size)
+		for i := 0; i < size; i++ {
+			slice[i] = i
+		}
+	})
 
-	fmt.Printf("Append: %v\n", appendTime)
-	fmt.Printf("Direct indexing: %v\n", indexTime)
-	fmt.Printf("Indexing is %.2fx faster\n",
-		float64(appendTime)/float64(indexTime))
+	fmt.Println(appendResult)
+	fmt.Println(indexResult)
+	fmt.Println(CompareBench(appendResult, indexResult))
 
 	// Test 2: Copy vs manual loop
 	src := make([]int, size)
 
-	start = time.Now()
-	dst1 := make([]int, size)
-	copy(dst1, src)
-	copyTime := time.Since(start)
+	copyResult := Benchmark("Built-in copy", runs, func() {
+		dst := make([]int, size)
+		copy(dst, src)
+	})
 
-	start = time.Now()
-	dst2 := make([]int, size)
-	for i := 0; i < size; i++ {
-		dst2[i] = src[i]
-	}
-	loopTime := time.Since(start)
+	loopResult := Benchmark("Manual loop", runs, func() {
+		dst := make([]int, size)
+		for i := 0; i < size; i++ {
+			dst[i] = src[i]
+		}
+	})
 
-	fmt.Printf("Built-in copy: %v\n", copyTime)
-	fmt.Printf("Manual loop: %v\n", loopTime)
-	fmt.Printf("Copy is %.2fx faster\n",
-		float64(loopTime)/float64(copyTime))
+	fmt.Println(copyResult)
+	fmt.Println(loopResult)
+	fmt.Println(CompareBench(loopResult, copyResult))
 
 	fmt.Println()
 }
@@ -551,6 +576,20 @@ func demonstrateRealWorldExamples() {
 		time.Sleep(300 * time.Millisecond)
 	}
 
+	// Rate counter: fixed-size buckets instead of one timestamp per event
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	requests := NewRateCounter(time.Minute, time.Second, clock)
+	for second := 0; second < 10; second++ {
+		requests.Add(20) // steady 20 req/s
+		clock.Advance(time.Second)
+	}
+	requests.Add(150) // a burst in the current second
+	fmt.Printf("Rate over 1s: %.0f req/s, 10s: %.1f req/s, 1m: %.2f req/s\n",
+		requests.Rate(time.Second), requests.Rate(10*time.Second), requests.Rate(time.Minute))
+	clock.Advance(55 * time.Second)
+	fmt.Printf("55s later: %d requests in the last 10s, %d in the last minute\n",
+		requests.Count(10*time.Second), requests.Count(time.Minute))
+
 	// Thread-safe slice example
 	safeSlice := NewSafeSlice[int]()
 	var wg sync.WaitGroup
@@ -567,10 +606,51 @@ func demonstrateRealWorldExamples() {
 	wg.Wait()
 	fmt.Printf("Thread-safe slice: %v\n", safeSlice.ToSlice())
 
+	// Latency statistics: a single-pass summary and two moving averages
+	var latencyStats Stats
+	latencyHist := NewHistogram(ExponentialBuckets(25, 2, 5))
+	fast, slow := NewEMA(0.5), NewEMA(0.1)
+	rng := rand.New(rand.NewSource(7))
+	fmt.Println("Simulated request latencies (ms):")
+	for i := 1; i <= 12; i++ {
+		latency := 40 + rng.Float64()*20
+		if i == 8 {
+			latency = 250 // one slow outlier
+		}
+		latencyStats.Add(latency)
+		latencyHist.Observe(latency)
+		fmt.Printf("  #%-2d %6.1f  ema(0.5)=%6.1f  ema(0.1)=%6.1f\n",
+			i, latency, fast.Add(latency), slow.Add(latency))
+	}
+	fmt.Printf("Latency stats: %v\n", &latencyStats)
+	fmt.Printf("Latency histogram (ms), n=%d sum=%.1f:\n%v\n",
+		latencyHist.Count(), latencyHist.Sum(), latencyHist)
+	fmt.Printf("Estimated p50=%.1f p90=%.1f p99=%.1f\n",
+		latencyHist.Quantile(0.5), latencyHist.Quantile(0.9), latencyHist.Quantile(0.99))
+
 	// Memory usage info
-	var m runtime.MemStats
-	runtime.ReadMemStats(&m)
-	fmt.Printf("\nMemory usage: %d KB\n", m.Alloc/1024)
+	fmt.Printf("\nMemory usage: %s\n", FormatBytes(int64(MemSnapshot().Alloc)))
+
+	// Allocation cost of building the same slice two ways
+	const size = 100000
+	var sink []int
+	appendDelta := MeasureAlloc(func() {
+		var slice []int
+		for i := 0; i < size; i++ {
+			slice = append(slice, i)
+		}
+		sink = slice
+	})
+	preAllocDelta := MeasureAlloc(func() {
+		slice := make([]int, 0, size)
+		for i := 0; i < size; i++ {
+			slice = append(slice, i)
+		}
+		sink = slice
+	})
+	fmt.Printf("Append build: %v\n", appendDelta)
+	fmt.Printf("Pre-allocated build: %v\n", preAllocDelta)
+	_ = sink
 
 	fmt.Println()
 }
@@ -0,0 +1,68 @@
+// backoff.go
+package internal
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Backoff produces an exponentially growing sequence of retry delays:
+// base, base*factor, base*factor², ... capped at max. With jitter each
+// delay is drawn uniformly from [d/2, d) so that many clients retrying at
+// once spread out instead of hitting the server in lockstep. It is not
+// safe for concurrent use.
+type Backoff struct {
+	base   time.Duration
+	max    time.Duration
+	factor float64
+	jitter bool
+	next   time.Duration
+	rand   *rand.Rand
+}
+
+// NewBackoff creates a backoff starting at base. A factor below 1 is
+// treated as 1 (a constant delay) and a max below base is raised to base.
+func NewBackoff(base, max time.Duration, factor float64, jitter bool) *Backoff {
+	if factor < 1 {
+		factor = 1
+	}
+	if max < base {
+		max = base
+	}
+	return &Backoff{
+		base:   base,
+		max:    max,
+		factor: factor,
+		jitter: jitter,
+		next:   base,
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// SetRand replaces the jitter source, e.g. with a seeded one for
+// reproducible delays
+func (b *Backoff) SetRand(r *rand.Rand) {
+	b.rand = r
+}
+
+// Next returns the delay to wait before the next attempt and advances the
+// sequence
+func (b *Backoff) Next() time.Duration {
+	delay := b.next
+	if grown := float64(b.next) * b.factor; grown < float64(b.max) {
+		b.next = time.Duration(grown)
+	} else {
+		b.next = b.max
+	}
+
+	if b.jitter && delay > 1 {
+		half := delay / 2
+		delay = half + time.Duration(b.rand.Int63n(int64(delay-half)))
+	}
+	return delay
+}
+
+// Reset restarts the sequence at base, typically after a success
+func (b *Backoff) Reset() {
+	b.next = b.base
+}
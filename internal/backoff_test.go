@@ -0,0 +1,71 @@
+// backoff_test.go
+package internal
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestBackoffGrowsAndCaps(t *testing.T) {
+	b := NewBackoff(100*time.Millisecond, time.Second, 2, false)
+	want := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}
+	for _, w := range want {
+		testutil.AssertEqual(t, b.Next(), w)
+	}
+
+	b.Reset()
+	testutil.AssertEqual(t, b.Next(), 100*time.Millisecond)
+	testutil.AssertEqual(t, b.Next(), 200*time.Millisecond)
+}
+
+func TestBackoffSeededJitter(t *testing.T) {
+	newJittered := func() *Backoff {
+		b := NewBackoff(100*time.Millisecond, time.Second, 2, true)
+		b.SetRand(rand.New(rand.NewSource(42)))
+		return b
+	}
+
+	b := newJittered()
+	var first []time.Duration
+	ceiling := 100 * time.Millisecond
+	for i := 0; i < 8; i++ {
+		delay := b.Next()
+		if delay < ceiling/2 || delay >= ceiling {
+			t.Errorf("delay %d = %v, want within [%v, %v)", i, delay, ceiling/2, ceiling)
+		}
+		first = append(first, delay)
+		if ceiling *= 2; ceiling > time.Second {
+			ceiling = time.Second
+		}
+	}
+
+	// the same seed reproduces the same sequence, and so does Reset with
+	// a re-seeded source
+	again := newJittered()
+	for _, want := range first {
+		testutil.AssertEqual(t, again.Next(), want)
+	}
+	again.Reset()
+	again.SetRand(rand.New(rand.NewSource(42)))
+	testutil.AssertEqual(t, again.Next(), first[0])
+}
+
+func TestBackoffClampsArguments(t *testing.T) {
+	constant := NewBackoff(50*time.Millisecond, time.Second, 0.5, false)
+	testutil.AssertEqual(t, constant.Next(), 50*time.Millisecond)
+	testutil.AssertEqual(t, constant.Next(), 50*time.Millisecond)
+
+	capped := NewBackoff(50*time.Millisecond, time.Millisecond, 2, false)
+	testutil.AssertEqual(t, capped.Next(), 50*time.Millisecond)
+	testutil.AssertEqual(t, capped.Next(), 50*time.Millisecond)
+}
@@ -0,0 +1,85 @@
+// base_convert.go
+package internal
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ConvertBases copies r to w with every whitespace-separated integer
+// rewritten from fromBase into toBase; the whitespace itself is copied
+// unchanged, so the layout of the input survives. Both bases must be
+// between 2 and 36. Input is processed a rune at a time, so streams of any
+// length work. A malformed token stops the conversion with an error giving
+// its line and column (both 1-based); everything before it has already
+// been written.
+func ConvertBases(r io.Reader, w io.Writer, fromBase, toBase int) error {
+	for _, base := range []int{fromBase, toBase} {
+		if base < 2 || base > 36 {
+			return fmt.Errorf("base %d out of range 2-36", base)
+		}
+	}
+
+	in := bufio.NewReader(r)
+	out := bufio.NewWriter(w)
+	var token strings.Builder
+	line, col := 1, 0
+	tokenLine, tokenCol := 0, 0
+
+	flushToken := func() error {
+		if token.Len() == 0 {
+			return nil
+		}
+		n, err := strconv.ParseInt(token.String(), fromBase, 64)
+		if err != nil {
+			var numErr *strconv.NumError
+			if errors.As(err, &numErr) {
+				err = numErr.Err
+			}
+			return fmt.Errorf("line %d, column %d: %q is not a base-%d integer: %w",
+				tokenLine, tokenCol, token.String(), fromBase, err)
+		}
+		token.Reset()
+		_, err = out.WriteString(strconv.FormatInt(n, toBase))
+		return err
+	}
+
+	for {
+		ch, _, err := in.ReadRune()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			out.Flush()
+			return err
+		}
+		col++
+
+		if !unicode.IsSpace(ch) {
+			if token.Len() == 0 {
+				tokenLine, tokenCol = line, col
+			}
+			token.WriteRune(ch)
+			continue
+		}
+		if err := flushToken(); err != nil {
+			out.Flush()
+			return err
+		}
+		out.WriteRune(ch)
+		if ch == '\n' {
+			line, col = line+1, 0
+		}
+	}
+
+	if err := flushToken(); err != nil {
+		out.Flush()
+		return err
+	}
+	return out.Flush()
+}
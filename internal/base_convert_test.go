@@ -0,0 +1,46 @@
+// base_convert_test.go
+package internal
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func convertString(t *testing.T, input string, from, to int) (string, error) {
+	t.Helper()
+	var out strings.Builder
+	err := ConvertBases(strings.NewReader(input), &out, from, to)
+	return out.String(), err
+}
+
+func TestConvertBasesHexToDecimal(t *testing.T) {
+	got, err := convertString(t, "ff 10\n  7fffffffffffffff\t-1a\n", 16, 10)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, got, "255 16\n  9223372036854775807\t-26\n")
+}
+
+func TestConvertBasesDecimalToBinary(t *testing.T) {
+	got, err := convertString(t, "0 5 255 -3", 10, 2)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, got, "0 101 11111111 -11")
+}
+
+func TestConvertBasesInvalidToken(t *testing.T) {
+	got, err := convertString(t, "12 34\n 5 6g 7\n", 10, 16)
+	testutil.AssertErrorIs(t, err, strconv.ErrSyntax)
+	testutil.AssertEqual(t, err.Error(), `line 2, column 4: "6g" is not a base-10 integer: invalid syntax`)
+	testutil.AssertEqual(t, got, "c 22\n 5 ") // output before the bad token is kept
+
+	_, err = convertString(t, "99999999999999999999", 10, 16)
+	testutil.AssertErrorIs(t, err, strconv.ErrRange)
+}
+
+func TestConvertBasesRejectsBadBase(t *testing.T) {
+	_, err := convertString(t, "1", 10, 37)
+	testutil.AssertEqual(t, err.Error(), "base 37 out of range 2-36")
+	_, err = convertString(t, "1", 1, 10)
+	testutil.AssertEqual(t, err.Error(), "base 1 out of range 2-36")
+}
@@ -0,0 +1,30 @@
+// batch.go
+package internal
+
+import (
+	"context"
+	"fmt"
+)
+
+// ProcessBatch calls fn on consecutive batches of at most batchSize items,
+// one batch at a time; the last batch holds the remainder. It stops at the
+// first failing batch or when ctx is cancelled between batches, and the
+// returned error names the batch index either way. A batchSize below 1 is
+// treated as 1.
+func ProcessBatch[T any](ctx context.Context, items []T, batchSize int, fn func(context.Context, []T) error) error {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	for index, start := 0, 0; start < len(items); index, start = index+1, start+batchSize {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("batch %d not started: %w", index, err)
+		}
+
+		end := min(start+batchSize, len(items))
+		if err := fn(ctx, items[start:end]); err != nil {
+			return fmt.Errorf("batch %d: %w", index, err)
+		}
+	}
+	return nil
+}
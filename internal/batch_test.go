@@ -0,0 +1,62 @@
+// batch_test.go
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// recordBatches runs ProcessBatch and returns the batches fn saw
+func recordBatches(t *testing.T, items []int, size int) string {
+	t.Helper()
+	var seen [][]int
+	err := ProcessBatch(context.Background(), items, size, func(_ context.Context, batch []int) error {
+		seen = append(seen, append([]int(nil), batch...))
+		return nil
+	})
+	testutil.AssertNoError(t, err)
+	return fmt.Sprint(seen)
+}
+
+func TestProcessBatchSizes(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6}
+	testutil.AssertEqual(t, recordBatches(t, items, 3), "[[1 2 3] [4 5 6]]")
+	testutil.AssertEqual(t, recordBatches(t, items, 4), "[[1 2 3 4] [5 6]]")
+	testutil.AssertEqual(t, recordBatches(t, items, 10), "[[1 2 3 4 5 6]]")
+	testutil.AssertEqual(t, recordBatches(t, items[:3], 0), "[[1] [2] [3]]")
+	testutil.AssertEqual(t, recordBatches(t, nil, 3), "[]")
+}
+
+func TestProcessBatchCancelledBetweenBatches(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := ProcessBatch(ctx, []int{1, 2, 3, 4, 5}, 2, func(context.Context, []int) error {
+		calls++
+		if calls == 2 {
+			cancel()
+		}
+		return nil
+	})
+	testutil.AssertEqual(t, calls, 2)
+	testutil.AssertErrorIs(t, err, context.Canceled)
+	testutil.AssertEqual(t, err.Error(), "batch 2 not started: context canceled")
+}
+
+func TestProcessBatchWrapsFailingBatch(t *testing.T) {
+	errBad := errors.New("bad item")
+	calls := 0
+	err := ProcessBatch(context.Background(), []string{"a", "b", "c", "d"}, 1, func(_ context.Context, batch []string) error {
+		calls++
+		if batch[0] == "b" {
+			return errBad
+		}
+		return nil
+	})
+	testutil.AssertEqual(t, calls, 2)
+	testutil.AssertErrorIs(t, err, errBad)
+	testutil.AssertEqual(t, err.Error(), "batch 1: bad item")
+}
@@ -0,0 +1,70 @@
+// benchmark.go
+package internal
+
+import (
+	"fmt"
+	"time"
+)
+
+// BenchResult summarizes repeated timings of a single function
+type BenchResult struct {
+	Name       string
+	Iterations int
+	Total      time.Duration
+	Mean       time.Duration
+	Min        time.Duration
+	Max        time.Duration
+	OpsPerSec  float64
+}
+
+// String renders the result on one line
+func (r BenchResult) String() string {
+	return fmt.Sprintf("%s: mean=%s min=%s max=%s (%.0f ops/sec, %d runs)",
+		r.Name, HumanizeDuration(r.Mean), HumanizeDuration(r.Min), HumanizeDuration(r.Max),
+		r.OpsPerSec, r.Iterations)
+}
+
+// Benchmark runs fn iterations times, timing each run individually.
+// A non-positive iterations count runs fn once.
+func Benchmark(name string, iterations int, fn func()) BenchResult {
+	if iterations <= 0 {
+		iterations = 1
+	}
+
+	result := BenchResult{Name: name, Iterations: iterations}
+	for i := 0; i < iterations; i++ {
+		start := time.Now()
+		fn()
+		elapsed := time.Since(start)
+
+		result.Total += elapsed
+		if i == 0 || elapsed < result.Min {
+			result.Min = elapsed
+		}
+		if elapsed > result.Max {
+			result.Max = elapsed
+		}
+	}
+
+	result.Mean = result.Total / time.Duration(iterations)
+	if result.Total > 0 {
+		result.OpsPerSec = float64(iterations) / result.Total.Seconds()
+	}
+	return result
+}
+
+// CompareBench produces a colorized line stating which result was faster
+// and by how much, comparing mean durations
+func CompareBench(a, b BenchResult) string {
+	fast, slow := a, b
+	if b.Mean < a.Mean {
+		fast, slow = b, a
+	}
+	if fast.Mean <= 0 {
+		return WarningText(fmt.Sprintf("%s vs %s: too fast to compare", a.Name, b.Name))
+	}
+
+	speedup := float64(slow.Mean) / float64(fast.Mean)
+	return fmt.Sprintf("%s is %s faster than %s",
+		Green(fast.Name), Bold(fmt.Sprintf("%.2fx", speedup)), Yellow(slow.Name))
+}
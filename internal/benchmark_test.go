@@ -0,0 +1,36 @@
+// benchmark_test.go
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestBenchmarkRunsIterations(t *testing.T) {
+	calls := 0
+	result := Benchmark("noop", 500, func() { calls++ })
+
+	testutil.AssertEqual(t, calls, 500)
+	testutil.AssertEqual(t, result.Iterations, 500)
+	if result.Mean < 0 || result.Mean > time.Millisecond {
+		t.Errorf("no-op mean = %v, want tiny and non-negative", result.Mean)
+	}
+	if result.Min > result.Mean || result.Mean > result.Max {
+		t.Errorf("min/mean/max out of order: %v %v %v", result.Min, result.Mean, result.Max)
+	}
+
+	calls = 0
+	testutil.AssertEqual(t, Benchmark("once", 0, func() { calls++ }).Iterations, 1)
+	testutil.AssertEqual(t, calls, 1)
+}
+
+func TestCompareBench(t *testing.T) {
+	fast := BenchResult{Name: "copy", Mean: time.Microsecond}
+	slow := BenchResult{Name: "loop", Mean: 3 * time.Microsecond}
+
+	testutil.AssertEqual(t, StripANSI(CompareBench(slow, fast)), "copy is 3.00x faster than loop")
+	testutil.AssertEqual(t, StripANSI(CompareBench(fast, slow)), "copy is 3.00x faster than loop")
+	testutil.AssertContains(t, StripANSI(CompareBench(BenchResult{Name: "a"}, BenchResult{Name: "b"})), "too fast to compare")
+}
@@ -0,0 +1,127 @@
+// bitset.go
+package internal
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// BitSet is a set of non-negative integers stored one bit each. The zero
+// value is an empty set ready to use; it grows as higher bits are set. It
+// is not safe for concurrent use.
+type BitSet struct {
+	words []uint64
+}
+
+// NewBitSet creates a set with room for bits [0, size) preallocated
+func NewBitSet(size int) *BitSet {
+	if size < 0 {
+		size = 0
+	}
+	return &BitSet{words: make([]uint64, (size+63)/64)}
+}
+
+func bitPos(i int) (word int, mask uint64) {
+	if i < 0 {
+		panic(fmt.Sprintf("bitset: negative index %d", i))
+	}
+	return i / 64, 1 << (uint(i) % 64)
+}
+
+// Set adds i to the set, growing it if needed
+func (b *BitSet) Set(i int) {
+	word, mask := bitPos(i)
+	if word >= len(b.words) {
+		grown := make([]uint64, word+1, max(word+1, 2*len(b.words)))
+		copy(grown, b.words)
+		b.words = grown
+	}
+	b.words[word] |= mask
+}
+
+// Clear removes i from the set
+func (b *BitSet) Clear(i int) {
+	word, mask := bitPos(i)
+	if word < len(b.words) {
+		b.words[word] &^= mask
+	}
+}
+
+// Test reports whether i is in the set
+func (b *BitSet) Test(i int) bool {
+	word, mask := bitPos(i)
+	return word < len(b.words) && b.words[word]&mask != 0
+}
+
+// Count returns the number of bits set
+func (b *BitSet) Count() int {
+	n := 0
+	for _, w := range b.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Len returns the capacity of the set in bits
+func (b *BitSet) Len() int {
+	return len(b.words) * 64
+}
+
+// Union returns a new set holding the bits set in either b or other
+func (b *BitSet) Union(other *BitSet) *BitSet {
+	long, short := b.words, other.words
+	if len(short) > len(long) {
+		long, short = short, long
+	}
+	result := &BitSet{words: append([]uint64(nil), long...)}
+	for i, w := range short {
+		result.words[i] |= w
+	}
+	return result
+}
+
+// Intersect returns a new set holding the bits set in both b and other
+func (b *BitSet) Intersect(other *BitSet) *BitSet {
+	n := min(len(b.words), len(other.words))
+	result := &BitSet{words: make([]uint64, n)}
+	for i := 0; i < n; i++ {
+		result.words[i] = b.words[i] & other.words[i]
+	}
+	return result
+}
+
+// Difference returns a new set holding the bits set in b but not in other
+func (b *BitSet) Difference(other *BitSet) *BitSet {
+	result := &BitSet{words: append([]uint64(nil), b.words...)}
+	for i := 0; i < len(result.words) && i < len(other.words); i++ {
+		result.words[i] &^= other.words[i]
+	}
+	return result
+}
+
+// Members returns the set bits in ascending order
+func (b *BitSet) Members() []int {
+	members := make([]int, 0, b.Count())
+	for i, w := range b.words {
+		for w != 0 {
+			members = append(members, i*64+bits.TrailingZeros64(w))
+			w &= w - 1 // clear the lowest set bit
+		}
+	}
+	return members
+}
+
+// String renders the members as {1 5 64}
+func (b *BitSet) String() string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, m := range b.Members() {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		fmt.Fprint(&sb, m)
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
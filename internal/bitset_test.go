@@ -0,0 +1,74 @@
+// bitset_test.go
+package internal
+
+import (
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func bitSetOf(members ...int) *BitSet {
+	var b BitSet
+	for _, m := range members {
+		b.Set(m)
+	}
+	return &b
+}
+
+func TestBitSetWordBoundaries(t *testing.T) {
+	var b BitSet
+	for _, i := range []int{0, 63, 64, 127, 128, 1000} {
+		b.Set(i)
+		testutil.AssertEqual(t, b.Test(i), true)
+	}
+	testutil.AssertEqual(t, b.Test(62), false)
+	testutil.AssertEqual(t, b.Test(65), false)
+	testutil.AssertEqual(t, b.Test(5000), false) // beyond the allocated words
+	testutil.AssertEqual(t, b.Len() >= 1001, true)
+	testutil.AssertEqual(t, b.String(), "{0 63 64 127 128 1000}")
+
+	b.Clear(63)
+	b.Clear(64)
+	b.Clear(5000) // clearing past the end is a no-op
+	testutil.AssertEqual(t, b.Test(63), false)
+	testutil.AssertEqual(t, b.Test(64), false)
+	testutil.AssertEqual(t, b.String(), "{0 127 128 1000}")
+}
+
+func TestBitSetCount(t *testing.T) {
+	b := NewBitSet(256)
+	testutil.AssertEqual(t, b.Count(), 0)
+	testutil.AssertEqual(t, b.Len(), 256)
+	for i := 0; i < 256; i += 3 {
+		b.Set(i)
+	}
+	testutil.AssertEqual(t, b.Count(), 86)
+	b.Set(0) // setting twice doesn't double count
+	testutil.AssertEqual(t, b.Count(), 86)
+	testutil.AssertEqual(t, len(b.Members()), 86)
+}
+
+func TestBitSetOperations(t *testing.T) {
+	short := bitSetOf(1, 5, 63)
+	long := bitSetOf(5, 64, 200)
+
+	testutil.AssertEqual(t, short.Union(long).String(), "{1 5 63 64 200}")
+	testutil.AssertEqual(t, long.Union(short).String(), "{1 5 63 64 200}")
+	testutil.AssertEqual(t, short.Intersect(long).String(), "{5}")
+	testutil.AssertEqual(t, long.Intersect(short).String(), "{5}")
+	testutil.AssertEqual(t, short.Difference(long).String(), "{1 63}")
+	testutil.AssertEqual(t, long.Difference(short).String(), "{64 200}")
+
+	// The operands are left untouched
+	testutil.AssertEqual(t, short.String(), "{1 5 63}")
+	testutil.AssertEqual(t, long.String(), "{5 64 200}")
+}
+
+func TestBitSetNegativeIndexPanics(t *testing.T) {
+	defer func() {
+		r := recover()
+		testutil.AssertEqual(t, r, interface{}("bitset: negative index -1"))
+	}()
+	var b BitSet
+	b.Set(-1)
+}
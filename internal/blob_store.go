@@ -0,0 +1,120 @@
+// blob_store.go
+package internal
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// ErrBlobNotFound is returned for a hash the store does not hold
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore keeps content on disk under its SHA-256 hash, so storing the
+// same bytes twice keeps a single copy. Blobs live in two-character prefix
+// directories (ab/abcdef...) to keep any one directory small. Writes go
+// through a temporary file and a rename, so readers never see a partial
+// blob.
+type BlobStore struct {
+	root string
+}
+
+// NewBlobStore opens a store rooted at dir, creating it if needed
+func NewBlobStore(dir string) (*BlobStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create blob store: %w", err)
+	}
+	return &BlobStore{root: dir}, nil
+}
+
+// Put stores everything read from r and returns its hex SHA-256 hash
+func (s *BlobStore) Put(r io.Reader) (hash string, err error) {
+	tmp, err := os.CreateTemp(s.root, ".incoming-*")
+	if err != nil {
+		return "", fmt.Errorf("put blob: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tmp, hasher), r); err != nil {
+		return "", fmt.Errorf("put blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("put blob: %w", err)
+	}
+
+	hash = hex.EncodeToString(hasher.Sum(nil))
+	path := s.path(hash)
+	if _, statErr := os.Stat(path); statErr == nil {
+		os.Remove(tmp.Name()) // already stored
+		return hash, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", fmt.Errorf("put blob: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("put blob: %w", err)
+	}
+	return hash, nil
+}
+
+// Get opens the blob with the given hash; the caller must close it
+func (s *BlobStore) Get(hash string) (io.ReadCloser, error) {
+	if !validBlobHash(hash) {
+		return nil, fmt.Errorf("get %q: %w", hash, ErrBlobNotFound)
+	}
+	file, err := os.Open(s.path(hash))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("get %s: %w", hash, ErrBlobNotFound)
+	}
+	return file, err
+}
+
+// Has reports whether the store holds a blob with the given hash
+func (s *BlobStore) Has(hash string) bool {
+	if !validBlobHash(hash) {
+		return false
+	}
+	_, err := os.Stat(s.path(hash))
+	return err == nil
+}
+
+// Delete removes the blob with the given hash
+func (s *BlobStore) Delete(hash string) error {
+	if !validBlobHash(hash) {
+		return fmt.Errorf("delete %q: %w", hash, ErrBlobNotFound)
+	}
+	err := os.Remove(s.path(hash))
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("delete %s: %w", hash, ErrBlobNotFound)
+	}
+	return err
+}
+
+func (s *BlobStore) path(hash string) string {
+	return filepath.Join(s.root, hash[:2], hash)
+}
+
+// validBlobHash accepts only lowercase hex SHA-256 digests, which also
+// keeps caller-supplied hashes from escaping the store's directory
+func validBlobHash(hash string) bool {
+	if len(hash) != sha256.Size*2 {
+		return false
+	}
+	for _, c := range hash {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+// blob_store_test.go
+package internal
+
+import (
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// helloHash is the SHA-256 of "hello world"
+const helloHash = "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+
+// storedFiles counts the files under root, ignoring directories
+func storedFiles(t *testing.T, root string) int {
+	t.Helper()
+	count := 0
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			count++
+		}
+		return err
+	})
+	testutil.AssertNoError(t, err)
+	return count
+}
+
+func TestBlobStoreDeduplicates(t *testing.T) {
+	root := t.TempDir()
+	store, err := NewBlobStore(root)
+	testutil.AssertNoError(t, err)
+
+	first, err := store.Put(strings.NewReader("hello world"))
+	testutil.AssertNoError(t, err)
+	second, err := store.Put(strings.NewReader("hello world"))
+	testutil.AssertNoError(t, err)
+
+	testutil.AssertEqual(t, first, helloHash)
+	testutil.AssertEqual(t, second, first)
+	testutil.AssertEqual(t, storedFiles(t, root), 1)
+	testutil.AssertEqual(t, store.path(first), filepath.Join(root, "b9", helloHash))
+}
+
+func TestBlobStoreGet(t *testing.T) {
+	store, _ := NewBlobStore(t.TempDir())
+	hash, _ := store.Put(strings.NewReader("hello world"))
+
+	rc, err := store.Get(hash)
+	testutil.AssertNoError(t, err)
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, string(data), "hello world")
+	testutil.AssertEqual(t, store.Has(hash), true)
+}
+
+func TestBlobStoreNotFound(t *testing.T) {
+	store, _ := NewBlobStore(t.TempDir())
+	unknown := strings.Repeat("0", 64)
+
+	_, err := store.Get(unknown)
+	testutil.AssertErrorIs(t, err, ErrBlobNotFound)
+	testutil.AssertEqual(t, store.Has(unknown), false)
+	testutil.AssertErrorIs(t, store.Delete(unknown), ErrBlobNotFound)
+
+	// Anything other than a lowercase hex digest is rejected outright
+	for _, bad := range []string{"", "../../etc/passwd", strings.ToUpper(helloHash)} {
+		_, err := store.Get(bad)
+		testutil.AssertErrorIs(t, err, ErrBlobNotFound)
+		testutil.AssertEqual(t, store.Has(bad), false)
+	}
+}
+
+func TestBlobStoreDelete(t *testing.T) {
+	root := t.TempDir()
+	store, _ := NewBlobStore(root)
+	hash, _ := store.Put(strings.NewReader("temporary"))
+
+	testutil.AssertNoError(t, store.Delete(hash))
+	testutil.AssertEqual(t, store.Has(hash), false)
+	testutil.AssertErrorIs(t, store.Delete(hash), ErrBlobNotFound)
+}
+
+func TestBlobStoreFailedPutLeavesNothing(t *testing.T) {
+	root := t.TempDir()
+	store, _ := NewBlobStore(root)
+	_, err := store.Put(io.MultiReader(strings.NewReader("partial"), iotest.ErrReader(io.ErrUnexpectedEOF)))
+	testutil.AssertErrorIs(t, err, io.ErrUnexpectedEOF)
+	testutil.AssertEqual(t, storedFiles(t, root), 0)
+}
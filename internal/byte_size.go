@@ -0,0 +1,101 @@
+// byte_size.go
+package internal
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ByteUnits selects the multiplier used by FormatBytes
+type ByteUnits int
+
+const (
+	IECUnits ByteUnits = iota // 1 KB = 1024 B (default)
+	SIUnits                   // 1 KB = 1000 B
+)
+
+var byteSuffixes = []string{"B", "KB", "MB", "GB", "TB", "PB", "EB"}
+
+// FormatBytes renders a byte count such as 1536 as "1.5 KB". Binary (IEC)
+// multiples are used unless SIUnits is passed.
+func FormatBytes(n int64, units ...ByteUnits) string {
+	base := 1024.0
+	if len(units) > 0 && units[0] == SIUnits {
+		base = 1000
+	}
+
+	sign := ""
+	value := float64(n)
+	if n < 0 {
+		sign = "-"
+		value = -value
+	}
+
+	if value < base {
+		return fmt.Sprintf("%s%d B", sign, int64(value))
+	}
+
+	exp := 0
+	for value >= base && exp < len(byteSuffixes)-1 {
+		value /= base
+		exp++
+	}
+	// Avoid "1024.0 KB" when rounding pushes the value up to the next unit
+	if math.Round(value*10)/10 >= base && exp < len(byteSuffixes)-1 {
+		value /= base
+		exp++
+	}
+
+	return fmt.Sprintf("%s%.1f %s", sign, value, byteSuffixes[exp])
+}
+
+// ParseBytes is the inverse of FormatBytes. It accepts values such as
+// "512", "1.5 KB", "2MB" or "3GiB"; unit letters are case-insensitive and
+// KB/MB/... use binary multiples, matching FormatBytes' default.
+func ParseBytes(s string) (int64, error) {
+	text := strings.TrimSpace(s)
+	if text == "" {
+		return 0, fmt.Errorf("parse bytes %q: empty value", s)
+	}
+
+	// Split into the numeric part and the unit suffix
+	i := 0
+	for i < len(text) && (text[i] == '-' || text[i] == '+' || text[i] == '.' || (text[i] >= '0' && text[i] <= '9')) {
+		i++
+	}
+	numPart := text[:i]
+	unit := strings.ToUpper(strings.TrimSpace(text[i:]))
+
+	value, err := strconv.ParseFloat(numPart, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse bytes %q: invalid number", s)
+	}
+
+	unit = strings.TrimSuffix(strings.Replace(unit, "IB", "B", 1), "B")
+	multiplier := 1.0
+	switch unit {
+	case "":
+	case "K":
+		multiplier = 1 << 10
+	case "M":
+		multiplier = 1 << 20
+	case "G":
+		multiplier = 1 << 30
+	case "T":
+		multiplier = 1 << 40
+	case "P":
+		multiplier = 1 << 50
+	case "E":
+		multiplier = 1 << 60
+	default:
+		return 0, fmt.Errorf("parse bytes %q: unknown unit", s)
+	}
+
+	result := value * multiplier
+	if result >= math.MaxInt64 || result < math.MinInt64 {
+		return 0, fmt.Errorf("parse bytes %q: value out of range", s)
+	}
+	return int64(math.Round(result)), nil
+}
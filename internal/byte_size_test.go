@@ -0,0 +1,64 @@
+// byte_size_test.go
+package internal
+
+import (
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1024, "1.0 KB"},
+		{1536, "1.5 KB"},
+		{2 << 20, "2.0 MB"},
+		{1<<20 - 1, "1.0 MB"}, // rounds up to the next unit, not "1024.0 KB"
+		{-1536, "-1.5 KB"},
+		{-5, "-5 B"},
+	}
+	for _, tt := range tests {
+		testutil.AssertEqual(t, FormatBytes(tt.n), tt.want)
+	}
+
+	testutil.AssertEqual(t, FormatBytes(999, SIUnits), "999 B")
+	testutil.AssertEqual(t, FormatBytes(1000, SIUnits), "1.0 KB")
+	testutil.AssertEqual(t, FormatBytes(3_400_000_000, SIUnits), "3.4 GB")
+}
+
+func TestParseBytes(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int64
+	}{
+		{"512", 512},
+		{"1.5 KB", 1536},
+		{"1.5KB", 1536},
+		{" 2mb ", 2 << 20},
+		{"3GiB", 3 << 30},
+		{"-1 K", -1024},
+	}
+	for _, tt := range tests {
+		got, err := ParseBytes(tt.in)
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, got, tt.want)
+	}
+
+	for _, in := range []string{"", "KB", "12 XB", "9999 EB"} {
+		if _, err := ParseBytes(in); err == nil {
+			t.Errorf("ParseBytes(%q) succeeded, want an error", in)
+		}
+	}
+}
+
+func TestParseBytesRoundTrip(t *testing.T) {
+	for _, n := range []int64{0, 1023, 1024, 1536, 5 << 30} {
+		got, err := ParseBytes(FormatBytes(n))
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, got, n)
+	}
+}
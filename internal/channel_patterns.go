@@ -0,0 +1,276 @@
+// channel_patterns.go
+package internal
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Merge fans several input channels into one. The output closes once every
+// input has closed or ctx is cancelled; no forwarding goroutine outlives it.
+func Merge[T any](ctx context.Context, chans ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+
+	forward := func(in <-chan T) {
+		defer wg.Done()
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case out <- v:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+
+	wg.Add(len(chans))
+	for _, in := range chans {
+		go forward(in)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+// Split distributes values from in across n outputs in round-robin order.
+// All outputs close when in closes or ctx is cancelled.
+func Split[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	if n < 1 {
+		n = 1
+	}
+
+	outs := make([]chan T, n)
+	result := make([]<-chan T, n)
+	for i := range outs {
+		outs[i] = make(chan T)
+		result[i] = outs[i]
+	}
+
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+
+		next := 0
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case outs[next] <- v:
+				case <-ctx.Done():
+					return
+				}
+				next = (next + 1) % n
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return result
+}
+
+// Broadcast delivers every value from in to all n outputs. Each value is
+// sent to every output before the next one is read, so the slowest
+// consumer sets the pace. All outputs close when in closes or ctx is
+// cancelled.
+func Broadcast[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	if n < 1 {
+		n = 1
+	}
+
+	outs := make([]chan T, n)
+	result := make([]<-chan T, n)
+	for i := range outs {
+		outs[i] = make(chan T)
+		result[i] = outs[i]
+	}
+
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				for _, out := range outs {
+					select {
+					case out <- v:
+					case <-ctx.Done():
+						return
+					}
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return result
+}
+
+// Dispatch applies fn to values from in using workers goroutines but emits
+// the results in the order the inputs arrived. Results that finish early
+// wait in a reorder buffer keyed by sequence number; at most 2*workers
+// values are in flight, so one slow item cannot make the buffer grow
+// without bound. The output closes once in closes and every result has
+// been sent, or when ctx is cancelled.
+func Dispatch[I, O any](ctx context.Context, in <-chan I, fn func(I) O, workers int) <-chan O {
+	if workers < 1 {
+		workers = 1
+	}
+
+	type job struct {
+		seq int
+		v   I
+	}
+	type result struct {
+		seq int
+		v   O
+	}
+
+	out := make(chan O)
+	jobs := make(chan job)
+	results := make(chan result)
+	window := make(chan struct{}, 2*workers) // one token per in-flight value
+
+	// Sequencer: number each input, waiting for room in the window
+	go func() {
+		defer close(jobs)
+		for seq := 0; ; seq++ {
+			select {
+			case window <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case jobs <- job{seq, v}:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				select {
+				case results <- result{j.seq, fn(j.v)}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// Reorderer: release results strictly by sequence number
+	go func() {
+		defer close(out)
+		pending := make(map[int]O)
+		next := 0
+		for r := range results {
+			pending[r.seq] = r.v
+			for {
+				v, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				select {
+				case out <- v:
+				case <-ctx.Done():
+					return
+				}
+				<-window
+				next++
+			}
+		}
+	}()
+
+	return out
+}
+
+// AggregateOverTime collects values from in and hands them to flush in
+// batches, one batch per window; windows with no values are skipped. When
+// in closes or ctx is cancelled, whatever has accumulated is flushed one
+// last time. It returns nil once in closes, or ctx's error on
+// cancellation. flush runs on the calling goroutine and may keep the batch.
+// A window <= 0 would flush in a busy loop, so it is rejected with an error
+// before anything is read.
+func AggregateOverTime[T any](ctx context.Context, in <-chan T, window time.Duration, flush func([]T)) error {
+	return aggregateOverTime(ctx, in, window, flush, nil)
+}
+
+// aggregateOverTime is AggregateOverTime with an injectable clock
+func aggregateOverTime[T any](ctx context.Context, in <-chan T, window time.Duration, flush func([]T), clock Clock) error {
+	if window <= 0 {
+		return fmt.Errorf("AggregateOverTime: window must be positive, got %s", window)
+	}
+	clock = clockOrSystem(clock)
+
+	var batch []T
+	emit := func() {
+		if len(batch) > 0 {
+			flush(batch)
+			batch = nil
+		}
+	}
+
+	tick := clock.After(window)
+	for {
+		select {
+		case v, ok := <-in:
+			if !ok {
+				emit()
+				return nil
+			}
+			batch = append(batch, v)
+		case <-tick:
+			emit()
+			tick = clock.After(window)
+		case <-ctx.Done():
+			emit()
+			return ctx.Err()
+		}
+	}
+}
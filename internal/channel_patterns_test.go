@@ -0,0 +1,249 @@
+// channel_patterns_test.go
+package internal
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// sendAll returns a channel that yields values and then closes
+func sendAll[T any](values ...T) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		for _, v := range values {
+			ch <- v
+		}
+	}()
+	return ch
+}
+
+// drain reads ch until it closes, failing t if that takes too long
+func drain[T any](t *testing.T, ch <-chan T) []T {
+	t.Helper()
+	var got []T
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return got
+			}
+			got = append(got, v)
+		case <-timeout:
+			t.Fatalf("channel did not close; got %v so far", got)
+			return got
+		}
+	}
+}
+
+// settleGoroutines waits briefly for finished goroutines to exit and
+// reports the count
+func settleGoroutines(limit int) int {
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > limit && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	return runtime.NumGoroutine()
+}
+
+func TestMergeDeliversEverything(t *testing.T) {
+	merged := Merge(context.Background(), sendAll(1, 2, 3), sendAll(4, 5), sendAll(6))
+	got := drain(t, merged)
+	sort.Ints(got)
+	testutil.AssertEqual(t, len(got), 6)
+	for i, v := range got {
+		testutil.AssertEqual(t, v, i+1)
+	}
+}
+
+func TestMergeCancellationClosesOutput(t *testing.T) {
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	never := make(chan int) // never closes
+	merged := Merge(ctx, never, never)
+	cancel()
+	drain(t, merged)
+	if n := settleGoroutines(before); n > before {
+		t.Errorf("%d goroutines after cancellation, want at most %d", n, before)
+	}
+}
+
+func TestSplitRoundRobin(t *testing.T) {
+	outs := Split(context.Background(), sendAll(0, 1, 2, 3, 4, 5), 3)
+	testutil.AssertEqual(t, len(outs), 3)
+
+	// read in round-robin order, since Split blocks on each output in turn
+	got := make([][]int, 3)
+	for i := 0; i < 6; i++ {
+		got[i%3] = append(got[i%3], <-outs[i%3])
+	}
+	for i, out := range outs {
+		testutil.AssertEqual(t, len(drain(t, out)), 0)
+		testutil.AssertEqual(t, got[i][0], i)
+		testutil.AssertEqual(t, got[i][1], i+3)
+	}
+}
+
+func TestSplitCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	outs := Split(ctx, make(chan int), 2)
+	cancel()
+	for _, out := range outs {
+		drain(t, out)
+	}
+}
+
+func TestBroadcastSlowAndFastConsumers(t *testing.T) {
+	before := runtime.NumGoroutine()
+	values := []int{1, 2, 3, 4, 5}
+	outs := Broadcast(context.Background(), sendAll(values...), 2)
+
+	results := make(chan []int, 2)
+	for i, out := range outs {
+		delay := time.Duration(i) * time.Millisecond // the second consumer is slower
+		go func(out <-chan int) {
+			var got []int
+			for v := range out {
+				time.Sleep(delay)
+				got = append(got, v)
+			}
+			results <- got
+		}(out)
+	}
+
+	for i := 0; i < 2; i++ {
+		got := <-results
+		testutil.AssertEqual(t, len(got), len(values))
+		for j, v := range got {
+			testutil.AssertEqual(t, v, values[j])
+		}
+	}
+	if n := settleGoroutines(before); n > before {
+		t.Errorf("%d goroutines after the input closed, want at most %d", n, before)
+	}
+}
+
+func TestBroadcastCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	outs := Broadcast(ctx, make(chan string), 3)
+	cancel()
+	for _, out := range outs {
+		drain(t, out)
+	}
+}
+
+func TestDispatchPreservesOrder(t *testing.T) {
+	inputs := make([]int, 50)
+	for i := range inputs {
+		inputs[i] = i
+	}
+	slowThenFast := func(n int) int {
+		// Early items are the slowest, so later ones finish first
+		time.Sleep(time.Duration((50-n)%7) * time.Millisecond)
+		return n * 10
+	}
+
+	got := drain(t, Dispatch(context.Background(), sendAll(inputs...), slowThenFast, 4))
+	testutil.AssertEqual(t, len(got), len(inputs))
+	for i, v := range got {
+		testutil.AssertEqual(t, v, i*10)
+	}
+}
+
+func TestDispatchSingleWorkerAndEmptyInput(t *testing.T) {
+	got := drain(t, Dispatch(context.Background(), sendAll("a", "b"), func(s string) string { return s + s }, 0))
+	testutil.AssertEqual(t, len(got), 2)
+	testutil.AssertEqual(t, got[1], "bb")
+
+	testutil.AssertEqual(t, len(drain(t, Dispatch(context.Background(), sendAll[int](), func(n int) int { return n }, 3))), 0)
+}
+
+func TestDispatchCancellation(t *testing.T) {
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int) // never closed
+	out := Dispatch(ctx, in, func(n int) int { return n }, 3)
+
+	in <- 1
+	testutil.AssertEqual(t, <-out, 1)
+	cancel()
+	drain(t, out)
+	if n := settleGoroutines(before); n > before {
+		t.Errorf("goroutines leaked: %d before, %d after", before, n)
+	}
+}
+
+// runAggregator starts aggregateOverTime on a manual clock, reporting each
+// flushed batch and the final error on the returned channels
+func runAggregator(ctx context.Context, in <-chan int, clock Clock) (<-chan []int, <-chan error) {
+	batches := make(chan []int, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- aggregateOverTime(ctx, in, time.Second, func(batch []int) { batches <- batch }, clock)
+	}()
+	return batches, done
+}
+
+func TestAggregateOverTimeFlushesEachWindow(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	in := make(chan int)
+	batches, done := runAggregator(ctx, in, clock)
+
+	waitForWaiters(t, clock, 1)
+	in <- 1
+	in <- 2
+	clock.Advance(time.Second)
+	testutil.AssertEqual(t, fmt.Sprint(<-batches), "[1 2]")
+
+	waitForWaiters(t, clock, 1)
+	in <- 3
+	clock.Advance(time.Second)
+	testutil.AssertEqual(t, fmt.Sprint(<-batches), "[3]")
+
+	// An empty window flushes nothing
+	waitForWaiters(t, clock, 1)
+	clock.Advance(time.Second)
+	waitForWaiters(t, clock, 1)
+	testutil.AssertEqual(t, len(batches), 0)
+
+	in <- 4
+	cancel()
+	testutil.AssertErrorIs(t, <-done, context.Canceled)
+	testutil.AssertEqual(t, fmt.Sprint(<-batches), "[4]") // the partial batch on shutdown
+	testutil.AssertEqual(t, len(batches), 0)
+}
+
+func TestAggregateOverTimeFlushesOnClose(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	in := make(chan int)
+	batches, done := runAggregator(context.Background(), in, clock)
+
+	in <- 7
+	in <- 8
+	close(in)
+	testutil.AssertNoError(t, <-done)
+	testutil.AssertEqual(t, fmt.Sprint(<-batches), "[7 8]")
+}
+
+func TestAggregateOverTimeRejectsNonPositiveWindow(t *testing.T) {
+	in := sendAll(1, 2)
+	for _, window := range []time.Duration{0, -time.Second} {
+		flushed := false
+		err := AggregateOverTime(context.Background(), in, window, func([]int) { flushed = true })
+		if err == nil {
+			t.Fatalf("window %v was accepted", window)
+		}
+		testutil.AssertContains(t, err.Error(), "window must be positive, got "+window.String())
+		testutil.AssertEqual(t, flushed, false)
+	}
+	testutil.AssertEqual(t, len(drain(t, in)), 2) // nothing was consumed
+}
@@ -2,7 +2,9 @@
 package internal
 
 import (
+	"context"
 	"fmt"
+	"sync"
 	"time"
 )
 
@@ -16,6 +18,12 @@ func RunChannelExamples() {
 	channelCloseExample()
 	producerConsumerExample()
 	fanOutFanInExample()
+	splitMergeExample()
+	broadcastExample()
+	dispatchExample()
+	pubSubExample()
+	aggregateOverTimeExample()
+	movingAverageExample()
 }
 
 // Example 1: Basic unbuffered channel
@@ -25,12 +33,12 @@ func basicChannelExample() {
 	ch := make(chan string)
 
 	// Send data in a goroutine
-	go func() {
+	Go(func() {
 		ch <- "Hello"
 		ch <- "World"
 		ch <- "from"
 		ch <- "Channel"
-	}()
+	})
 
 	// Receive data
 	for i := 0; i < 4; i++ {
@@ -115,15 +123,15 @@ func channelSelectExample() {
 	ch1 := make(chan string)
 	ch2 := make(chan string)
 
-	go func() {
+	Go(func() {
 		time.Sleep(100 * time.Millisecond)
 		ch1 <- "Message from ch1"
-	}()
+	})
 
-	go func() {
+	Go(func() {
 		time.Sleep(200 * time.Millisecond)
 		ch2 <- "Message from ch2"
-	}()
+	})
 
 	// Select waits for first available channel
 	select {
@@ -228,29 +236,29 @@ func fanOutFanInExample() {
 	worker3 := make(chan int)
 
 	// Start workers
-	go func() {
+	Go(func() {
 		for n := range worker1 {
 			fmt.Printf("Worker 1 processing: %d\n", n)
 			time.Sleep(100 * time.Millisecond)
 		}
-	}()
+	})
 
-	go func() {
+	Go(func() {
 		for n := range worker2 {
 			fmt.Printf("Worker 2 processing: %d\n", n)
 			time.Sleep(150 * time.Millisecond)
 		}
-	}()
+	})
 
-	go func() {
+	Go(func() {
 		for n := range worker3 {
 			fmt.Printf("Worker 3 processing: %d\n", n)
 			time.Sleep(200 * time.Millisecond)
 		}
-	}()
+	})
 
 	// Fan-out goroutine
-	go func() {
+	Go(func() {
 		defer close(worker1)
 		defer close(worker2)
 		defer close(worker3)
@@ -262,20 +270,223 @@ func fanOutFanInExample() {
 			case worker3 <- n:
 			}
 		}
-	}()
+	})
 
 	// Send work
-	go func() {
+	Go(func() {
 		defer close(input)
 		for i := 1; i <= 9; i++ {
 			input <- i
 		}
-	}()
+	})
 
 	// Wait for processing
 	time.Sleep(3 * time.Second)
 }
 
+// Example 9: Split work round-robin and merge the results back
+func splitMergeExample() {
+	fmt.Println("\n=== Split and Merge Example ===")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	input := make(chan int)
+	Go(func() {
+		defer close(input)
+		for i := 1; i <= 9; i++ {
+			input <- i
+		}
+	})
+
+	// Each worker squares the values from its own split output
+	metrics := NewMetrics()
+	parts := Split(ctx, input, 3)
+	results := make([]<-chan string, len(parts))
+	for i, part := range parts {
+		out := make(chan string)
+		results[i] = out
+		go func(id int, in <-chan int) {
+			defer close(out)
+			for n := range in {
+				out <- fmt.Sprintf("worker %d: %d^2 = %d", id, n, n*n)
+				metrics.Inc(fmt.Sprintf("worker%d.processed", id))
+			}
+		}(i+1, part)
+	}
+
+	count := 0
+	for line := range Merge(ctx, results...) {
+		fmt.Println(line)
+		count++
+	}
+	fmt.Printf("Merged %d results\n", count)
+	for _, name := range metrics.Names() {
+		fmt.Printf("  %s: %d\n", name, metrics.Get(name))
+	}
+}
+
+// Example 10: Broadcast every value to consumers running at different speeds
+func broadcastExample() {
+	fmt.Println("\n=== Broadcast Example ===")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	input := make(chan int)
+	Go(func() {
+		defer close(input)
+		for i := 1; i <= 5; i++ {
+			input <- i
+		}
+	})
+
+	outs := Broadcast(ctx, input, 2)
+	delays := []time.Duration{10 * time.Millisecond, 50 * time.Millisecond}
+
+	var wg sync.WaitGroup
+	for i, out := range outs {
+		wg.Add(1)
+		go func(id int, in <-chan int, delay time.Duration) {
+			defer wg.Done()
+			var received []int
+			for n := range in {
+				time.Sleep(delay)
+				received = append(received, n)
+			}
+			fmt.Printf("Consumer %d (%v per item) received: %v\n", id, delay, received)
+		}(i+1, out, delays[i])
+	}
+	wg.Wait()
+}
+
+// Example 11: Concurrent processing with results kept in input order
+func dispatchExample() {
+	fmt.Println("\n=== Ordered Dispatch Example ===")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	input := make(chan int)
+	Go(func() {
+		defer close(input)
+		for i := 1; i <= 8; i++ {
+			input <- i
+		}
+	})
+
+	// Odd inputs are slow, so workers finish out of order
+	var finished []int
+	var mu sync.Mutex
+	slowSquare := func(n int) string {
+		delay := 10 * time.Millisecond
+		if n%2 == 1 {
+			delay = 40 * time.Millisecond
+		}
+		time.Sleep(delay)
+		mu.Lock()
+		finished = append(finished, n)
+		mu.Unlock()
+		return fmt.Sprintf("%d²=%d", n, n*n)
+	}
+
+	var results []string
+	for r := range Dispatch(ctx, input, slowSquare, 4) {
+		results = append(results, r)
+	}
+	fmt.Printf("Finished in order: %v\n", finished)
+	fmt.Printf("Emitted in order:  %v\n", results)
+}
+
+// Example 12: Topic-based publish/subscribe with wildcards
+func pubSubExample() {
+	fmt.Println("\n=== Pub/Sub Example ===")
+
+	bus := NewPubSub(2, DropWhenFull)
+	created := bus.Subscribe("orders.created")
+	allOrders := bus.Subscribe("orders.*")
+	everything := bus.Subscribe("*")
+
+	events := []Message{
+		{"orders.created", "order-1"},
+		{"orders.shipped", "order-1"},
+		{"users.signup", "alice"},
+		{"orders.created", "order-2"},
+	}
+	for _, event := range events {
+		n := bus.Publish(event.Topic, event.Payload)
+		fmt.Printf("Published %-15s %-8v -> %d subscribers\n", event.Topic, event.Payload, n)
+	}
+	bus.Close()
+
+	drain := func(name string, ch <-chan Message) {
+		var got []string
+		for msg := range ch {
+			got = append(got, fmt.Sprintf("%s:%v", msg.Topic, msg.Payload))
+		}
+		fmt.Printf("%-15s received %v\n", name, got)
+	}
+	drain("orders.created", created)
+	drain("orders.*", allOrders)
+	drain("*", everything) // buffer of 2, so the later events were dropped
+	fmt.Printf("Dropped deliveries: %d\n", bus.Dropped())
+}
+
+// Example 13: Batch values per time window, flushing the rest on shutdown
+func aggregateOverTimeExample() {
+	fmt.Println("\n=== Windowed Aggregation Example ===")
+
+	// A manual clock makes the window boundaries deterministic
+	clock := NewManualClock(time.Now())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := make(chan string)
+	batches := make(chan []string)
+	done := make(chan error, 1)
+	Go(func() {
+		done <- aggregateOverTime(ctx, events, time.Second, func(batch []string) {
+			batches <- batch
+		}, clock)
+	})
+
+	events <- "click"
+	events <- "scroll"
+	events <- "click"
+	clock.Advance(time.Second)
+	fmt.Printf("Window 1 flushed: %v\n", <-batches)
+
+	events <- "purchase"
+	events <- "logout"
+	cancel() // shut down mid-window
+	fmt.Printf("Flushed on shutdown: %v\n", <-batches)
+	fmt.Printf("Aggregator returned: %v\n", <-done)
+}
+
+// Example 14: Smooth a stream of readings with a moving average
+func movingAverageExample() {
+	fmt.Println("\n=== Moving Average Example ===")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	values := []float64{10, 20, 30, 40, 100, 40, 30}
+	readings := make(chan float64)
+	Go(func() {
+		defer close(readings)
+		for _, v := range values {
+			readings <- v
+		}
+	})
+
+	// The first two averages cover only the values seen so far
+	i := 0
+	for avg := range MovingAverage(ctx, readings, 3) {
+		fmt.Printf("reading %5.1f -> avg(3) %5.1f\n", values[i], avg)
+		i++
+	}
+}
+
 // Additional helper functions
 func pingPong(ping chan<- string, pong <-chan string) {
 	for i := 0; i < 3; i++ {
@@ -0,0 +1,49 @@
+// chunk_reader.go
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ReadChunksConcurrent reads the first size bytes of ra in chunkSize pieces
+// using workers goroutines. Each chunk gets its own io.SectionReader, so
+// the reads never share a file offset, and lands directly in its slot of
+// the result, which keeps the bytes in source order. The last chunk may be
+// shorter than chunkSize; a source shorter than size is an error.
+func ReadChunksConcurrent(ra io.ReaderAt, size int64, chunkSize int64, workers int) ([]byte, error) {
+	if size < 0 {
+		return nil, fmt.Errorf("negative size %d", size)
+	}
+	if chunkSize <= 0 {
+		return nil, fmt.Errorf("chunk size must be positive, got %d", chunkSize)
+	}
+
+	buf := make([]byte, size)
+	var offsets []int64
+	for off := int64(0); off < size; off += chunkSize {
+		offsets = append(offsets, off)
+	}
+
+	_, err := FanOut(context.Background(), offsets, workers, func(ctx context.Context, off int64) (int, error) {
+		end := off + chunkSize
+		if end > size {
+			end = size
+		}
+		section := io.NewSectionReader(ra, off, end-off)
+		n, err := io.ReadFull(section, buf[off:end])
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return n, fmt.Errorf("chunk at offset %d: %w", off, err)
+		}
+		return n, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
@@ -0,0 +1,61 @@
+// chunk_reader_test.go
+package internal
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func patternBytes(n int) []byte {
+	data := make([]byte, n)
+	for i := range data {
+		data[i] = byte(i*7 + i/251)
+	}
+	return data
+}
+
+func TestReadChunksConcurrentReassembles(t *testing.T) {
+	cases := []struct {
+		size, chunk int64
+		workers     int
+	}{
+		{0, 4, 2},
+		{1, 4, 2},
+		{64, 16, 4},  // exact multiple
+		{100, 16, 4}, // final partial chunk
+		{100, 1000, 3},
+		{4099, 512, 1},
+		{10000, 7, 8},
+	}
+	for _, c := range cases {
+		src := patternBytes(int(c.size))
+		got, err := ReadChunksConcurrent(bytes.NewReader(src), c.size, c.chunk, c.workers)
+		testutil.AssertNoError(t, err)
+		if !bytes.Equal(got, src) {
+			t.Errorf("size %d chunk %d: reassembled bytes differ from the source", c.size, c.chunk)
+		}
+	}
+}
+
+func TestReadChunksConcurrentPrefix(t *testing.T) {
+	src := patternBytes(50)
+	got, err := ReadChunksConcurrent(bytes.NewReader(src), 30, 8, 3)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, bytes.Equal(got, src[:30]), true)
+}
+
+func TestReadChunksConcurrentErrors(t *testing.T) {
+	src := bytes.NewReader(patternBytes(20))
+
+	_, err := ReadChunksConcurrent(src, 40, 8, 2)
+	testutil.AssertErrorIs(t, err, io.ErrUnexpectedEOF)
+	testutil.AssertContains(t, err.Error(), "chunk at offset ")
+
+	_, err = ReadChunksConcurrent(src, -1, 8, 2)
+	testutil.AssertEqual(t, err.Error(), "negative size -1")
+	_, err = ReadChunksConcurrent(src, 10, 0, 2)
+	testutil.AssertEqual(t, err.Error(), "chunk size must be positive, got 0")
+}
@@ -0,0 +1,144 @@
+// circuit_breaker.go
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by Execute while the breaker is rejecting calls
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the position of a CircuitBreaker
+type CircuitState int
+
+const (
+	CircuitClosed   CircuitState = iota // calls pass through, failures are counted
+	CircuitOpen                         // calls fail fast until the cooldown ends
+	CircuitHalfOpen                     // one probe call decides whether to close again
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// CircuitBreaker stops calling a dependency that keeps failing. After
+// threshold consecutive failures it opens and rejects calls with
+// ErrCircuitOpen; once cooldown has passed it lets a single probe through
+// (half-open), closing on success and reopening on failure. It is safe for
+// concurrent use.
+type CircuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	clock     Clock
+	state     CircuitState
+	failures  int
+	openedAt  time.Time
+	probing   bool
+}
+
+// NewCircuitBreaker creates a closed breaker. A nil clock means the system
+// clock; a threshold below 1 is treated as 1.
+func NewCircuitBreaker(threshold int, cooldown time.Duration, clock Clock) *CircuitBreaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+	return &CircuitBreaker{threshold: threshold, cooldown: cooldown, clock: clockOrSystem(clock)}
+}
+
+// State reports the breaker's current state. An open breaker whose
+// cooldown has passed reports half-open, since the next call will probe.
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if cb.state == CircuitOpen && !cb.clock.Now().Before(cb.openedAt.Add(cb.cooldown)) {
+		return CircuitHalfOpen
+	}
+	return cb.state
+}
+
+// Execute runs fn unless the breaker is open, recording the outcome. A
+// context that is already done returns its error without calling fn or
+// counting as a failure. A panic in fn counts as a failure (so a panicking
+// probe does not leave the breaker stuck half-open) and is re-raised.
+func (cb *CircuitBreaker) Execute(ctx context.Context, fn func() error) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := cb.admit(); err != nil {
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			cb.record(fmt.Errorf("circuit breaker call panicked: %v", r))
+			panic(r)
+		}
+		cb.record(err)
+	}()
+	return fn()
+}
+
+// admit decides whether a call may proceed, moving an open breaker to
+// half-open once its cooldown is over
+func (cb *CircuitBreaker) admit() error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	switch cb.state {
+	case CircuitOpen:
+		if cb.clock.Now().Before(cb.openedAt.Add(cb.cooldown)) {
+			return ErrCircuitOpen
+		}
+		cb.state = CircuitHalfOpen
+		fallthrough
+	case CircuitHalfOpen:
+		if cb.probing {
+			return ErrCircuitOpen // only one probe at a time
+		}
+		cb.probing = true
+	}
+	return nil
+}
+
+func (cb *CircuitBreaker) record(err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if cb.state == CircuitHalfOpen {
+		cb.probing = false
+		if err != nil {
+			cb.trip()
+			return
+		}
+		cb.state = CircuitClosed
+		cb.failures = 0
+		return
+	}
+
+	if err == nil {
+		cb.failures = 0
+		return
+	}
+	cb.failures++
+	if cb.failures >= cb.threshold {
+		cb.trip()
+	}
+}
+
+// trip opens the breaker, starting a fresh cooldown
+func (cb *CircuitBreaker) trip() {
+	cb.state = CircuitOpen
+	cb.openedAt = cb.clock.Now()
+	cb.failures = 0
+}
@@ -0,0 +1,91 @@
+// circuit_breaker_test.go
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+var errBreakerTest = errors.New("dependency down")
+
+func failing() error    { return errBreakerTest }
+func succeeding() error { return nil }
+
+func TestCircuitBreakerTransitions(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	cb := NewCircuitBreaker(2, time.Second, clock)
+	ctx := context.Background()
+
+	testutil.AssertErrorIs(t, cb.Execute(ctx, failing), errBreakerTest)
+	testutil.AssertEqual(t, cb.State(), CircuitClosed)
+	testutil.AssertErrorIs(t, cb.Execute(ctx, failing), errBreakerTest)
+	testutil.AssertEqual(t, cb.State(), CircuitOpen)
+
+	called := false
+	err := cb.Execute(ctx, func() error { called = true; return nil })
+	testutil.AssertErrorIs(t, err, ErrCircuitOpen)
+	testutil.AssertEqual(t, called, false)
+
+	clock.Advance(time.Second)
+	testutil.AssertEqual(t, cb.State(), CircuitHalfOpen)
+	testutil.AssertErrorIs(t, cb.Execute(ctx, failing), errBreakerTest)
+	testutil.AssertEqual(t, cb.State(), CircuitOpen)
+
+	clock.Advance(time.Second)
+	testutil.AssertNoError(t, cb.Execute(ctx, succeeding))
+	testutil.AssertEqual(t, cb.State(), CircuitClosed)
+}
+
+func TestCircuitBreakerSingleProbe(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	cb := NewCircuitBreaker(1, time.Second, clock)
+	ctx := context.Background()
+	cb.Execute(ctx, failing)
+	clock.Advance(time.Second)
+
+	err := cb.Execute(ctx, func() error {
+		// a second call while the probe is in flight is rejected
+		testutil.AssertErrorIs(t, cb.Execute(ctx, succeeding), ErrCircuitOpen)
+		return nil
+	})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, cb.State(), CircuitClosed)
+}
+
+func TestCircuitBreakerPanickingProbe(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	cb := NewCircuitBreaker(1, time.Second, clock)
+	ctx := context.Background()
+	cb.Execute(ctx, failing)
+	clock.Advance(time.Second)
+
+	func() {
+		defer func() {
+			testutil.AssertEqual(t, recover(), any("boom"))
+		}()
+		cb.Execute(ctx, func() error { panic("boom") })
+	}()
+
+	// the panic counted as a failed probe, so the breaker reopened
+	testutil.AssertEqual(t, cb.State(), CircuitOpen)
+	clock.Advance(time.Second)
+	testutil.AssertNoError(t, cb.Execute(ctx, succeeding))
+	testutil.AssertEqual(t, cb.State(), CircuitClosed)
+}
+
+func TestCircuitBreakerCancelledContext(t *testing.T) {
+	cb := NewCircuitBreaker(1, time.Second, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	testutil.AssertErrorIs(t, cb.Execute(ctx, failing), context.Canceled)
+	testutil.AssertEqual(t, cb.State(), CircuitClosed)
+}
+
+func TestCircuitStateString(t *testing.T) {
+	testutil.AssertEqual(t, CircuitHalfOpen.String(), "half-open")
+	testutil.AssertEqual(t, CircuitState(9).String(), "unknown")
+}
@@ -0,0 +1,207 @@
+// clone.go
+package internal
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+// Two ways to deep-copy a value:
+//
+//   - GobClone round-trips through encoding/gob. It is short and well
+//     tested, but only sees exported fields, cannot copy funcs or channels,
+//     loses pointer sharing and turns nil-vs-empty distinctions into
+//     whatever gob decodes. Good for plain data types such as DTOs.
+//   - DeepCopy walks the value with reflection. It copies unexported
+//     fields, keeps shared and cyclic pointers shared and cyclic, and is
+//     usually much faster, at the cost of more code to maintain.
+
+// GobClone deep-copies src by encoding and decoding it with encoding/gob.
+// Because gob silently drops unexported fields, GobClone refuses types that
+// have any (unless the type encodes itself, as time.Time does) instead of
+// returning a partial copy.
+func GobClone[T any](src T) (T, error) {
+	var dst T
+	if err := checkGobCloneable(reflect.TypeOf(src), map[reflect.Type]bool{}); err != nil {
+		return dst, err
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&src); err != nil {
+		return dst, fmt.Errorf("gob clone: encode: %w", err)
+	}
+	if err := gob.NewDecoder(&buf).Decode(&dst); err != nil {
+		return dst, fmt.Errorf("gob clone: decode: %w", err)
+	}
+	return dst, nil
+}
+
+var (
+	gobEncoderType      = reflect.TypeOf((*gob.GobEncoder)(nil)).Elem()
+	binaryMarshalerType = reflect.TypeOf((*encoding.BinaryMarshaler)(nil)).Elem()
+)
+
+// checkGobCloneable reports the first unexported struct field reachable
+// from t
+func checkGobCloneable(t reflect.Type, seen map[reflect.Type]bool) error {
+	if t == nil || seen[t] {
+		return nil
+	}
+	seen[t] = true
+	if t.Implements(gobEncoderType) || t.Implements(binaryMarshalerType) ||
+		reflect.PointerTo(t).Implements(gobEncoderType) || reflect.PointerTo(t).Implements(binaryMarshalerType) {
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array:
+		return checkGobCloneable(t.Elem(), seen)
+	case reflect.Map:
+		if err := checkGobCloneable(t.Key(), seen); err != nil {
+			return err
+		}
+		return checkGobCloneable(t.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				return fmt.Errorf("gob clone: %s has unexported field %s, which gob would silently drop", t, field.Name)
+			}
+			if err := checkGobCloneable(field.Type, seen); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DeepCopy returns a deep copy of src made with reflection. Maps, slices,
+// pointers and interfaces are duplicated recursively; pointers that are
+// shared (or cyclic) in src stay shared in the copy. Funcs and channels
+// are copied by reference.
+//
+// Unexported fields are copied too. Reflection refuses to write them, so
+// DeepCopy aliases them through package unsafe (see settable); this is
+// what lets it clone types that GobClone rejects, but it also means the
+// copy bypasses any invariants a type's own constructor or Clone method
+// would keep. A pointer into the middle of another copied value (such as
+// &s.Field next to &s) gets its own copy rather than pointing into the
+// copy of s.
+func DeepCopy[T any](src T) T {
+	var dst T
+	srcValue := reflect.ValueOf(&src).Elem()
+	dstValue := reflect.ValueOf(&dst).Elem()
+	deepCopyValue(dstValue, srcValue, map[pointerKey]reflect.Value{})
+	return dst
+}
+
+// pointerKey identifies what a pointer, map or slice refers to, for the
+// reflection walkers that track sharing or cycles; the type is part of the
+// key because a struct and its first field share an address
+type pointerKey struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+// pointerKeyOf returns the key for a pointer, map or slice value
+func pointerKeyOf(v reflect.Value) pointerKey {
+	return pointerKey{addr: v.Pointer(), typ: v.Type()}
+}
+
+// deepCopyValue copies src into the settable dst; copied maps pointer
+// targets by address and type so sharing survives
+func deepCopyValue(dst, src reflect.Value, copied map[pointerKey]reflect.Value) {
+	src = readable(src)
+	switch src.Kind() {
+	case reflect.Pointer:
+		if src.IsNil() {
+			return
+		}
+		key := pointerKeyOf(src)
+		if existing, ok := copied[key]; ok {
+			dst.Set(existing)
+			return
+		}
+		ptr := reflect.New(src.Type().Elem())
+		copied[key] = ptr
+		deepCopyValue(ptr.Elem(), src.Elem(), copied)
+		dst.Set(ptr)
+
+	case reflect.Interface:
+		if src.IsNil() {
+			return
+		}
+		inner := reflect.New(src.Elem().Type()).Elem()
+		deepCopyValue(inner, src.Elem(), copied)
+		dst.Set(inner)
+
+	case reflect.Struct:
+		dst.Set(src) // copies every field, exported or not, shallowly
+		if src.Type() == timeType {
+			return // time.Time is a value type; its *Location must stay shared
+		}
+		for i := 0; i < src.NumField(); i++ {
+			deepCopyValue(settable(dst.Field(i)), src.Field(i), copied)
+		}
+
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		slice := reflect.MakeSlice(src.Type(), src.Len(), src.Cap())
+		for i := 0; i < src.Len(); i++ {
+			deepCopyValue(slice.Index(i), src.Index(i), copied)
+		}
+		dst.Set(slice)
+
+	case reflect.Array:
+		for i := 0; i < src.Len(); i++ {
+			deepCopyValue(dst.Index(i), src.Index(i), copied)
+		}
+
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+		m := reflect.MakeMapWithSize(src.Type(), src.Len())
+		iter := src.MapRange()
+		for iter.Next() {
+			key := reflect.New(src.Type().Key()).Elem()
+			deepCopyValue(key, iter.Key(), copied)
+			value := reflect.New(src.Type().Elem()).Elem()
+			deepCopyValue(value, iter.Value(), copied)
+			m.SetMapIndex(key, value)
+		}
+		dst.Set(m)
+
+	default:
+		dst.Set(src)
+	}
+}
+
+// settable returns v, or for an unexported struct field a settable alias
+// of the same memory; reflection otherwise refuses to write such fields
+func settable(v reflect.Value) reflect.Value {
+	if v.CanSet() {
+		return v
+	}
+	return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+}
+
+// readable returns an addressable value holding v that reflection lets us
+// read from, even when v was reached through an unexported field.
+// Unaddressable values (map entries, interface contents) are copied first;
+// they are never read-only because their container was already made
+// readable.
+func readable(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return settable(v)
+	}
+	tmp := reflect.New(v.Type()).Elem()
+	tmp.Set(v)
+	return tmp
+}
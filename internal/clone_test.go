@@ -0,0 +1,116 @@
+// clone_test.go
+package internal
+
+import (
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+type cloneNode struct {
+	Name string
+	Next *cloneNode
+}
+
+type cloneInner struct {
+	X int
+	Y int
+}
+
+type cloneOuter struct {
+	A *cloneInner
+	B *int
+}
+
+func TestDeepCopyIsIndependent(t *testing.T) {
+	original := catalogSnapshot{
+		Owner:    AccountUser{ID: 1, Name: "Alice"},
+		Products: []Product{{Name: "Laptop", Price: 999.99}},
+		Stock:    map[string][]int{"Laptop": {4, 2}},
+		revision: 7,
+	}
+
+	clone := DeepCopy(original)
+	clone.Products[0].Price = 1
+	clone.Stock["Laptop"][0] = 0
+
+	testutil.AssertEqual(t, original.Products[0].Price, 999.99)
+	testutil.AssertEqual(t, original.Stock["Laptop"][0], 4)
+	testutil.AssertEqual(t, clone.revision, 7)
+}
+
+func TestDeepCopyKeepsSharingAndCycles(t *testing.T) {
+	shared := &cloneInner{X: 1}
+	pair := [2]*cloneInner{shared, shared}
+	copied := DeepCopy(pair)
+	testutil.AssertEqual(t, copied[0] == copied[1], true)
+	testutil.AssertEqual(t, copied[0] != shared, true)
+
+	ring := &cloneNode{Name: "a"}
+	ring.Next = &cloneNode{Name: "b", Next: ring}
+	ringCopy := DeepCopy(ring)
+	testutil.AssertEqual(t, ringCopy.Next.Next, ringCopy)
+	testutil.AssertEqual(t, ringCopy.Next.Name, "b")
+}
+
+func TestDeepCopyInteriorPointer(t *testing.T) {
+	inner := &cloneInner{X: 3, Y: 4}
+	src := cloneOuter{A: inner, B: &inner.X}
+
+	dst := DeepCopy(src) // used to panic: inner and inner.X share an address
+	testutil.AssertEqual(t, dst.A.X, 3)
+	testutil.AssertEqual(t, *dst.B, 3)
+	testutil.AssertEqual(t, dst.B != &inner.X, true)
+}
+
+func TestGobClone(t *testing.T) {
+	type plain struct {
+		Stock map[string][]int
+	}
+	src := plain{Stock: map[string][]int{"Laptop": {4, 2}}}
+	dst, err := GobClone(src)
+	testutil.AssertNoError(t, err)
+	dst.Stock["Laptop"][1] = 99
+	testutil.AssertEqual(t, src.Stock["Laptop"][1], 2)
+
+	_, err = GobClone(catalogSnapshot{})
+	if err == nil {
+		t.Fatal("GobClone accepted a type with an unexported field")
+	}
+	testutil.AssertContains(t, err.Error(), "revision")
+}
+
+func benchmarkSnapshot() catalogSnapshot {
+	return catalogSnapshot{
+		Owner: AccountUser{ID: 1, Name: "Alice", Email: "alice@example.com", Age: 30},
+		Products: []Product{
+			{Name: "Laptop", Price: 999.99, Category: "Electronics"},
+			{Name: "Desk", Price: 249.50, Category: "Furniture"},
+		},
+		Stock: map[string][]int{"Laptop": {4, 2}, "Desk": {1}},
+	}
+}
+
+func BenchmarkDeepCopy(b *testing.B) {
+	src := benchmarkSnapshot()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		DeepCopy(src)
+	}
+}
+
+func BenchmarkGobClone(b *testing.B) {
+	type exportedSnapshot struct {
+		Owner    AccountUser
+		Products []Product
+		Stock    map[string][]int
+	}
+	snap := benchmarkSnapshot()
+	src := exportedSnapshot{snap.Owner, snap.Products, snap.Stock}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := GobClone(src); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
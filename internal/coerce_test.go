@@ -0,0 +1,108 @@
+// coerce_test.go
+package internal
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestAsIntFromDecodedJSON(t *testing.T) {
+	var payload map[string]interface{}
+	err := json.Unmarshal([]byte(`{"user_id": 123, "score": 9.5, "name": "ada", "count": "42"}`), &payload)
+	testutil.AssertNoError(t, err)
+
+	_, isFloat := payload["user_id"].(float64)
+	testutil.AssertEqual(t, isFloat, true) // encoding/json's default for numbers
+
+	id, ok := AsInt(payload["user_id"])
+	testutil.AssertEqual(t, ok, true)
+	testutil.AssertEqual(t, id, 123)
+
+	_, ok = AsInt(payload["score"])
+	testutil.AssertEqual(t, ok, false) // a fractional part does not truncate
+	_, ok = AsInt(payload["name"])
+	testutil.AssertEqual(t, ok, false)
+	count, ok := AsInt(payload["count"])
+	testutil.AssertEqual(t, ok, true)
+	testutil.AssertEqual(t, count, 42)
+}
+
+func TestAsInt(t *testing.T) {
+	cases := []struct {
+		in   interface{}
+		want int
+		ok   bool
+	}{
+		{int8(-5), -5, true},
+		{uint16(7), 7, true},
+		{uint64(math.MaxUint64), 0, false},
+		{float32(3), 3, true},
+		{1e30, 0, false},
+		{json.Number("17"), 17, true},
+		{" 1e3 ", 1000, true},
+		{"12.0", 12, true},
+		{"12.5", 0, false},
+		{nil, 0, false},
+		{true, 0, false},
+	}
+	for _, c := range cases {
+		got, ok := AsInt(c.in)
+		if ok != c.ok || (ok && got != c.want) {
+			t.Errorf("AsInt(%#v) = %d, %v; want %d, %v", c.in, got, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestAsFloat(t *testing.T) {
+	f, ok := AsFloat(42)
+	testutil.AssertEqual(t, ok, true)
+	testutil.AssertEqual(t, f, 42.0)
+	f, _ = AsFloat(json.Number("2.5"))
+	testutil.AssertEqual(t, f, 2.5)
+	f, _ = AsFloat(" -0.25 ")
+	testutil.AssertEqual(t, f, -0.25)
+	_, ok = AsFloat("abc")
+	testutil.AssertEqual(t, ok, false)
+	_, ok = AsFloat([]int{1})
+	testutil.AssertEqual(t, ok, false)
+}
+
+func TestAsString(t *testing.T) {
+	cases := []struct {
+		in   interface{}
+		want string
+	}{
+		{"hi", "hi"},
+		{[]byte("raw"), "raw"},
+		{json.Number("1.50"), "1.50"},
+		{true, "true"},
+		{-12, "-12"},
+		{uint8(200), "200"},
+		{123.0, "123"},
+		{float32(0.1), "0.1"},
+	}
+	for _, c := range cases {
+		got, ok := AsString(c.in)
+		testutil.AssertEqual(t, ok, true)
+		testutil.AssertEqual(t, got, c.want)
+	}
+	_, ok := AsString(nil)
+	testutil.AssertEqual(t, ok, false)
+}
+
+func TestAsBool(t *testing.T) {
+	b, ok := AsBool(true)
+	testutil.AssertEqual(t, b && ok, true)
+	b, ok = AsBool(" F ")
+	testutil.AssertEqual(t, ok, true)
+	testutil.AssertEqual(t, b, false)
+	b, _ = AsBool("1")
+	testutil.AssertEqual(t, b, true)
+	_, ok = AsBool("yes")
+	testutil.AssertEqual(t, ok, false)
+	_, ok = AsBool(1)
+	testutil.AssertEqual(t, ok, false)
+}
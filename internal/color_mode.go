@@ -0,0 +1,67 @@
+// color_mode.go
+package internal
+
+import (
+	"os"
+	"sync"
+	"sync/atomic"
+)
+
+// ColorMode decides whether the color helpers emit ANSI escape codes
+type ColorMode int32
+
+const (
+	ColorAuto   ColorMode = iota // color only on a capable terminal (default)
+	ColorAlways                  // always emit escapes, even when piped
+	ColorNever                   // never emit escapes
+)
+
+var (
+	colorMode      atomic.Int32
+	autoColorOnce  sync.Once
+	autoColorValue bool
+)
+
+// ForceColorMode overrides terminal detection for all color helpers.
+// ColorAuto restores detection.
+func ForceColorMode(mode ColorMode) {
+	colorMode.Store(int32(mode))
+}
+
+// colorsEnabled reports whether the helpers should emit escapes right now
+func colorsEnabled() bool {
+	switch ColorMode(colorMode.Load()) {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	}
+	autoColorOnce.Do(func() { autoColorValue = detectColorSupport() })
+	return autoColorValue
+}
+
+// detectColorSupport honours NO_COLOR (https://no-color.org) and
+// TERM=dumb, requires stdout to be a terminal and finally asks the
+// platform to turn on escape processing, which only matters on Windows
+func detectColorSupport() bool {
+	if _, set := os.LookupEnv("NO_COLOR"); set {
+		return false
+	}
+	if os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	info, err := os.Stdout.Stat()
+	if err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return false
+	}
+	return enableVirtualTerminal()
+}
+
+// paint wraps text in codes and a reset, or returns it unchanged when
+// colors are off
+func paint(codes, text string) string {
+	if !colorsEnabled() {
+		return text
+	}
+	return codes + text + ColorReset
+}
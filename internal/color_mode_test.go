@@ -0,0 +1,39 @@
+// color_mode_test.go
+package internal
+
+import (
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// withColorMode forces mode for the rest of the test
+func withColorMode(t *testing.T, mode ColorMode) {
+	t.Helper()
+	ForceColorMode(mode)
+	t.Cleanup(func() { ForceColorMode(ColorAuto) })
+}
+
+func TestColorNeverStripsEscapes(t *testing.T) {
+	withColorMode(t, ColorNever)
+	testutil.AssertEqual(t, Red("error"), "error")
+	testutil.AssertEqual(t, Bold(Green("ok")), "ok")
+	testutil.AssertEqual(t, colorsEnabled(), false)
+}
+
+func TestColorAlwaysKeepsEscapes(t *testing.T) {
+	withColorMode(t, ColorAlways) // go test's stdout is not a terminal, so this overrides detection
+	testutil.AssertEqual(t, Red("error"), ColorRed+"error"+ColorReset)
+	testutil.AssertEqual(t, StripANSI(Bold(Green("ok"))), "ok")
+	testutil.AssertEqual(t, colorsEnabled(), true)
+}
+
+func TestDetectColorSupportHonoursEnvironment(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	testutil.AssertEqual(t, detectColorSupport(), false)
+}
+
+func TestDetectColorSupportDumbTerminal(t *testing.T) {
+	t.Setenv("TERM", "dumb")
+	testutil.AssertEqual(t, detectColorSupport(), false)
+}
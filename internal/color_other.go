@@ -0,0 +1,11 @@
+// color_other.go
+
+//go:build !windows
+
+package internal
+
+// enableVirtualTerminal is a no-op: terminals on this platform already
+// understand ANSI escapes
+func enableVirtualTerminal() bool {
+	return true
+}
@@ -0,0 +1,32 @@
+// color_windows.go
+
+//go:build windows
+
+package internal
+
+import (
+	"os"
+	"syscall"
+)
+
+// enableVirtualTerminalProcessing is ENABLE_VIRTUAL_TERMINAL_PROCESSING
+// from <consoleapi.h>
+const enableVirtualTerminalProcessing = 0x0004
+
+var procSetConsoleMode = syscall.NewLazyDLL("kernel32.dll").NewProc("SetConsoleMode")
+
+// enableVirtualTerminal switches the stdout console into VT mode so it
+// interprets ANSI escapes. Legacy consoles reject the flag, in which case
+// coloring is disabled rather than printing raw escapes.
+func enableVirtualTerminal() bool {
+	handle := syscall.Handle(os.Stdout.Fd())
+	var mode uint32
+	if err := syscall.GetConsoleMode(handle, &mode); err != nil {
+		return false
+	}
+	if mode&enableVirtualTerminalProcessing != 0 {
+		return true
+	}
+	ok, _, _ := procSetConsoleMode.Call(uintptr(handle), uintptr(mode|enableVirtualTerminalProcessing))
+	return ok != 0
+}
@@ -38,65 +38,65 @@ func repeat(s string, count int) string {
 
 // Helper Functions
 func Red(text string) string {
-	return ColorRed + text + ColorReset
+	return paint(ColorRed, text)
 }
 
 func Green(text string) string {
-	return ColorGreen + text + ColorReset
+	return paint(ColorGreen, text)
 }
 
 func Yellow(text string) string {
-	return ColorYellow + text + ColorReset
+	return paint(ColorYellow, text)
 }
 
 func Blue(text string) string {
-	return ColorBlue + text + ColorReset
+	return paint(ColorBlue, text)
 }
 
 func Purple(text string) string {
-	return ColorPurple + text + ColorReset
+	return paint(ColorPurple, text)
 }
 
 func Cyan(text string) string {
-	return ColorCyan + text + ColorReset
+	return paint(ColorCyan, text)
 }
 
 func Bold(text string) string {
-	return ColorBold + text + ColorReset
+	return paint(ColorBold, text)
 }
 
 func Dim(text string) string {
-	return ColorDim + text + ColorReset
+	return paint(ColorDim, text)
 }
 
 // Success, Warning, Error functions
 func SuccessText(text string) string {
-	return ColorGreen + "✅ " + text + ColorReset
+	return paint(ColorGreen, "✅ "+text)
 }
 
 func WarningText(text string) string {
-	return ColorYellow + "⚠️  " + text + ColorReset
+	return paint(ColorYellow, "⚠️  "+text)
 }
 
 func ErrorText(text string) string {
-	return ColorRed + "❌ " + text + ColorReset
+	return paint(ColorRed, "❌ "+text)
 }
 
 func InfoText(text string) string {
-	return ColorBlue + "ℹ️  " + text + ColorReset
+	return paint(ColorBlue, "ℹ️  "+text)
 }
 
 // Enhanced formatting
 func Header(text string) string {
-	return ColorBold + ColorCyan + text + ColorReset
+	return paint(ColorBold+ColorCyan, text)
 }
 
 func Subtitle(text string) string {
-	return ColorBold + ColorYellow + text + ColorReset
+	return paint(ColorBold+ColorYellow, text)
 }
 
 func Code(text string) string {
-	return BgBlue + ColorWhite + " " + text + " " + ColorReset
+	return paint(BgBlue+ColorWhite, " "+text+" ")
 }
 
 // Example usage function
@@ -122,4 +122,27 @@ func ColorExamples() {
 	fmt.Println("\n" + Subtitle("Code Examples:"))
 	fmt.Println("Variable:", Code("myVariable"))
 	fmt.Println("Function:", Code("func main()"))
+
+	fmt.Println("\n" + Subtitle("Color Modes:"))
+	for _, mode := range []struct {
+		name string
+		mode ColorMode
+	}{{"Always", ColorAlways}, {"Never", ColorNever}} {
+		ForceColorMode(mode.mode)
+		fmt.Printf("%-6s -> %q\n", mode.name, Green("ok"))
+	}
+
+	// Captured colored output can be turned back into plain text
+	ForceColorMode(ColorAlways)
+	colored := ErrorText("disk full") + " " + Code("df -h")
+	fmt.Printf("Stripped: %q -> %q\n", colored, StripANSI(colored))
+
+	var plain strings.Builder
+	w := NewStrippingWriter(&plain)
+	for _, chunk := range []string{Bold("build"), " \x1b[3", "2mpassed\x1b[", "0m"} {
+		w.Write([]byte(chunk))
+	}
+	w.Flush()
+	fmt.Printf("Escapes split across writes: %q\n", plain.String())
+	ForceColorMode(ColorAuto)
 }
@@ -0,0 +1,116 @@
+// config_watch.go
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+)
+
+// configPollInterval is how often WatchConfig checks the file for changes
+var configPollInterval = 500 * time.Millisecond
+
+// Validate checks the fields a running service cannot do without
+func (c *JSONConfig) Validate() error {
+	var problems MultiError
+	if c.AppName == "" {
+		problems.Errors = append(problems.Errors, errors.New("app_name is required"))
+	}
+	if c.Version == "" {
+		problems.Errors = append(problems.Errors, errors.New("version is required"))
+	}
+	if c.Database.Port < 0 || c.Database.Port > 65535 {
+		problems.Errors = append(problems.Errors, fmt.Errorf("database.port %d is out of range", c.Database.Port))
+	}
+	for i, server := range c.Servers {
+		if server.Host == "" {
+			problems.Errors = append(problems.Errors, fmt.Errorf("servers[%d].host is required", i))
+		}
+	}
+
+	if len(problems.Errors) > 0 {
+		return &problems
+	}
+	return nil
+}
+
+// LoadConfig reads, parses and validates a JSON config file
+func LoadConfig(path string) (*JSONConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+
+	var config JSONConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	return &config, nil
+}
+
+// WatchConfig polls path and calls onChange with the reloaded config each
+// time the file's modification time or size changes. The state at the time
+// of the call is the baseline and does not trigger onChange. A change that
+// fails to load is logged and skipped, so the caller keeps its previous
+// config. WatchConfig blocks until ctx is cancelled and then returns nil.
+func WatchConfig(ctx context.Context, path string, onChange func(*JSONConfig)) error {
+	type fileState struct {
+		modTime time.Time
+		size    int64
+		exists  bool
+	}
+	stat := func() (fileState, error) {
+		info, err := os.Stat(path)
+		if errors.Is(err, os.ErrNotExist) {
+			return fileState{}, nil
+		}
+		if err != nil {
+			return fileState{}, err
+		}
+		return fileState{modTime: info.ModTime(), size: info.Size(), exists: true}, nil
+	}
+
+	last, err := stat()
+	if err != nil {
+		return fmt.Errorf("watch config: %w", err)
+	}
+
+	ticker := time.NewTicker(configPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		current, err := stat()
+		if err != nil {
+			log.Printf("watch config: %v", err)
+			continue
+		}
+		if current == last {
+			continue
+		}
+		last = current
+		if !current.exists {
+			log.Printf("watch config: %s was removed; keeping previous config", path)
+			continue
+		}
+
+		config, err := LoadConfig(path)
+		if err != nil {
+			log.Printf("watch config: %v; keeping previous config", err)
+			continue
+		}
+		onChange(config)
+	}
+}
@@ -0,0 +1,87 @@
+// config_watch_test.go
+package internal
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+const validWatchedConfig = `{"app_name": "shop", "version": "2.0", "servers": [{"name": "a", "host": "10.0.0.1"}]}`
+
+// writeConfigAt writes content and stamps it with a distinct mtime so the
+// change is visible however coarse the filesystem's timestamps are
+func writeConfigAt(t *testing.T, path, content string, mtime time.Time) {
+	t.Helper()
+	testutil.AssertNoError(t, os.WriteFile(path, []byte(content), 0644))
+	testutil.AssertNoError(t, os.Chtimes(path, mtime, mtime))
+}
+
+func TestWatchConfigSkipsInvalidReload(t *testing.T) {
+	saved := configPollInterval
+	configPollInterval = 5 * time.Millisecond
+	t.Cleanup(func() { configPollInterval = saved })
+
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	base := time.Now().Add(-time.Hour)
+	writeConfigAt(t, path, `{}`, base) // baseline, never reported
+
+	var (
+		mu      sync.Mutex
+		changes []*JSONConfig
+	)
+	reloaded := make(chan struct{}, 4)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- WatchConfig(ctx, path, func(c *JSONConfig) {
+			mu.Lock()
+			changes = append(changes, c)
+			mu.Unlock()
+			reloaded <- struct{}{}
+		})
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	writeConfigAt(t, path, validWatchedConfig, base.Add(time.Minute))
+	select {
+	case <-reloaded:
+	case <-time.After(2 * time.Second):
+		t.Fatal("valid config change was not picked up")
+	}
+
+	writeConfigAt(t, path, `{"app_name": "shop", "version": `, base.Add(2*time.Minute))
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	testutil.AssertNoError(t, <-done)
+
+	mu.Lock()
+	defer mu.Unlock()
+	testutil.AssertEqual(t, len(changes), 1)
+	testutil.AssertEqual(t, changes[0].AppName, "shop")
+	testutil.AssertEqual(t, changes[0].Servers[0].Host, "10.0.0.1")
+	testutil.AssertContains(t, logs.String(), "keeping previous config")
+}
+
+func TestLoadConfigValidation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	testutil.AssertNoError(t, os.WriteFile(path, []byte(`{"database": {"port": 70000}, "servers": [{}]}`), 0644))
+	_, err := LoadConfig(path)
+	for _, want := range []string{"app_name is required", "version is required", "out of range", "servers[0].host"} {
+		testutil.AssertContains(t, err.Error(), want)
+	}
+
+	_, err = LoadConfig(filepath.Join(t.TempDir(), "missing.json"))
+	testutil.AssertErrorIs(t, err, os.ErrNotExist)
+}
@@ -3,10 +3,15 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"math/rand"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -17,14 +22,34 @@ type RequestData struct {
 	IP        string
 }
 
-// DatabaseService simulates a database service
+// DatabaseService simulates a database service. Each call takes delay, or
+// a delay drawn from latency when it is set.
 type DatabaseService struct {
-	delay time.Duration
+	delay   time.Duration
+	latency *LatencySimulator
 }
 
-// APIService simulates an API service
+// APIService simulates an API service. Each call takes delay, or a delay
+// drawn from latency when it is set.
 type APIService struct {
-	delay time.Duration
+	delay   time.Duration
+	latency *LatencySimulator
+}
+
+// nextDelay returns how long the next database call takes
+func (db *DatabaseService) nextDelay() time.Duration {
+	if db.latency != nil {
+		return db.latency.Delay()
+	}
+	return db.delay
+}
+
+// nextDelay returns how long the next API call takes
+func (api *APIService) nextDelay() time.Duration {
+	if api.latency != nil {
+		return api.latency.Delay()
+	}
+	return api.delay
 }
 
 // RunContextExamples - main function to run all context examples
@@ -39,6 +64,16 @@ func RunContextExamples() {
 	pipelineContextExample()
 	contextBestPracticesExample()
 	realWorldScenarioExample()
+	httpRetryExample()
+	rateLimitedAPIExample()
+	deadlineBudgetExample()
+	batchProcessingExample()
+	deadlineSplitExample()
+	circuitBreakerExample()
+	backoffExample()
+	gracefulShutdownExample()
+	latencySimulationExample()
+	singleFlightExample()
 }
 
 // basicContextExample demonstrates basic context usage
@@ -392,8 +427,9 @@ func httpServerContextExample() {
 	mux := http.NewServeMux()
 
 	// Add middleware for request context
-	mux.HandleFunc("/api/users", withContext(userHandler))
-	mux.HandleFunc("/api/orders", withContext(orderHandler))
+	requestIDs := NewRandomIDGenerator("req-", 4)
+	mux.HandleFunc("/api/users", withContext(userHandler, requestIDs))
+	mux.HandleFunc("/api/orders", withContext(orderHandler, requestIDs))
 
 	// Simulate HTTP requests
 	fmt.Println("Simulating HTTP requests...")
@@ -403,15 +439,16 @@ func httpServerContextExample() {
 	fmt.Println()
 }
 
-// withContext middleware adds context to HTTP requests
-func withContext(next http.HandlerFunc) http.HandlerFunc {
+// withContext middleware adds context to HTTP requests, tagging each one
+// with an ID from the injected generator
+func withContext(next http.HandlerFunc, ids IDGenerator) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Create context with timeout
 		ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
 		defer cancel()
 
 		// Add request metadata to context
-		requestID := fmt.Sprintf("req-%d", rand.Intn(10000))
+		requestID := ids.Next()
 		ctx = context.WithValue(ctx, "requestID", requestID)
 		ctx = context.WithValue(ctx, "startTime", time.Now())
 
@@ -506,10 +543,13 @@ func pipelineContextExample() {
 	stage2 := make(chan int, 5)
 	output := make(chan int, 5)
 
+	// Count items and cancellations across all stages
+	metrics := NewMetrics()
+
 	// Start pipeline stages
-	go pipelineStage1(ctx, input, stage1)
-	go pipelineStage2(ctx, stage1, stage2)
-	go pipelineStage3(ctx, stage2, output)
+	go pipelineStage1(ctx, input, stage1, metrics)
+	go pipelineStage2(ctx, stage1, stage2, metrics)
+	go pipelineStage3(ctx, stage2, output, metrics)
 
 	// Send input data
 	go func() {
@@ -536,6 +576,7 @@ func pipelineContextExample() {
 					return
 				}
 				fmt.Printf("Received output: %d\n", result)
+				metrics.Inc("pipeline.output")
 			case <-ctx.Done():
 				fmt.Printf("Output reading canceled: %v\n", ctx.Err())
 				return
@@ -546,11 +587,14 @@ func pipelineContextExample() {
 	// Wait for pipeline to complete or timeout
 	time.Sleep(2 * time.Second)
 	fmt.Println("Pipeline processing completed")
+	for _, name := range metrics.Names() {
+		fmt.Printf("  %-20s %d\n", name, metrics.Get(name))
+	}
 	fmt.Println()
 }
 
 // pipelineStage1 processes input and multiplies by 2
-func pipelineStage1(ctx context.Context, input <-chan int, output chan<- int) {
+func pipelineStage1(ctx context.Context, input <-chan int, output chan<- int, metrics *Metrics) {
 	defer close(output)
 
 	for {
@@ -564,19 +608,22 @@ func pipelineStage1(ctx context.Context, input <-chan int, output chan<- int) {
 			select {
 			case output <- result:
 				fmt.Printf("Stage 1: %d -> %d\n", value, result)
+				metrics.Inc("stage1.processed")
 			case <-ctx.Done():
 				fmt.Printf("Stage 1 canceled: %v\n", ctx.Err())
+				metrics.Inc("errors.canceled")
 				return
 			}
 		case <-ctx.Done():
 			fmt.Printf("Stage 1 canceled: %v\n", ctx.Err())
+			metrics.Inc("errors.canceled")
 			return
 		}
 	}
 }
 
 // pipelineStage2 processes input and adds 10
-func pipelineStage2(ctx context.Context, input <-chan int, output chan<- int) {
+func pipelineStage2(ctx context.Context, input <-chan int, output chan<- int, metrics *Metrics) {
 	defer close(output)
 
 	for {
@@ -590,19 +637,22 @@ func pipelineStage2(ctx context.Context, input <-chan int, output chan<- int) {
 			select {
 			case output <- result:
 				fmt.Printf("Stage 2: %d -> %d\n", value, result)
+				metrics.Inc("stage2.processed")
 			case <-ctx.Done():
 				fmt.Printf("Stage 2 canceled: %v\n", ctx.Err())
+				metrics.Inc("errors.canceled")
 				return
 			}
 		case <-ctx.Done():
 			fmt.Printf("Stage 2 canceled: %v\n", ctx.Err())
+			metrics.Inc("errors.canceled")
 			return
 		}
 	}
 }
 
 // pipelineStage3 processes input and divides by 2
-func pipelineStage3(ctx context.Context, input <-chan int, output chan<- int) {
+func pipelineStage3(ctx context.Context, input <-chan int, output chan<- int, metrics *Metrics) {
 	defer close(output)
 
 	for {
@@ -616,12 +666,15 @@ func pipelineStage3(ctx context.Context, input <-chan int, output chan<- int) {
 			select {
 			case output <- result:
 				fmt.Printf("Stage 3: %d -> %d\n", value, result)
+				metrics.Inc("stage3.processed")
 			case <-ctx.Done():
 				fmt.Printf("Stage 3 canceled: %v\n", ctx.Err())
+				metrics.Inc("errors.canceled")
 				return
 			}
 		case <-ctx.Done():
 			fmt.Printf("Stage 3 canceled: %v\n", ctx.Err())
+			metrics.Inc("errors.canceled")
 			return
 		}
 	}
@@ -739,16 +792,90 @@ func realWorldScenarioExample() {
 	ctx = context.WithValue(ctx, "userID", "user789")
 	ctx = context.WithValue(ctx, "requestID", "req123")
 
+	// Every log line of this request carries the same trace ID
+	traceID := NewRandomIDGenerator("", 8).Next()
+	ctx = WithTrace(ctx, traceID)
+	ctx = WithLogger(ctx, NewLogger("ORDER"))
+
 	// Set timeout for the entire request
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
 
-	// Process order
-	order := processOrder(ctx)
+	// Process order, timing each stage
+	timing := NewStopwatch(nil)
+	timing.Start()
+	order := processOrder(ctx, NewRandomIDGenerator("order-", 4), timing)
+	timing.Stop()
+	logger := LoggerFromContext(ctx)
 	if order != nil {
-		fmt.Printf("Order processed successfully: %+v\n", order)
+		logger.Log(fmt.Sprintf("Order processed successfully: %+v", order))
 	} else {
-		fmt.Println("Order processing failed")
+		logger.Log("Order processing failed")
+	}
+	fmt.Print(timing.Report())
+
+	fmt.Println()
+}
+
+// httpRetryExample demonstrates retrying a flaky HTTP endpoint
+func httpRetryExample() {
+	fmt.Println(Subtitle("11. HTTP Retry Example"))
+
+	// A local server that fails a configurable number of times first
+	failures := int32(2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&failures, -1) >= 0 {
+			fmt.Println("  server: 503 Service Unavailable")
+			http.Error(w, "try again later", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Println("  server: 200 OK")
+		fmt.Fprint(w, "hello after retries")
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := GetWithRetry(ctx, server.Client(), server.URL, 4)
+	if err != nil {
+		fmt.Printf("Request failed: %v\n", err)
+		return
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	fmt.Printf("Response %d: %s\n", resp.StatusCode, body)
+
+	// Giving up once the attempts are used
+	atomic.StoreInt32(&failures, 5)
+	if _, err := GetWithRetry(ctx, server.Client(), server.URL, 2); err != nil {
+		fmt.Printf("Expected failure: %v\n", err)
+	}
+
+	fmt.Println()
+}
+
+// rateLimitedAPIExample demonstrates throttling API calls with a token bucket
+func rateLimitedAPIExample() {
+	fmt.Println(Subtitle("12. Rate Limited API Example"))
+
+	api := &APIService{delay: 20 * time.Millisecond}
+	limiter := NewTokenBucket(5, 2) // 5 calls per second, bursts of 2
+
+	ctx, cancel := context.WithTimeout(context.Background(), 700*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	for i := 1; i <= 6; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			fmt.Printf("Call %d not made: %v\n", i, err)
+			break
+		}
+		fmt.Printf("Call %d at +%s\n", i, HumanizeDuration(time.Since(start).Round(10*time.Millisecond)))
+		if _, err := api.GetProductPrices(ctx, []string{fmt.Sprintf("product%d", i)}); err != nil {
+			fmt.Printf("Call %d failed: %v\n", i, err)
+		}
 	}
 
 	fmt.Println()
@@ -762,28 +889,100 @@ type Order struct {
 	Total    float64
 }
 
-// processOrder simulates order processing with multiple service calls
-func processOrder(ctx context.Context) *Order {
+// deadlineBudgetExample shows skipping work that cannot finish before the
+// deadline, and attaching a cause to a timeout
+func deadlineBudgetExample() {
+	fmt.Println(Subtitle("13. Deadline Budget Example"))
+
+	ctx, cancel := WithTimeoutCause(context.Background(), 500*time.Millisecond,
+		fmt.Errorf("checkout budget of 500ms exhausted: %w", context.DeadlineExceeded))
+	defer cancel()
+
+	if remaining, ok := RemainingTime(ctx); ok {
+		fmt.Printf("Budget: %s\n", HumanizeDuration(remaining.Round(time.Millisecond)))
+	}
+
+	db := &DatabaseService{delay: 300 * time.Millisecond}
+	api := &APIService{delay: 400 * time.Millisecond}
+
+	if _, err := db.GetUser(ctx, "user42"); err != nil {
+		fmt.Printf("Failed to get user: %v\n", err)
+	}
+
+	// Only ~200ms remain, so the 400ms API call is rejected up front
+	_, err := api.GetProductPrices(ctx, []string{"product1"})
+	if errors.Is(err, ErrInsufficientTime) {
+		fmt.Printf("Skipped API call without waiting: %v\n", err)
+	}
+
+	<-ctx.Done()
+	fmt.Printf("ctx.Err():         %v\n", ctx.Err())
+	fmt.Printf("context.Cause(ctx): %v\n", context.Cause(ctx))
+	fmt.Println()
+}
+
+// batchProcessingExample saves orders a few at a time until the deadline
+// leaves no room for another batch
+func batchProcessingExample() {
+	fmt.Println(Subtitle("14. Batch Processing Example"))
+
+	ids := NewSequentialIDGenerator("order-")
+	orders := make([]*Order, 10)
+	for i := range orders {
+		orders[i] = &Order{ID: ids.Next(), UserID: "user42", Total: float64(10 * (i + 1))}
+	}
+
+	db := &DatabaseService{delay: 100 * time.Millisecond}
+	ctx, cancel := context.WithTimeout(context.Background(), 250*time.Millisecond)
+	defer cancel()
+
+	// One round trip per batch instead of per order; the third batch would
+	// need 100ms with only ~50ms left
+	if err := ProcessBatch(ctx, orders, 4, db.SaveOrders); err != nil {
+		fmt.Printf("Batching stopped: %v\n", err)
+	}
+	fmt.Println()
+}
+
+// processOrder simulates order processing with multiple service calls.
+// Order IDs come from ids so callers can make them deterministic, and each
+// stage is recorded as a lap on timing.
+func processOrder(ctx context.Context, ids IDGenerator, timing *Stopwatch) *Order {
 	userID := ctx.Value("userID").(string)
 	requestID := ctx.Value("requestID").(string)
+	logger := LoggerFromContext(ctx)
 
-	fmt.Printf("Processing order for user %s (Request: %s)\n", userID, requestID)
+	logger.Log(fmt.Sprintf("Processing order for user %s (Request: %s)", userID, requestID))
 
 	// Create services
 	dbService := &DatabaseService{delay: 300 * time.Millisecond}
 	apiService := &APIService{delay: 400 * time.Millisecond}
 
-	// Get user data
-	user, err := dbService.GetUser(ctx, userID)
-	if err != nil {
-		fmt.Printf("Failed to get user: %v\n", err)
+	// The user lookup and the price lookup are independent, so run them in
+	// parallel; whichever fails first cancels the other
+	var (
+		user   string
+		prices []float64
+	)
+	group, groupCtx := ErrGroupWithContext(ctx)
+	group.Go(func() error {
+		var err error
+		if user, err = dbService.GetUser(groupCtx, userID); err != nil {
+			return fmt.Errorf("get user: %w", err)
+		}
 		return nil
-	}
-
-	// Get product prices
-	prices, err := apiService.GetProductPrices(ctx, []string{"product1", "product2"})
+	})
+	group.Go(func() error {
+		var err error
+		if prices, err = apiService.GetProductPrices(groupCtx, []string{"product1", "product2"}); err != nil {
+			return fmt.Errorf("get prices: %w", err)
+		}
+		return nil
+	})
+	err := group.Wait()
+	timing.Lap("load user and prices")
 	if err != nil {
-		fmt.Printf("Failed to get prices: %v\n", err)
+		logger.Log(fmt.Sprintf("Failed to load order data: %v", err))
 		return nil
 	}
 
@@ -795,16 +994,19 @@ func processOrder(ctx context.Context) *Order {
 
 	// Create order
 	order := &Order{
-		ID:       fmt.Sprintf("order-%d", rand.Intn(10000)),
+		ID:       ids.Next(),
 		UserID:   user,
 		Products: []string{"product1", "product2"},
 		Total:    total,
 	}
 
+	timing.Lap("calculate total")
+
 	// Save order
 	err = dbService.SaveOrder(ctx, order)
+	timing.Lap("save order")
 	if err != nil {
-		fmt.Printf("Failed to save order: %v\n", err)
+		logger.Log(fmt.Sprintf("Failed to save order: %v", err))
 		return nil
 	}
 
@@ -813,11 +1015,16 @@ func processOrder(ctx context.Context) *Order {
 
 // GetUser simulates getting user from database
 func (db *DatabaseService) GetUser(ctx context.Context, userID string) (string, error) {
-	fmt.Printf("Getting user %s from database...\n", userID)
+	delay := db.nextDelay()
+	if err := requireTime(ctx, delay); err != nil {
+		return "", err
+	}
+	logger := LoggerFromContext(ctx)
+	logger.Log(fmt.Sprintf("Getting user %s from database...", userID))
 
 	select {
-	case <-time.After(db.delay):
-		fmt.Println("User retrieved from database")
+	case <-time.After(delay):
+		logger.Log("User retrieved from database")
 		return userID, nil
 	case <-ctx.Done():
 		return "", fmt.Errorf("database operation canceled: %w", ctx.Err())
@@ -826,11 +1033,38 @@ func (db *DatabaseService) GetUser(ctx context.Context, userID string) (string,
 
 // SaveOrder simulates saving order to database
 func (db *DatabaseService) SaveOrder(ctx context.Context, order *Order) error {
-	fmt.Printf("Saving order %s to database...\n", order.ID)
+	delay := db.nextDelay()
+	if err := requireTime(ctx, delay); err != nil {
+		return err
+	}
+	logger := LoggerFromContext(ctx)
+	logger.Log(fmt.Sprintf("Saving order %s to database...", order.ID))
+
+	select {
+	case <-time.After(delay):
+		logger.Log("Order saved to database")
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("database operation canceled: %w", ctx.Err())
+	}
+}
+
+// SaveOrders simulates saving several orders in one round trip
+func (db *DatabaseService) SaveOrders(ctx context.Context, orders []*Order) error {
+	delay := db.nextDelay()
+	if err := requireTime(ctx, delay); err != nil {
+		return err
+	}
+	ids := make([]string, len(orders))
+	for i, order := range orders {
+		ids[i] = order.ID
+	}
+	logger := LoggerFromContext(ctx)
+	logger.Log(fmt.Sprintf("Saving orders %v to database...", ids))
 
 	select {
-	case <-time.After(db.delay):
-		fmt.Println("Order saved to database")
+	case <-time.After(delay):
+		logger.Log(fmt.Sprintf("%d orders saved to database", len(orders)))
 		return nil
 	case <-ctx.Done():
 		return fmt.Errorf("database operation canceled: %w", ctx.Err())
@@ -839,11 +1073,16 @@ func (db *DatabaseService) SaveOrder(ctx context.Context, order *Order) error {
 
 // GetProductPrices simulates getting product prices from API
 func (api *APIService) GetProductPrices(ctx context.Context, products []string) ([]float64, error) {
-	fmt.Printf("Getting prices for products %v from API...\n", products)
+	delay := api.nextDelay()
+	if err := requireTime(ctx, delay); err != nil {
+		return nil, err
+	}
+	logger := LoggerFromContext(ctx)
+	logger.Log(fmt.Sprintf("Getting prices for products %v from API...", products))
 
 	select {
-	case <-time.After(api.delay):
-		fmt.Println("Product prices retrieved from API")
+	case <-time.After(delay):
+		logger.Log("Product prices retrieved from API")
 		prices := make([]float64, len(products))
 		for i := range prices {
 			prices[i] = rand.Float64() * 100
@@ -853,3 +1092,257 @@ func (api *APIService) GetProductPrices(ctx context.Context, products []string)
 		return nil, fmt.Errorf("API operation canceled: %w", ctx.Err())
 	}
 }
+
+// deadlineSplitExample shares one deadline between several retry attempts
+func deadlineSplitExample() {
+	fmt.Println(Subtitle("15. Deadline Split Retry Example"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 700*time.Millisecond)
+	defer cancel()
+
+	for _, split := range []struct {
+		name  string
+		split DeadlineSplit
+	}{{"even", SplitEven}, {"doubling", SplitDoubling}} {
+		var shares []string
+		for _, budget := range SplitDeadline(ctx, 3, split.split) {
+			shares = append(shares, HumanizeDuration(budget.Round(10*time.Millisecond)))
+		}
+		fmt.Printf("Budgets for 3 attempts (%s): %s\n", split.name, strings.Join(shares, ", "))
+	}
+	fmt.Printf("Budget without a deadline: %v\n", SplitDeadline(context.Background(), 2))
+
+	// The service needs 300ms: too long for the first attempt's share, but
+	// the later shares grow, so the third attempt succeeds in time
+	start := time.Now()
+	attempt := 0
+	err := Retry(ctx, 3, func(ctx context.Context) error {
+		attempt++
+		budget, _ := RemainingTime(ctx)
+		select {
+		case <-time.After(300 * time.Millisecond):
+			fmt.Printf("  attempt %d (budget %s): succeeded\n", attempt, HumanizeDuration(budget.Round(10*time.Millisecond)))
+			return nil
+		case <-ctx.Done():
+			fmt.Printf("  attempt %d (budget %s): %v\n", attempt, HumanizeDuration(budget.Round(10*time.Millisecond)), ctx.Err())
+			return ctx.Err()
+		}
+	}, SplitDoubling)
+	fmt.Printf("Retry result: %v after %s\n", err, HumanizeDuration(time.Since(start).Round(10*time.Millisecond)))
+	fmt.Println()
+}
+
+// circuitBreakerExample stops hammering an external API that is down
+func circuitBreakerExample() {
+	fmt.Println(Subtitle("16. Circuit Breaker Example"))
+
+	clock := NewManualClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	breaker := NewCircuitBreaker(3, 30*time.Second, clock)
+
+	healthy := false
+	calls := 0
+	callAPI := func() error {
+		calls++
+		if !healthy {
+			return errors.New("external API: 503 service unavailable")
+		}
+		return nil
+	}
+
+	attempt := func(label string) {
+		err := breaker.Execute(context.Background(), callAPI)
+		result := "ok"
+		if errors.Is(err, ErrCircuitOpen) {
+			result = "rejected without calling the API"
+		} else if err != nil {
+			result = err.Error()
+		}
+		fmt.Printf("  %-22s -> %-40s [state: %s]\n", label, result, breaker.State())
+	}
+
+	for i := 1; i <= 4; i++ {
+		attempt(fmt.Sprintf("call %d (API down)", i))
+	}
+	clock.Advance(30 * time.Second)
+	attempt("probe after 30s")
+	attempt("call during cooldown")
+
+	healthy = true
+	clock.Advance(30 * time.Second)
+	attempt("probe after recovery")
+	attempt("normal call")
+	fmt.Printf("The API was called %d times for 8 attempts\n", calls)
+	fmt.Println()
+}
+
+// backoffExample drives a hand-written retry loop with a Backoff
+func backoffExample() {
+	fmt.Println(Subtitle("17. Backoff Example"))
+
+	formatDelays := func(b *Backoff, n int) string {
+		delays := make([]string, n)
+		for i := range delays {
+			delays[i] = HumanizeDuration(b.Next())
+		}
+		return strings.Join(delays, ", ")
+	}
+
+	plain := NewBackoff(100*time.Millisecond, 2*time.Second, 2, false)
+	fmt.Printf("Doubling, capped at 2s: %s\n", formatDelays(plain, 7))
+	plain.Reset()
+	fmt.Printf("After Reset:            %s\n", formatDelays(plain, 3))
+
+	jittered := NewBackoff(100*time.Millisecond, 2*time.Second, 2, true)
+	jittered.SetRand(rand.New(rand.NewSource(1)))
+	fmt.Printf("With jitter (seed 1):   %s\n", formatDelays(jittered, 7))
+
+	// A custom loop: poll a job until it reports done, backing off between polls
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	backoff := NewBackoff(10*time.Millisecond, 80*time.Millisecond, 2, true)
+	for poll := 1; ; poll++ {
+		if poll == 4 {
+			fmt.Printf("  poll %d: job done\n", poll)
+			break
+		}
+		delay := backoff.Next()
+		fmt.Printf("  poll %d: still running, next poll in about %s\n", poll, HumanizeDuration(delay.Round(10*time.Millisecond)))
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			fmt.Printf("  gave up: %v\n", ctx.Err())
+			return
+		}
+	}
+	fmt.Println()
+}
+
+// gracefulShutdownExample runs prioritized cleanups against one deadline
+func gracefulShutdownExample() {
+	fmt.Println(Subtitle("18. Graceful Shutdown Example"))
+
+	group := NewShutdownGroup()
+	step := func(name string, took time.Duration, err error) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			select {
+			case <-time.After(took):
+				fmt.Printf("  %s done\n", name)
+				return err
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	group.Register("close database", 10, step("close database", 20*time.Millisecond, nil))
+	group.Register("flush metrics", 50, step("flush metrics", 10*time.Millisecond, errors.New("collector unreachable")))
+	group.Register("stop HTTP server", 100, step("stop HTTP server", 30*time.Millisecond, nil))
+	// Ignores its context, like a client library stuck on the network
+	group.Register("drain job queue", 50, func(ctx context.Context) error {
+		time.Sleep(time.Second)
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 400*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := group.Shutdown(WithLogger(ctx, NewLogger("SHUTDOWN")))
+	fmt.Printf("Shutdown finished in about %s\n", HumanizeDuration(time.Since(start).Round(100*time.Millisecond)))
+
+	var problems *MultiError
+	if errors.As(err, &problems) {
+		for _, problem := range problems.Errors {
+			fmt.Printf("  %s\n", ErrorText(problem.Error()))
+		}
+		fmt.Printf("Deadline overrun reported: %v\n", errors.Is(err, context.DeadlineExceeded))
+	}
+	fmt.Println()
+}
+
+// latencySimulationExample drives services with realistic, varying delays
+func latencySimulationExample() {
+	fmt.Println(Subtitle("19. Latency Simulation Example"))
+
+	configs := []struct {
+		name   string
+		config LatencyConfig
+	}{
+		{"constant 50ms", ConstantLatency(50 * time.Millisecond)},
+		{"uniform 20-80ms", UniformLatency(20*time.Millisecond, 80*time.Millisecond)},
+		{"normal 50ms±30ms", NormalLatency(50*time.Millisecond, 30*time.Millisecond)},
+	}
+	for _, c := range configs {
+		sim := NewLatencySimulator(c.config, rand.New(rand.NewSource(42)))
+		var stats Stats
+		for i := 0; i < 1000; i++ {
+			stats.Add(float64(sim.Delay()) / float64(time.Millisecond))
+		}
+		fmt.Printf("  %-17s mean %5.1fms  min %5.1fms  max %5.1fms\n", c.name, stats.Mean(), stats.Min(), stats.Max())
+	}
+
+	// An API whose latency varies: calls slower than the 100ms budget are
+	// refused up front instead of timing out
+	api := &APIService{latency: NewLatencySimulator(NormalLatency(80*time.Millisecond, 40*time.Millisecond), rand.New(rand.NewSource(7)))}
+	quiet := NewLogger("API")
+	quiet.SetOutput(io.Discard)
+	served, refused := 0, 0
+	for i := 0; i < 10; i++ {
+		ctx, cancel := context.WithTimeout(WithLogger(context.Background(), quiet), 100*time.Millisecond)
+		if _, err := api.GetProductPrices(ctx, []string{"product1"}); err == nil {
+			served++
+		} else if errors.Is(err, ErrInsufficientTime) {
+			refused++
+		}
+		cancel()
+	}
+	fmt.Printf("10 calls with a 100ms budget: %d served, %d refused as too slow\n", served, refused)
+	fmt.Println()
+}
+
+// singleFlightExample collapses duplicate concurrent lookups into one
+func singleFlightExample() {
+	fmt.Println(Subtitle("20. Single-Flight Example"))
+
+	db := &DatabaseService{delay: 100 * time.Millisecond}
+	quiet := NewLogger("DB")
+	quiet.SetOutput(io.Discard)
+	ctx := WithLogger(context.Background(), quiet)
+
+	var queries atomic.Int32
+	fetchUser := SingleFlight[string]()
+	lookup := func(userID string) (string, error) {
+		return fetchUser(userID, func() (string, error) {
+			queries.Add(1)
+			return db.GetUser(ctx, userID)
+		})
+	}
+
+	// Ten handlers ask for user42 and two for user7 at the same moment
+	var wg sync.WaitGroup
+	var results sync.Map
+	for i := 0; i < 12; i++ {
+		userID := "user42"
+		if i%6 == 5 {
+			userID = "user7"
+		}
+		wg.Add(1)
+		Go(func() {
+			defer wg.Done()
+			if user, err := lookup(userID); err == nil {
+				results.Store(i, user)
+			}
+		})
+	}
+	wg.Wait()
+
+	answered := 0
+	results.Range(func(_, _ interface{}) bool {
+		answered++
+		return true
+	})
+	fmt.Printf("12 concurrent lookups, %d answered, %d database queries\n", answered, queries.Load())
+
+	// The flight has landed, so a new call queries again
+	lookup("user42")
+	fmt.Printf("After the first flight finished, another lookup made the total %d\n", queries.Load())
+	fmt.Println()
+}
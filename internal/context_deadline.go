@@ -0,0 +1,45 @@
+// context_deadline.go
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInsufficientTime is returned by operations that refuse to start
+// because the context's deadline would expire before they could finish
+var ErrInsufficientTime = errors.New("insufficient time before deadline")
+
+// RemainingTime reports how long until ctx's deadline; ok is false when ctx
+// has no deadline. An expired deadline yields a zero duration.
+func RemainingTime(ctx context.Context) (remaining time.Duration, ok bool) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return 0, false
+	}
+	return max(time.Until(deadline), 0), true
+}
+
+// WithTimeoutCause is context.WithTimeout whose expiry makes
+// context.Cause(ctx) return cause instead of the bare
+// context.DeadlineExceeded. A nil cause is replaced by one naming the
+// timeout, which still matches context.DeadlineExceeded with errors.Is.
+func WithTimeoutCause(parent context.Context, timeout time.Duration, cause error) (context.Context, context.CancelFunc) {
+	if cause == nil {
+		cause = fmt.Errorf("timed out after %s: %w", HumanizeDuration(timeout), context.DeadlineExceeded)
+	}
+	return context.WithTimeoutCause(parent, timeout, cause)
+}
+
+// requireTime fails fast with ErrInsufficientTime when ctx's deadline
+// leaves less than need; contexts without a deadline always pass
+func requireTime(ctx context.Context, need time.Duration) error {
+	remaining, ok := RemainingTime(ctx)
+	if ok && remaining < need {
+		return fmt.Errorf("%w: need %s, %s left", ErrInsufficientTime,
+			HumanizeDuration(need), HumanizeDuration(remaining.Round(time.Millisecond)))
+	}
+	return nil
+}
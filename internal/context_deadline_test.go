@@ -0,0 +1,72 @@
+// context_deadline_test.go
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestRemainingTime(t *testing.T) {
+	_, ok := RemainingTime(context.Background())
+	testutil.AssertEqual(t, ok, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	remaining, ok := RemainingTime(ctx)
+	testutil.AssertEqual(t, ok, true)
+	if remaining <= 59*time.Minute || remaining > time.Hour {
+		t.Fatalf("remaining = %v, want just under 1h", remaining)
+	}
+
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	remaining, ok = RemainingTime(expired)
+	testutil.AssertEqual(t, ok, true)
+	testutil.AssertEqual(t, remaining, time.Duration(0))
+}
+
+func TestWithTimeoutCause(t *testing.T) {
+	errSlow := errors.New("upstream too slow")
+	ctx, cancel := WithTimeoutCause(context.Background(), time.Millisecond, errSlow)
+	defer cancel()
+	<-ctx.Done()
+	testutil.AssertErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+	testutil.AssertErrorIs(t, context.Cause(ctx), errSlow)
+
+	ctx, cancel = WithTimeoutCause(context.Background(), time.Millisecond, nil)
+	defer cancel()
+	<-ctx.Done()
+	cause := context.Cause(ctx)
+	testutil.AssertErrorIs(t, cause, context.DeadlineExceeded)
+	testutil.AssertEqual(t, cause.Error(), "timed out after 1ms: context deadline exceeded")
+}
+
+func TestWithTimeoutCauseCancelled(t *testing.T) {
+	ctx, cancel := WithTimeoutCause(context.Background(), time.Hour, errors.New("unused"))
+	cancel()
+	testutil.AssertErrorIs(t, context.Cause(ctx), context.Canceled)
+}
+
+func TestRequireTime(t *testing.T) {
+	testutil.AssertNoError(t, requireTime(context.Background(), time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	testutil.AssertNoError(t, requireTime(ctx, time.Millisecond))
+
+	err := requireTime(ctx, time.Minute)
+	testutil.AssertErrorIs(t, err, ErrInsufficientTime)
+	testutil.AssertContains(t, err.Error(), "need 1m, ")
+}
+
+func TestDatabaseServiceSkipsWorkWithoutTime(t *testing.T) {
+	db := &DatabaseService{delay: time.Second}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := db.GetUser(ctx, "u1")
+	testutil.AssertErrorIs(t, err, ErrInsufficientTime)
+}
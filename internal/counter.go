@@ -0,0 +1,69 @@
+// counter.go
+package internal
+
+import (
+	"fmt"
+	"sort"
+)
+
+// KeyCount pairs a key with how often it occurred
+type KeyCount[K comparable] struct {
+	Key   K
+	Count int
+}
+
+// Increment adds delta to m[key]; a missing key starts from zero
+func Increment[K comparable](m map[K]int, key K, delta int) {
+	m[key] += delta
+}
+
+// CounterFromSlice tallies how many times each element occurs in s
+func CounterFromSlice[T comparable](s []T) map[T]int {
+	counts := make(map[T]int, len(s))
+	for _, item := range s {
+		Increment(counts, item, 1)
+	}
+	return counts
+}
+
+// TopCounts returns the n most frequent keys of m, highest count first.
+// Equal counts are ordered by key, comparing keys by their fmt.Sprint form
+// since comparable types have no natural order. If n exceeds len(m) every
+// key is returned; a negative n returns none.
+func TopCounts[K comparable](m map[K]int, n int) []KeyCount[K] {
+	all := make([]KeyCount[K], 0, len(m))
+	for key, count := range m {
+		all = append(all, KeyCount[K]{Key: key, Count: count})
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Count != all[j].Count {
+			return all[i].Count > all[j].Count
+		}
+		return compareKeys(all[i].Key, all[j].Key)
+	})
+
+	n = min(max(n, 0), len(all))
+	return all[:n]
+}
+
+// compareKeys orders strings and numbers naturally and anything else by
+// its printed form. Keys of an interface type may hold different dynamic
+// types; a mixed pair falls back to the printed form as well.
+func compareKeys[K comparable](a, b K) bool {
+	switch x := any(a).(type) {
+	case string:
+		if y, ok := any(b).(string); ok {
+			return x < y
+		}
+	case int:
+		if y, ok := any(b).(int); ok {
+			return x < y
+		}
+	case float64:
+		if y, ok := any(b).(float64); ok {
+			return x < y
+		}
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
@@ -0,0 +1,55 @@
+// counter_test.go
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestCounterFromSlice(t *testing.T) {
+	counts := CounterFromSlice(strings.Fields("the cat and the hat and the bat"))
+	testutil.AssertEqual(t, len(counts), 5)
+	testutil.AssertEqual(t, counts["the"], 3)
+	testutil.AssertEqual(t, counts["and"], 2)
+	testutil.AssertEqual(t, counts["bat"], 1)
+	testutil.AssertEqual(t, len(CounterFromSlice([]int(nil))), 0)
+}
+
+func TestIncrement(t *testing.T) {
+	m := map[string]int{}
+	Increment(m, "a", 2)
+	Increment(m, "a", 3)
+	Increment(m, "b", -1)
+	testutil.AssertEqual(t, m["a"], 5)
+	testutil.AssertEqual(t, m["b"], -1)
+}
+
+func TestTopCountsTieBreaking(t *testing.T) {
+	m := map[string]int{"pear": 2, "apple": 2, "fig": 5, "kiwi": 1, "date": 2}
+	top := TopCounts(m, 3)
+	testutil.AssertEqual(t, fmt.Sprint(top), "[{fig 5} {apple 2} {date 2}]")
+
+	nums := map[int]int{10: 1, 9: 1, 100: 1}
+	testutil.AssertEqual(t, fmt.Sprint(TopCounts(nums, 3)), "[{9 1} {10 1} {100 1}]") // numeric, not textual, order
+}
+
+func TestTopCountsBounds(t *testing.T) {
+	m := map[string]int{"x": 1, "y": 2}
+	testutil.AssertEqual(t, fmt.Sprint(TopCounts(m, 10)), "[{y 2} {x 1}]")
+	testutil.AssertEqual(t, len(TopCounts(m, 0)), 0)
+	testutil.AssertEqual(t, len(TopCounts(m, -1)), 0)
+	testutil.AssertEqual(t, len(TopCounts(map[string]int{}, 3)), 0)
+}
+
+func TestTopCountsMixedInterfaceKeys(t *testing.T) {
+	m := map[any]int{"b": 1, 10: 1, "a": 1, 9: 1, 2.5: 3}
+	top := TopCounts(m, len(m))
+	keys := make([]string, len(top))
+	for i, kc := range top {
+		keys[i] = fmt.Sprint(kc.Key)
+	}
+	testutil.AssertEqual(t, strings.Join(keys, " "), "2.5 9 10 a b")
+}
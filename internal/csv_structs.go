@@ -0,0 +1,165 @@
+// csv_structs.go
+package internal
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// csvColumn maps one CSV column to a struct field
+type csvColumn struct {
+	name  string
+	index int
+}
+
+// csvColumns lists the columns for struct type t: every exported scalar
+// field, named by its `csv:"..."` tag or else its Go name. Fields tagged
+// `csv:"-"` are skipped.
+func csvColumns(t reflect.Type) ([]csvColumn, error) {
+	var columns []csvColumn
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Tag.Get("csv")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if !isScalarType(field.Type) {
+			return nil, fmt.Errorf("field %s: unsupported type %s", field.Name, field.Type)
+		}
+		columns = append(columns, csvColumn{name: name, index: i})
+	}
+	return columns, nil
+}
+
+// csvStructType returns the struct type held by a slice whose elements are
+// structs or pointers to structs
+func csvStructType(sliceType reflect.Type) (reflect.Type, bool) {
+	elem := sliceType.Elem()
+	if elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+	return elem, elem.Kind() == reflect.Struct
+}
+
+// EncodeCSVStructs writes records, a slice of structs (or struct
+// pointers), as CSV: a header row from the column names followed by one
+// row per element. encoding/csv quotes values containing commas, quotes
+// or newlines.
+func EncodeCSVStructs(w io.Writer, records interface{}) error {
+	rv := reflect.ValueOf(records)
+	if rv.Kind() != reflect.Slice {
+		return fmt.Errorf("records must be a slice of structs, got %T", records)
+	}
+	structType, ok := csvStructType(rv.Type())
+	if !ok {
+		return fmt.Errorf("records must be a slice of structs, got %T", records)
+	}
+	columns, err := csvColumns(structType)
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	row := make([]string, len(columns))
+	for i, col := range columns {
+		row[i] = col.name
+	}
+	if err := cw.Write(row); err != nil {
+		return err
+	}
+
+	for i := 0; i < rv.Len(); i++ {
+		elem := rv.Index(i)
+		if elem.Kind() == reflect.Ptr {
+			if elem.IsNil() {
+				return fmt.Errorf("record %d is nil", i)
+			}
+			elem = elem.Elem()
+		}
+		for j, col := range columns {
+			row[j] = scalarString(elem.Field(col.index))
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// DecodeCSVStructs reads CSV with a header row, appending each row to dest,
+// a pointer to a slice of structs (or struct pointers). Columns are matched
+// to fields by name; unknown columns are ignored and missing ones leave the
+// field at its zero value. Errors name the offending line and column.
+func DecodeCSVStructs(r io.Reader, dest interface{}) error {
+	rv := reflect.ValueOf(dest)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("dest must be a pointer to a slice of structs, got %T", dest)
+	}
+	slice := rv.Elem()
+	structType, ok := csvStructType(slice.Type())
+	if !ok {
+		return fmt.Errorf("dest must be a pointer to a slice of structs, got %T", dest)
+	}
+	columns, err := csvColumns(structType)
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]int, len(columns))
+	for _, col := range columns {
+		byName[col.name] = col.index
+	}
+
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// fieldFor[i] is the struct field for CSV column i, or -1 to ignore it
+	fieldFor := make([]int, len(header))
+	for i, name := range header {
+		fieldFor[i] = -1
+		if index, ok := byName[name]; ok {
+			fieldFor[i] = index
+		}
+	}
+
+	for {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		line, _ := cr.FieldPos(0)
+
+		elem := reflect.New(structType).Elem()
+		for i, value := range row {
+			if fieldFor[i] < 0 {
+				continue
+			}
+			if err := setScalar(elem.Field(fieldFor[i]), value); err != nil {
+				return fmt.Errorf("line %d, column %q: %w", line, header[i], err)
+			}
+		}
+
+		if slice.Type().Elem().Kind() == reflect.Ptr {
+			elem = elem.Addr()
+		}
+		slice.Set(reflect.Append(slice, elem))
+	}
+}
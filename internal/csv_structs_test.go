@@ -0,0 +1,82 @@
+// csv_structs_test.go
+package internal
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+type csvProduct struct {
+	SKU     string        `csv:"sku"`
+	Name    string        `csv:"name"`
+	Price   float64       `csv:"price"`
+	Stock   int           `csv:"stock"`
+	Active  bool          `csv:"active"`
+	Lead    time.Duration `csv:"lead_time"`
+	Note    string        `csv:"-"`
+	Comment string
+	secret  string
+}
+
+func TestEncodeCSVStructsRoundTrip(t *testing.T) {
+	products := []csvProduct{
+		{SKU: "A-1", Name: "Widget, large", Price: 9.99, Stock: 3, Active: true, Lead: 48 * time.Hour, Note: "dropped"},
+		{SKU: "B-2", Name: `Gadget "pro"`, Price: 120, Stock: 0, Lead: 90 * time.Minute, Comment: "line\nbreak"},
+	}
+
+	var b strings.Builder
+	testutil.AssertNoError(t, EncodeCSVStructs(&b, products))
+	out := b.String()
+	testutil.AssertEqual(t, strings.SplitN(out, "\n", 2)[0], "sku,name,price,stock,active,lead_time,Comment")
+	testutil.AssertContains(t, out, `"Widget, large"`)
+	testutil.AssertContains(t, out, `"Gadget ""pro"""`)
+
+	var decoded []csvProduct
+	testutil.AssertNoError(t, DecodeCSVStructs(strings.NewReader(out), &decoded))
+	testutil.AssertEqual(t, len(decoded), len(products))
+	for i := range products {
+		want := products[i]
+		want.Note = "" // csv:"-" is not written
+		testutil.AssertEqual(t, decoded[i], want)
+	}
+}
+
+func TestEncodeCSVStructsPointers(t *testing.T) {
+	var b strings.Builder
+	testutil.AssertNoError(t, EncodeCSVStructs(&b, []*csvProduct{{SKU: "p"}}))
+	var decoded []*csvProduct
+	testutil.AssertNoError(t, DecodeCSVStructs(strings.NewReader(b.String()), &decoded))
+	testutil.AssertEqual(t, decoded[0].SKU, "p")
+
+	err := EncodeCSVStructs(&b, []*csvProduct{nil})
+	testutil.AssertEqual(t, err.Error(), "record 0 is nil")
+}
+
+func TestEncodeCSVStructsErrors(t *testing.T) {
+	var b strings.Builder
+	err := EncodeCSVStructs(&b, csvProduct{})
+	testutil.AssertEqual(t, err.Error(), "records must be a slice of structs, got internal.csvProduct")
+	err = EncodeCSVStructs(&b, []int{1})
+	testutil.AssertEqual(t, err.Error(), "records must be a slice of structs, got []int")
+	err = EncodeCSVStructs(&b, []struct{ Tags []string }{})
+	testutil.AssertEqual(t, err.Error(), "field Tags: unsupported type []string")
+}
+
+func TestDecodeCSVStructsColumnsAndErrors(t *testing.T) {
+	var decoded []csvProduct
+	input := "extra,stock,sku\nx,5,A\ny,7,B\n"
+	testutil.AssertNoError(t, DecodeCSVStructs(strings.NewReader(input), &decoded))
+	testutil.AssertEqual(t, len(decoded), 2)
+	testutil.AssertEqual(t, decoded[1], csvProduct{SKU: "B", Stock: 7})
+
+	err := DecodeCSVStructs(strings.NewReader("sku,stock\nA,1\nB,many\n"), &decoded)
+	testutil.AssertContains(t, err.Error(), `line 3, column "stock": `)
+
+	err = DecodeCSVStructs(strings.NewReader(""), &decoded)
+	testutil.AssertNoError(t, err)
+	err = DecodeCSVStructs(strings.NewReader(""), decoded)
+	testutil.AssertEqual(t, err.Error(), "dest must be a pointer to a slice of structs, got []internal.csvProduct")
+}
@@ -0,0 +1,128 @@
+// csv_validate.go
+package internal
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// CSVField describes one column of a CSVSchema. Type is the Go type each
+// value must parse as (any type setScalar supports) and Rules uses the
+// same syntax as the `validate:"..."` struct tag, e.g. "required,min=2".
+type CSVField struct {
+	Name  string
+	Type  reflect.Type
+	Rules string
+}
+
+// CSVSchema lists the columns ValidateCSV checks. Columns are matched by
+// header name; columns not in the schema are ignored.
+type CSVSchema struct {
+	Fields []CSVField
+}
+
+// RowError is one problem found by ValidateCSV. Line is the 1-based line
+// in the input; Column is empty for problems with the row as a whole.
+type RowError struct {
+	Line    int
+	Column  string
+	Message string
+}
+
+func (e RowError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+	}
+	return fmt.Sprintf("line %d, column %q: %s", e.Line, e.Column, e.Message)
+}
+
+// ValidateCSV streams r, which must start with a header row, and checks
+// every value against schema using the reflection validation rules. Bad
+// rows are collected rather than stopping the scan, so one pass reports
+// everything wrong with a file. The error is only for problems that make
+// the rest of the input unreadable: malformed CSV, a schema column missing
+// from the header, or a failed read.
+func ValidateCSV(r io.Reader, schema CSVSchema) ([]RowError, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1 // ragged rows are reported as RowErrors
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	position := make(map[string]int, len(header))
+	for i, name := range header {
+		position[name] = i
+	}
+	columns := make([]int, len(schema.Fields))
+	for i, field := range schema.Fields {
+		index, ok := position[field.Name]
+		if !ok {
+			return nil, fmt.Errorf("header is missing column %q", field.Name)
+		}
+		columns[i] = index
+	}
+
+	var problems []RowError
+	for {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return problems, nil
+		}
+		if err != nil {
+			return problems, err
+		}
+		line, _ := cr.FieldPos(0)
+
+		if len(row) != len(header) {
+			problems = append(problems, RowError{
+				Line:    line,
+				Message: fmt.Sprintf("expected %d fields, got %d", len(header), len(row)),
+			})
+			continue
+		}
+		for i, field := range schema.Fields {
+			if msg := validateCSVValue(field, row[columns[i]]); msg != "" {
+				problems = append(problems, RowError{Line: line, Column: field.Name, Message: msg})
+			}
+		}
+	}
+}
+
+// validateCSVValue parses raw as field.Type and applies field.Rules,
+// returning the first failure or "" if the value is acceptable
+func validateCSVValue(field CSVField, raw string) string {
+	rules := strings.Split(field.Rules, ",")
+	for i := range rules {
+		rules[i] = strings.TrimSpace(rules[i])
+	}
+	if raw == "" {
+		for _, rule := range rules {
+			if rule == "required" {
+				return fmt.Sprintf("%s is required", field.Name)
+			}
+		}
+		return "" // optional and absent: nothing further to check
+	}
+
+	value := reflect.New(field.Type).Elem()
+	if err := setScalar(value, raw); err != nil {
+		return fmt.Sprintf("%s must be a valid %s", field.Name, field.Type)
+	}
+	for _, rule := range rules {
+		if rule == "" || rule == "required" {
+			continue
+		}
+		if msg := validateField(field.Name, value, rule); msg != "" {
+			return msg
+		}
+	}
+	return ""
+}
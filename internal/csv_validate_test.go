@@ -0,0 +1,60 @@
+// csv_validate_test.go
+package internal
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+var userCSVSchema = CSVSchema{Fields: []CSVField{
+	{Name: "id", Type: reflect.TypeOf(0), Rules: "required,min=1"},
+	{Name: "name", Type: reflect.TypeOf(""), Rules: "required, min=2"},
+	{Name: "email", Type: reflect.TypeOf(""), Rules: "email"},
+	{Name: "age", Type: reflect.TypeOf(0), Rules: "min=0,max=120"},
+}}
+
+func TestValidateCSVReportsEachProblem(t *testing.T) {
+	input := "" +
+		"id,name,email,age,notes\n" + // line 1
+		"1,Ada,ada@example.com,36,ok\n" + // 2: valid
+		"x,B,bob@example.com,20,\n" + // 3: bad id, short name
+		"3,Cy,not-an-email,130,\n" + // 4: email and age
+		"\"4\",\"Dee\",,,\"multi\nline\"\n" + // 5-6: optional columns empty, valid
+		"5,Eve\n" + // 7: too few fields
+		",Fay,fay@example.com,-1,\n" // 8: missing id, negative age
+
+	problems, err := ValidateCSV(strings.NewReader(input), userCSVSchema)
+	testutil.AssertNoError(t, err)
+
+	var got []string
+	for _, p := range problems {
+		got = append(got, p.Error())
+	}
+	want := []string{
+		`line 3, column "id": id must be a valid int`,
+		`line 3, column "name": name must be at least 2 characters`,
+		`line 4, column "email": email must be a valid email`,
+		`line 4, column "age": age must be at most 120`,
+		`line 7: expected 5 fields, got 2`,
+		`line 8, column "id": id is required`,
+		`line 8, column "age": age must be at least 0`,
+	}
+	testutil.AssertEqual(t, strings.Join(got, "\n"), strings.Join(want, "\n"))
+	testutil.AssertEqual(t, problems[0].Line, 3)
+	testutil.AssertEqual(t, problems[0].Column, "id")
+}
+
+func TestValidateCSVFatalErrors(t *testing.T) {
+	_, err := ValidateCSV(strings.NewReader("id,name,age\n1,Al,3\n"), userCSVSchema)
+	testutil.AssertEqual(t, err.Error(), `header is missing column "email"`)
+
+	_, err = ValidateCSV(strings.NewReader("id,name,email,age\n1,\"Al,x@y.z,3\n"), userCSVSchema)
+	testutil.AssertContains(t, err.Error(), "extraneous or missing \" in quoted-field")
+
+	problems, err := ValidateCSV(strings.NewReader(""), userCSVSchema)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(problems), 0)
+}
@@ -0,0 +1,111 @@
+// dedup_writer.go
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// DedupLineWriter collapses runs of identical consecutive lines into one,
+// like uniq. A line split across Write calls is held until its newline
+// arrives. With counts enabled each line is prefixed by its run length,
+// which means a line is only written once the next different line (or
+// Flush) shows the run has ended. Call Flush (or Close) when done.
+type DedupLineWriter struct {
+	mu      sync.Mutex
+	w       io.Writer
+	counts  bool
+	partial []byte // bytes after the last newline
+	last    []byte // most recent distinct line
+	repeats int    // length of the current run of last; 0 before any line
+}
+
+// NewDedupLineWriter wraps w; withCounts prefixes every line with the
+// number of times it repeated
+func NewDedupLineWriter(w io.Writer, withCounts bool) *DedupLineWriter {
+	return &DedupLineWriter{w: w, counts: withCounts}
+}
+
+// Write forwards each complete line in p unless it repeats the previous
+// one. It reports len(p) on success.
+func (d *DedupLineWriter) Write(p []byte) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.partial = append(d.partial, p...)
+	for {
+		i := bytes.IndexByte(d.partial, '\n')
+		if i < 0 {
+			break
+		}
+		err := d.line(d.partial[:i])
+		d.partial = d.partial[i+1:]
+		if err != nil {
+			return 0, err
+		}
+	}
+	d.partial = append([]byte(nil), d.partial...) // drop the consumed prefix
+	return len(p), nil
+}
+
+// Flush treats any unterminated trailing text as a final line and writes
+// out the pending run. The next line written starts a fresh comparison.
+func (d *DedupLineWriter) Flush() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.partial) > 0 {
+		err := d.line(d.partial)
+		d.partial = nil
+		if err != nil {
+			return err
+		}
+	}
+	err := d.emitRun()
+	d.last, d.repeats = nil, 0
+	return err
+}
+
+// Close flushes the writer, then closes the downstream writer if it is an
+// io.Closer
+func (d *DedupLineWriter) Close() error {
+	if err := d.Flush(); err != nil {
+		return err
+	}
+	if closer, ok := d.w.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// line handles one complete line without its newline. The caller holds d.mu.
+func (d *DedupLineWriter) line(text []byte) error {
+	if d.repeats > 0 && bytes.Equal(text, d.last) {
+		d.repeats++
+		return nil
+	}
+	if d.counts {
+		if err := d.emitRun(); err != nil {
+			return err
+		}
+	}
+	d.last = append(d.last[:0], text...)
+	d.repeats = 1
+	if d.counts {
+		return nil
+	}
+	_, err := d.w.Write(append(append([]byte(nil), text...), '\n'))
+	return err
+}
+
+// emitRun writes the counted run of the last line, if counts are enabled
+// and a run is pending. The caller holds d.mu.
+func (d *DedupLineWriter) emitRun() error {
+	if !d.counts || d.repeats == 0 {
+		return nil
+	}
+	_, err := fmt.Fprintf(d.w, "%4d %s\n", d.repeats, d.last)
+	return err
+}
@@ -0,0 +1,71 @@
+// dedup_writer_test.go
+package internal
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// writeChunks feeds chunks to d, failing t on any error
+func writeChunks(t *testing.T, d *DedupLineWriter, chunks ...string) {
+	t.Helper()
+	for _, chunk := range chunks {
+		n, err := d.Write([]byte(chunk))
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, n, len(chunk))
+	}
+}
+
+func TestDedupLineWriterAcrossWrites(t *testing.T) {
+	var out strings.Builder
+	d := NewDedupLineWriter(&out, false)
+	writeChunks(t, d, "alpha\nal", "pha\nalpha\nbe", "ta\n", "beta\nalpha", "\ngamma")
+
+	// Lines are forwarded as soon as they are known to be new
+	testutil.AssertEqual(t, out.String(), "alpha\nbeta\nalpha\n")
+	testutil.AssertNoError(t, d.Flush())
+	testutil.AssertEqual(t, out.String(), "alpha\nbeta\nalpha\ngamma\n")
+}
+
+func TestDedupLineWriterCounts(t *testing.T) {
+	var out strings.Builder
+	d := NewDedupLineWriter(&out, true)
+	writeChunks(t, d, "a\na", "\na\nb\n", "c\nc\n")
+
+	testutil.AssertEqual(t, out.String(), "   3 a\n   1 b\n")
+	testutil.AssertNoError(t, d.Flush())
+	testutil.AssertEqual(t, out.String(), "   3 a\n   1 b\n   2 c\n")
+}
+
+func TestDedupLineWriterFlushResetsComparison(t *testing.T) {
+	var out strings.Builder
+	d := NewDedupLineWriter(&out, false)
+	writeChunks(t, d, "x\n")
+	d.Flush()
+	writeChunks(t, d, "x\n", "\n", "\n")
+	d.Flush()
+	testutil.AssertEqual(t, out.String(), "x\nx\n\n")
+}
+
+func TestDedupLineWriterClose(t *testing.T) {
+	var out closeRecorder
+	d := NewDedupLineWriter(&out, true)
+	writeChunks(t, d, "tail")
+	testutil.AssertNoError(t, d.Close())
+	testutil.AssertEqual(t, out.String(), "   1 tail\n")
+	testutil.AssertEqual(t, out.closed, true)
+}
+
+type failingWriter struct{ err error }
+
+func (f failingWriter) Write([]byte) (int, error) { return 0, f.err }
+
+func TestDedupLineWriterDownstreamError(t *testing.T) {
+	errDisk := errors.New("disk full")
+	d := NewDedupLineWriter(failingWriter{errDisk}, false)
+	_, err := d.Write([]byte("line\n"))
+	testutil.AssertErrorIs(t, err, errDisk)
+}
@@ -0,0 +1,173 @@
+// deep_equal_approx.go
+package internal
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+	"unsafe"
+)
+
+// DeepEqualApprox compares a and b like reflect.DeepEqual, except that
+// floating-point values (including complex parts) are equal when they are
+// within tol of each other. When the values differ it also returns the path
+// of the first difference, such as "Items[2].Price" or `Meta["region"]`;
+// "(root)" means the top-level values themselves differ.
+func DeepEqualApprox(a, b interface{}, tol float64) (bool, string) {
+	c := approxComparer{tol: tol, visited: make(map[approxVisit]bool)}
+	if path, ok := c.equal(reflect.ValueOf(a), reflect.ValueOf(b), ""); !ok {
+		if path == "" {
+			path = "(root)"
+		}
+		return false, path
+	}
+	return true, ""
+}
+
+// approxVisit identifies a pair of pointers, maps or slices already being
+// compared, which stops the walk from looping forever on cyclic data
+type approxVisit struct {
+	a, b unsafe.Pointer
+	typ  reflect.Type
+}
+
+type approxComparer struct {
+	tol     float64
+	visited map[approxVisit]bool
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func (c *approxComparer) floatsEqual(x, y float64) bool {
+	if math.IsNaN(x) || math.IsNaN(y) {
+		return math.IsNaN(x) && math.IsNaN(y)
+	}
+	return x == y || math.Abs(x-y) <= c.tol
+}
+
+// visit records that a and b are being compared, reporting false when the
+// pair was already seen. Like reflect.DeepEqual, a repeated pair counts as
+// equal; any real difference shows up where it was first compared.
+func (c *approxComparer) visit(a, b reflect.Value) bool {
+	key := approxVisit{a.UnsafePointer(), b.UnsafePointer(), a.Type()}
+	if c.visited[key] {
+		return false
+	}
+	c.visited[key] = true
+	return true
+}
+
+// equal returns ok=false and the path of the first difference
+func (c *approxComparer) equal(a, b reflect.Value, path string) (string, bool) {
+	if !a.IsValid() || !b.IsValid() {
+		return path, a.IsValid() == b.IsValid()
+	}
+	if a.Type() != b.Type() {
+		return path, false
+	}
+
+	// Compare instants rather than internal representation (location pointers)
+	if a.Type() == timeType && a.CanInterface() {
+		return path, a.Interface().(time.Time).Equal(b.Interface().(time.Time))
+	}
+
+	switch a.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return path, c.floatsEqual(a.Float(), b.Float())
+
+	case reflect.Complex64, reflect.Complex128:
+		x, y := a.Complex(), b.Complex()
+		return path, c.floatsEqual(real(x), real(y)) && c.floatsEqual(imag(x), imag(y))
+
+	case reflect.Bool:
+		return path, a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return path, a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return path, a.Uint() == b.Uint()
+	case reflect.String:
+		return path, a.String() == b.String()
+
+	case reflect.Ptr:
+		if a.IsNil() || b.IsNil() {
+			return path, a.IsNil() == b.IsNil()
+		}
+		if a.Pointer() == b.Pointer() {
+			return "", true
+		}
+		if !c.visit(a, b) {
+			return "", true
+		}
+		return c.equal(a.Elem(), b.Elem(), path)
+
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return path, a.IsNil() == b.IsNil()
+		}
+		return c.equal(a.Elem(), b.Elem(), path)
+
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			field := joinFieldPath(path, a.Type().Field(i).Name)
+			if diff, ok := c.equal(a.Field(i), b.Field(i), field); !ok {
+				return diff, false
+			}
+		}
+		return "", true
+
+	case reflect.Slice:
+		if a.IsNil() != b.IsNil() {
+			return path, false
+		}
+		fallthrough
+	case reflect.Array:
+		if a.Len() != b.Len() {
+			return path, false
+		}
+		if a.Kind() == reflect.Slice && a.Len() > 0 && !c.visit(a, b) {
+			return "", true
+		}
+		for i := 0; i < a.Len(); i++ {
+			elem := path + "[" + strconv.Itoa(i) + "]"
+			if diff, ok := c.equal(a.Index(i), b.Index(i), elem); !ok {
+				return diff, false
+			}
+		}
+		return "", true
+
+	case reflect.Map:
+		if a.IsNil() != b.IsNil() || a.Len() != b.Len() {
+			return path, false
+		}
+		if !a.IsNil() && !c.visit(a, b) {
+			return "", true
+		}
+		for _, key := range sortedMapKeys(a) {
+			elem := path + "[" + formatScalar(key) + "]"
+			other := b.MapIndex(key)
+			if !other.IsValid() {
+				return elem, false
+			}
+			if diff, ok := c.equal(a.MapIndex(key), other, elem); !ok {
+				return diff, false
+			}
+		}
+		return "", true
+
+	default:
+		// Funcs, channels and unsafe pointers: equal only when both are nil
+		// or, for channels and pointers, identical
+		if a.Kind() == reflect.Func {
+			return path, a.IsNil() && b.IsNil()
+		}
+		return path, a.Pointer() == b.Pointer()
+	}
+}
+
+func joinFieldPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
@@ -0,0 +1,102 @@
+// deep_equal_approx_test.go
+package internal
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+type approxOrder struct {
+	ID    int
+	Items []Product
+	Meta  map[string]float64
+	At    time.Time
+}
+
+func TestDeepEqualApproxTolerance(t *testing.T) {
+	at := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	expected := approxOrder{
+		ID:    7,
+		Items: []Product{{Name: "Laptop", Price: 0.1 + 0.2}, {Name: "Desk", Price: 249.5}},
+		Meta:  map[string]float64{"tax": 0.2},
+		At:    at,
+	}
+	computed := approxOrder{
+		ID:    7,
+		Items: []Product{{Name: "Laptop", Price: 0.3}, {Name: "Desk", Price: 249.5}},
+		Meta:  map[string]float64{"tax": 0.2 + 1e-12},
+		At:    at.In(time.FixedZone("CET", 3600)), // same instant, other location
+	}
+
+	equal, path := DeepEqualApprox(expected, computed, 1e-9)
+	testutil.AssertEqual(t, equal, true)
+	testutil.AssertEqual(t, path, "")
+
+	computed.Items[1].Price = 250
+	equal, path = DeepEqualApprox(expected, computed, 1e-9)
+	testutil.AssertEqual(t, equal, false)
+	testutil.AssertEqual(t, path, "Items[1].Price")
+
+	computed.Items[1].Price = 249.5
+	computed.Meta["tax"] = 0.25
+	_, path = DeepEqualApprox(expected, computed, 1e-9)
+	testutil.AssertEqual(t, path, `Meta["tax"]`)
+}
+
+func TestDeepEqualApproxPaths(t *testing.T) {
+	tests := []struct {
+		a, b interface{}
+		path string
+	}{
+		{1, 2, "(root)"},
+		{1, int64(1), "(root)"},
+		{[]int{1, 2}, []int{1}, "(root)"},
+		{[]int(nil), []int{}, "(root)"},
+		{map[string]int{"a": 1}, map[string]int{"b": 1}, `["a"]`},
+		{&Product{Name: "a"}, &Product{Name: "b"}, "Name"},
+		{nil, 0, "(root)"},
+	}
+	for _, tt := range tests {
+		equal, path := DeepEqualApprox(tt.a, tt.b, 0)
+		testutil.AssertEqual(t, equal, false)
+		testutil.AssertEqual(t, path, tt.path)
+	}
+
+	equal, _ := DeepEqualApprox(math.NaN(), math.NaN(), 0)
+	testutil.AssertEqual(t, equal, true)
+	equal, _ = DeepEqualApprox(complex(1, 2), complex(1.0005, 2), 1e-3)
+	testutil.AssertEqual(t, equal, true)
+}
+
+func TestDeepEqualApproxCycles(t *testing.T) {
+	a := &cloneNode{Name: "a"}
+	a.Next = a
+	b := &cloneNode{Name: "a"}
+	b.Next = b
+	equal, _ := DeepEqualApprox(a, b, 0)
+	testutil.AssertEqual(t, equal, true)
+}
+
+func TestDeepEqualApproxCyclicMapsAndSlices(t *testing.T) {
+	a := map[string]interface{}{"price": 1.0}
+	a["self"] = a
+	b := map[string]interface{}{"price": 1.0000001}
+	b["self"] = b
+	equal, _ := DeepEqualApprox(a, b, 1e-3)
+	testutil.AssertEqual(t, equal, true)
+
+	b["price"] = 2.0
+	equal, path := DeepEqualApprox(a, b, 1e-3)
+	testutil.AssertEqual(t, equal, false)
+	testutil.AssertEqual(t, path, `["price"]`)
+
+	x := []interface{}{1.0, nil}
+	x[1] = x
+	y := []interface{}{1.0, nil}
+	y[1] = y
+	equal, _ = DeepEqualApprox(x, y, 0)
+	testutil.AssertEqual(t, equal, true)
+}
@@ -0,0 +1,127 @@
+// dependency_graph.go
+package internal
+
+import (
+	"sort"
+	"strings"
+)
+
+// DependencyGraph is a directed graph of named nodes where an edge from A
+// to B means "A depends on B". The zero value is not usable; call
+// NewDependencyGraph.
+type DependencyGraph struct {
+	order []string            // nodes in insertion order, for stable output
+	deps  map[string][]string // node -> what it depends on
+	known map[string]bool
+}
+
+// CycleError reports a dependency cycle; Cycle starts and ends on the
+// same node
+type CycleError struct {
+	Cycle []string
+}
+
+func (e *CycleError) Error() string {
+	return "dependency cycle: " + strings.Join(e.Cycle, " -> ")
+}
+
+// NewDependencyGraph creates an empty graph
+func NewDependencyGraph() *DependencyGraph {
+	return &DependencyGraph{deps: make(map[string][]string), known: make(map[string]bool)}
+}
+
+// AddNode adds a node with no dependencies; adding it again is a no-op
+func (g *DependencyGraph) AddNode(name string) {
+	if !g.known[name] {
+		g.known[name] = true
+		g.order = append(g.order, name)
+	}
+}
+
+// AddEdge records that from depends on to, adding either node if needed
+func (g *DependencyGraph) AddEdge(from, to string) {
+	g.AddNode(from)
+	g.AddNode(to)
+	for _, existing := range g.deps[from] {
+		if existing == to {
+			return
+		}
+	}
+	g.deps[from] = append(g.deps[from], to)
+}
+
+// Dependents returns the nodes that depend directly on node, sorted
+func (g *DependencyGraph) Dependents(node string) []string {
+	var dependents []string
+	for _, name := range g.order {
+		for _, dep := range g.deps[name] {
+			if dep == node {
+				dependents = append(dependents, name)
+				break
+			}
+		}
+	}
+	sort.Strings(dependents)
+	return dependents
+}
+
+// TopoSort orders the nodes so every node comes after everything it
+// depends on, e.g. a build order. Among nodes that are ready at the same
+// time, insertion order wins. A cycle is reported as a *CycleError.
+func (g *DependencyGraph) TopoSort() ([]string, error) {
+	// Kahn's algorithm: repeatedly take nodes whose dependencies are all placed
+	remaining := make(map[string]int, len(g.order))
+	for _, name := range g.order {
+		remaining[name] = len(g.deps[name])
+	}
+
+	sorted := make([]string, 0, len(g.order))
+	placed := make(map[string]bool, len(g.order))
+	for len(sorted) < len(g.order) {
+		progress := false
+		for _, name := range g.order {
+			if placed[name] || remaining[name] > 0 {
+				continue
+			}
+			placed[name] = true
+			sorted = append(sorted, name)
+			progress = true
+			for _, dependent := range g.Dependents(name) {
+				remaining[dependent]--
+			}
+		}
+		if !progress {
+			return nil, &CycleError{Cycle: g.findCycle(placed)}
+		}
+	}
+	return sorted, nil
+}
+
+// findCycle walks dependencies from an unplaced node. Every unplaced node
+// still has an unplaced dependency, so the walk must revisit a node, and
+// the path from that node's first visit is a cycle.
+func (g *DependencyGraph) findCycle(placed map[string]bool) []string {
+	var start string
+	for _, name := range g.order {
+		if !placed[name] {
+			start = name
+			break
+		}
+	}
+
+	seenAt := make(map[string]int)
+	var path []string
+	for node := start; ; {
+		if i, seen := seenAt[node]; seen {
+			return append(path[i:], node)
+		}
+		seenAt[node] = len(path)
+		path = append(path, node)
+		for _, dep := range g.deps[node] {
+			if !placed[dep] {
+				node = dep
+				break
+			}
+		}
+	}
+}
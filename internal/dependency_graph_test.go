@@ -0,0 +1,73 @@
+// dependency_graph_test.go
+package internal
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestDependencyGraphTopoSort(t *testing.T) {
+	g := NewDependencyGraph()
+	g.AddEdge("cmd", "api")
+	g.AddEdge("cmd", "config")
+	g.AddEdge("api", "store")
+	g.AddEdge("api", "config")
+	g.AddEdge("store", "config")
+	g.AddEdge("store", "config") // duplicate edges are ignored
+	g.AddNode("docs")
+
+	order, err := g.TopoSort()
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, strings.Join(order, " "), "config store docs api cmd")
+
+	// Every node comes after everything it depends on
+	position := map[string]int{}
+	for i, name := range order {
+		position[name] = i
+	}
+	for from, deps := range g.deps {
+		for _, to := range deps {
+			if position[from] < position[to] {
+				t.Errorf("%s placed before its dependency %s", from, to)
+			}
+		}
+	}
+}
+
+func TestDependencyGraphDependents(t *testing.T) {
+	g := NewDependencyGraph()
+	g.AddEdge("web", "db")
+	g.AddEdge("api", "db")
+	g.AddEdge("api", "cache")
+	testutil.AssertEqual(t, strings.Join(g.Dependents("db"), ","), "api,web")
+	testutil.AssertEqual(t, strings.Join(g.Dependents("cache"), ","), "api")
+	testutil.AssertEqual(t, len(g.Dependents("web")), 0)
+}
+
+func TestDependencyGraphCycle(t *testing.T) {
+	g := NewDependencyGraph()
+	g.AddEdge("base", "util")
+	g.AddEdge("a", "b")
+	g.AddEdge("b", "c")
+	g.AddEdge("c", "a")
+	g.AddEdge("c", "base")
+
+	_, err := g.TopoSort()
+	var cycleErr *CycleError
+	testutil.AssertEqual(t, errors.As(err, &cycleErr), true)
+	testutil.AssertEqual(t, err.Error(), "dependency cycle: a -> b -> c -> a")
+
+	self := NewDependencyGraph()
+	self.AddEdge("x", "x")
+	_, err = self.TopoSort()
+	testutil.AssertEqual(t, err.Error(), "dependency cycle: x -> x")
+}
+
+func TestDependencyGraphEmpty(t *testing.T) {
+	order, err := NewDependencyGraph().TopoSort()
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(order), 0)
+}
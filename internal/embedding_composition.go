@@ -7,16 +7,28 @@ import (
 )
 
 func (l *Logger) Log(message string) {
-	fmt.Printf("[%s] %s\n", l.prefix, message)
+	fmt.Fprintf(l.output(), "%s %s\n", l.tag(), message)
 }
 
 // Base types for embedding examples
 type AutoEngine struct {
 	Horsepower int
 	Fuel       string
-	Running    bool
+	state      *StateMachine[EngineState, EngineEvent]
 }
 
+// EngineState and EngineEvent drive AutoEngine's state machine
+type EngineState string
+type EngineEvent string
+
+const (
+	EngineStopped EngineState = "stopped"
+	EngineRunning EngineState = "running"
+
+	EngineStart EngineEvent = "start"
+	EngineStop  EngineEvent = "stop"
+)
+
 type VehicleWheels struct {
 	Count int
 	Size  string
@@ -44,30 +56,45 @@ type VehicleHonker interface {
 }
 
 // Methods for base types
+// machine lazily builds the engine's state machine so zero-value and
+// literal-constructed engines work without a constructor
+func (e *AutoEngine) machine() *StateMachine[EngineState, EngineEvent] {
+	if e.state == nil {
+		e.state = NewStateMachine[EngineState, EngineEvent](EngineStopped).
+			AddTransition(EngineStopped, EngineStart, EngineRunning).
+			AddTransition(EngineRunning, EngineStop, EngineStopped)
+	}
+	return e.state
+}
+
 func (e *AutoEngine) Start() error {
-	if e.Running {
-		return fmt.Errorf("engine is already running")
+	if err := e.machine().Fire(EngineStart); err != nil {
+		return fmt.Errorf("engine is already %s", e.machine().Current())
 	}
-	e.Running = true
 	fmt.Printf("Engine started: %d HP, %s fuel\n", e.Horsepower, e.Fuel)
 	return nil
 }
 
 func (e *AutoEngine) Stop() error {
-	if !e.Running {
-		return fmt.Errorf("engine is already stopped")
+	if err := e.machine().Fire(EngineStop); err != nil {
+		return fmt.Errorf("engine is already %s", e.machine().Current())
 	}
-	e.Running = false
 	fmt.Println("Engine stopped")
 	return nil
 }
 
+// Running reports whether the engine is currently running
+func (e *AutoEngine) Running() bool {
+	return e.machine().Current() == EngineRunning
+}
+
+// OnStateChange registers a hook called on every engine state change
+func (e *AutoEngine) OnStateChange(hook func(from, to EngineState, event EngineEvent)) {
+	e.machine().OnTransition(hook)
+}
+
 func (e *AutoEngine) Status() string {
-	status := "stopped"
-	if e.Running {
-		status = "running"
-	}
-	return fmt.Sprintf("Engine: %d HP, %s fuel, %s", e.Horsepower, e.Fuel, status)
+	return fmt.Sprintf("Engine: %d HP, %s fuel, %s", e.Horsepower, e.Fuel, e.machine().Current())
 }
 
 func (g *NavigationGPS) Navigate(destination string) error {
@@ -314,6 +341,11 @@ func methodPromotionExample() {
 		Year:          2023,
 	}
 
+	// The engine's state machine reports every transition
+	car.OnStateChange(func(from, to EngineState, event EngineEvent) {
+		fmt.Printf("  [engine] %s: %s -> %s\n", event, from, to)
+	})
+
 	// Promoted methods from embedded structs
 	fmt.Printf("Starting %s:\n", car.String())
 	car.Start() // Promoted from AutoEngine
@@ -327,6 +359,9 @@ func methodPromotionExample() {
 	fmt.Printf("Wheels description: %s\n", car.Description()) // Promoted from VehicleWheels
 
 	car.Stop() // Promoted from AutoEngine
+	if err := car.Stop(); err != nil {
+		fmt.Printf("Second stop rejected: %v\n", err)
+	}
 	fmt.Println()
 }
 
@@ -0,0 +1,81 @@
+// errgroup.go
+package internal
+
+import (
+	"context"
+	"sync"
+)
+
+// ErrGroup runs functions in their own goroutines and reports the first
+// error any of them returns. The zero value is ready to use; a group made
+// by ErrGroupWithContext also cancels its context on that first error.
+type ErrGroup struct {
+	wg     sync.WaitGroup
+	once   sync.Once
+	err    error
+	cancel context.CancelCauseFunc
+}
+
+// ErrGroupWithContext returns a group and a context derived from ctx that
+// is cancelled as soon as a function fails or Wait returns
+func ErrGroupWithContext(ctx context.Context) (*ErrGroup, context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	return &ErrGroup{cancel: cancel}, ctx
+}
+
+// Go starts fn in a new goroutine
+func (g *ErrGroup) Go(fn func() error) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if err := fn(); err != nil {
+			g.once.Do(func() {
+				g.err = err
+				if g.cancel != nil {
+					g.cancel(err)
+				}
+			})
+		}
+	}()
+}
+
+// Wait blocks until every function started with Go has returned, then
+// reports the first error
+func (g *ErrGroup) Wait() error {
+	g.wg.Wait()
+	if g.cancel != nil {
+		g.cancel(g.err)
+	}
+	return g.err
+}
+
+// Collect runs fns concurrently and returns their results in the order of
+// fns, along with the first error to occur. Failed calls leave a zero value
+// in their slot. Use CollectContext when the functions should stop early
+// once another one has failed.
+func Collect[T any](fns []func() (T, error)) ([]T, error) {
+	wrapped := make([]func(context.Context) (T, error), len(fns))
+	for i, fn := range fns {
+		wrapped[i] = func(context.Context) (T, error) { return fn() }
+	}
+	return CollectContext(context.Background(), wrapped)
+}
+
+// CollectContext is Collect for functions that accept a context; the
+// context is cancelled on the first failure
+func CollectContext[T any](ctx context.Context, fns []func(context.Context) (T, error)) ([]T, error) {
+	g, ctx := ErrGroupWithContext(ctx)
+	results := make([]T, len(fns))
+	for i, fn := range fns {
+		g.Go(func() error {
+			result, err := fn(ctx)
+			if err != nil {
+				return err
+			}
+			results[i] = result
+			return nil
+		})
+	}
+	err := g.Wait()
+	return results, err
+}
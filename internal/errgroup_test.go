@@ -0,0 +1,90 @@
+// errgroup_test.go
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestErrGroupWaitsForAll(t *testing.T) {
+	var g ErrGroup
+	var done atomic.Int32
+	for i := 0; i < 5; i++ {
+		g.Go(func() error {
+			time.Sleep(time.Duration(i) * time.Millisecond)
+			done.Add(1)
+			return nil
+		})
+	}
+	testutil.AssertNoError(t, g.Wait())
+	testutil.AssertEqual(t, done.Load(), int32(5))
+}
+
+func TestErrGroupFirstErrorCancelsContext(t *testing.T) {
+	errBoom := errors.New("boom")
+	g, ctx := ErrGroupWithContext(context.Background())
+
+	g.Go(func() error { return errBoom })
+	g.Go(func() error {
+		<-ctx.Done() // released only by the cancellation
+		return fmt.Errorf("late: %w", ctx.Err())
+	})
+
+	testutil.AssertErrorIs(t, g.Wait(), errBoom)
+	testutil.AssertErrorIs(t, context.Cause(ctx), errBoom)
+}
+
+func TestErrGroupWithContextCancelsOnWait(t *testing.T) {
+	g, ctx := ErrGroupWithContext(context.Background())
+	g.Go(func() error { return nil })
+	testutil.AssertNoError(t, g.Wait())
+	testutil.AssertErrorIs(t, ctx.Err(), context.Canceled)
+}
+
+func TestCollectOrderedResults(t *testing.T) {
+	var fns []func() (int, error)
+	for i := 0; i < 8; i++ {
+		fns = append(fns, func() (int, error) {
+			time.Sleep(time.Duration(8-i) * time.Millisecond) // finish in reverse order
+			return i * i, nil
+		})
+	}
+	results, err := Collect(fns)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, fmt.Sprint(results), "[0 1 4 9 16 25 36 49]")
+}
+
+func TestCollectFirstError(t *testing.T) {
+	errFail := errors.New("fail")
+	results, err := Collect([]func() (string, error){
+		func() (string, error) { return "a", nil },
+		func() (string, error) { return "", errFail },
+		func() (string, error) { return "c", nil },
+	})
+	testutil.AssertErrorIs(t, err, errFail)
+	testutil.AssertEqual(t, results[0], "a")
+	testutil.AssertEqual(t, results[1], "")
+	testutil.AssertEqual(t, results[2], "c")
+}
+
+func TestCollectContextStopsOthers(t *testing.T) {
+	errFail := errors.New("fail")
+	_, err := CollectContext(context.Background(), []func(context.Context) (int, error){
+		func(ctx context.Context) (int, error) { return 0, errFail },
+		func(ctx context.Context) (int, error) {
+			select {
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			case <-time.After(5 * time.Second):
+				return 1, nil
+			}
+		},
+	})
+	testutil.AssertErrorIs(t, err, errFail)
+}
@@ -35,6 +35,27 @@ func (e *DatabaseError) Unwrap() error {
 	return e.Err
 }
 
+// MultiError collects several independent failures into one error
+type MultiError struct {
+	Errors []error
+}
+
+func (e *MultiError) Error() string {
+	if len(e.Errors) == 1 {
+		return e.Errors[0].Error()
+	}
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d errors occurred: %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// Unwrap exposes the collected errors to errors.Is and errors.As
+func (e *MultiError) Unwrap() []error {
+	return e.Errors
+}
+
 // User struct for examples
 type User struct {
 	ID    int
@@ -523,6 +544,23 @@ func stringToIntExample() {
 			fmt.Printf("Converted '%s' to int: %d\n", numStr, num)
 		}
 	}
+
+	// Helpers for config and CLI values
+	fmt.Printf("ParseIntDefault(\"abc\", 8080) = %d\n", ParseIntDefault("abc", 8080))
+	for _, port := range []string{"443", "70000"} {
+		if n, err := ParseInRange(port, 1, 65535); err != nil {
+			fmt.Printf("Invalid port: %v\n", err)
+		} else {
+			fmt.Printf("Valid port: %d\n", n)
+		}
+	}
+	for _, text := range []string{"10k", "2M", "1.5k", "3x"} {
+		if n, err := ParseHumanInt(text); err != nil {
+			fmt.Printf("ParseHumanInt failed: %v\n", err)
+		} else {
+			fmt.Printf("ParseHumanInt(%q) = %d\n", text, n)
+		}
+	}
 }
 
 // Main function to run all examples
@@ -0,0 +1,50 @@
+// examples.go
+package internal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NamedExample is a single sub-example of a topic that can be run on its own
+type NamedExample struct {
+	Name string
+	Run  func()
+}
+
+// topicExamples maps a CLI topic name to its individually runnable
+// sub-examples
+var topicExamples = map[string][]NamedExample{
+	"json":       jsonExamples,
+	"reflection": reflectionExamples,
+}
+
+// ExampleNames returns the sub-example names of topic in run order
+func ExampleNames(topic string) ([]string, error) {
+	examples, ok := topicExamples[topic]
+	if !ok {
+		return nil, fmt.Errorf("topic %q has no named sub-examples", topic)
+	}
+
+	names := make([]string, len(examples))
+	for i, example := range examples {
+		names[i] = example.Name
+	}
+	return names, nil
+}
+
+// RunExample runs only the named sub-example of topic
+func RunExample(topic, example string) error {
+	names, err := ExampleNames(topic)
+	if err != nil {
+		return err
+	}
+
+	for _, candidate := range topicExamples[topic] {
+		if candidate.Name == example {
+			candidate.Run()
+			return nil
+		}
+	}
+	return fmt.Errorf("topic %q has no example %q (available: %s)", topic, example, strings.Join(names, ", "))
+}
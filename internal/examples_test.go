@@ -0,0 +1,50 @@
+// examples_test.go
+package internal
+
+import (
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestRunExampleRunsOnlyThatOne(t *testing.T) {
+	var ran []string
+	record := func(name string) func() {
+		return func() { ran = append(ran, name) }
+	}
+	topicExamples["test-topic"] = []NamedExample{
+		{"first", record("first")},
+		{"second", record("second")},
+		{"third", record("third")},
+	}
+	t.Cleanup(func() { delete(topicExamples, "test-topic") })
+
+	testutil.AssertNoError(t, RunExample("test-topic", "second"))
+	testutil.AssertEqual(t, len(ran), 1)
+	testutil.AssertEqual(t, ran[0], "second")
+
+	err := RunExample("test-topic", "fourth")
+	testutil.AssertContains(t, err.Error(), "available: first, second, third")
+	testutil.AssertEqual(t, len(ran), 1)
+}
+
+func TestExampleNames(t *testing.T) {
+	for topic := range topicExamples {
+		names, err := ExampleNames(topic)
+		testutil.AssertNoError(t, err)
+		seen := make(map[string]bool)
+		for _, name := range names {
+			if name == "" || seen[name] {
+				t.Errorf("topic %s: empty or duplicate example name %q", topic, name)
+			}
+			seen[name] = true
+		}
+	}
+
+	names, err := ExampleNames("json")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, names[0] != "", true)
+
+	_, err = ExampleNames("pointers")
+	testutil.AssertContains(t, err.Error(), "has no named sub-examples")
+}
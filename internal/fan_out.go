@@ -0,0 +1,112 @@
+// fan_out.go
+package internal
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// FanOutMode selects how FanOut reacts to a failing input
+type FanOutMode int
+
+const (
+	FailFast        FanOutMode = iota // cancel remaining work on the first error (default)
+	ContinueOnError                   // process every input and collect errors in a MultiError
+)
+
+// FanOut runs fn over inputs using a pool of workers and returns the
+// results in input order.
+//
+// In FailFast mode the first error cancels the context passed to the
+// remaining calls; the returned slice holds the results for the leading
+// inputs that completed successfully. With ContinueOnError every input is
+// processed, the full result slice is returned (zero values for failed
+// inputs) and the failures are reported together as a *MultiError.
+func FanOut[I, O any](ctx context.Context, inputs []I, workers int, fn func(context.Context, I) (O, error), mode ...FanOutMode) ([]O, error) {
+	continueOnError := len(mode) > 0 && mode[0] == ContinueOnError
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(inputs) {
+		workers = len(inputs)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]O, len(inputs))
+	errs := make([]error, len(inputs))
+	done := make([]bool, len(inputs))
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				out, err := fn(ctx, inputs[i])
+
+				mu.Lock()
+				results[i], errs[i], done[i] = out, err, true
+				if err != nil && firstErr == nil && !continueOnError {
+					firstErr = fmt.Errorf("input %d: %w", i, err)
+					cancel()
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+feed:
+	for i := range inputs {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if continueOnError {
+		var multi MultiError
+		for i, err := range errs {
+			if err != nil {
+				multi.Errors = append(multi.Errors, fmt.Errorf("input %d: %w", i, err))
+			}
+		}
+		for i := range inputs {
+			if !done[i] {
+				multi.Errors = append(multi.Errors, ctx.Err())
+				break
+			}
+		}
+		if len(multi.Errors) > 0 {
+			return results, &multi
+		}
+		return results, nil
+	}
+
+	if firstErr == nil && ctx.Err() != nil {
+		// The parent context was cancelled before every input was processed
+		for i := range inputs {
+			if !done[i] {
+				firstErr = ctx.Err()
+				break
+			}
+		}
+	}
+
+	prefix := 0
+	for prefix < len(inputs) && done[prefix] && errs[prefix] == nil {
+		prefix++
+	}
+	return results[:prefix], firstErr
+}
@@ -0,0 +1,79 @@
+// fan_out_test.go
+package internal
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+var errOdd = errors.New("odd input")
+
+func square(ctx context.Context, n int) (int, error) {
+	return n * n, nil
+}
+
+func TestFanOutKeepsInputOrder(t *testing.T) {
+	inputs := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	results, err := FanOut(context.Background(), inputs, 3, square)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(results), len(inputs))
+	for i, n := range inputs {
+		testutil.AssertEqual(t, results[i], n*n)
+	}
+
+	empty, err := FanOut(context.Background(), nil, 4, square)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(empty), 0)
+}
+
+func TestFanOutFailFast(t *testing.T) {
+	var started atomic.Int32
+	inputs := []int{0, 2, 3, 4, 6, 8, 10, 12}
+	results, err := FanOut(context.Background(), inputs, 1, func(ctx context.Context, n int) (int, error) {
+		started.Add(1)
+		if n%2 == 1 {
+			return 0, errOdd
+		}
+		return n, ctx.Err()
+	})
+
+	testutil.AssertErrorIs(t, err, errOdd)
+	testutil.AssertContains(t, err.Error(), "input 2")
+	// the successful prefix comes back, and later inputs were never started
+	testutil.AssertEqual(t, len(results), 2)
+	testutil.AssertEqual(t, results[1], 2)
+	if n := started.Load(); n > 4 {
+		t.Errorf("%d inputs started after the failure, want fail-fast", n)
+	}
+}
+
+func TestFanOutContinueOnError(t *testing.T) {
+	inputs := []int{1, 2, 3, 4}
+	results, err := FanOut(context.Background(), inputs, 2, func(ctx context.Context, n int) (int, error) {
+		if n%2 == 1 {
+			return 0, errOdd
+		}
+		return n * 10, nil
+	}, ContinueOnError)
+
+	var multi *MultiError
+	testutil.AssertEqual(t, errors.As(err, &multi), true)
+	testutil.AssertEqual(t, len(multi.Errors), 2)
+	testutil.AssertErrorIs(t, err, errOdd)
+	testutil.AssertEqual(t, len(results), 4)
+	testutil.AssertEqual(t, results[0], 0)
+	testutil.AssertEqual(t, results[3], 40)
+}
+
+func TestFanOutParentCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := FanOut(ctx, []int{1, 2, 3}, 2, func(ctx context.Context, n int) (int, error) {
+		return n, ctx.Err()
+	})
+	testutil.AssertErrorIs(t, err, context.Canceled)
+}
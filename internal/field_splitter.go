@@ -0,0 +1,89 @@
+// field_splitter.go
+package internal
+
+import (
+	"strings"
+	"unicode"
+)
+
+// FieldSplitter splits a single delimited line into fields, honouring
+// quoted fields so "Doe, John",30 yields two fields rather than three.
+// The zero value splits on commas with double quotes.
+type FieldSplitter struct {
+	Delimiter rune // field separator; defaults to ','
+	Quote     rune // quote character; defaults to '"'
+	NoQuotes  bool // treat quote characters as ordinary text
+	TrimSpace bool // trim whitespace around fields (outside any quotes)
+}
+
+// Split breaks line into fields. Inside a quoted field the delimiter is
+// literal and a quote is written either doubled ("") or backslash-escaped
+// (\"). A trailing delimiter produces a trailing empty field.
+func (s FieldSplitter) Split(line string) []string {
+	delim, quote := s.Delimiter, s.Quote
+	if delim == 0 {
+		delim = ','
+	}
+	if quote == 0 {
+		quote = '"'
+	}
+
+	runes := []rune(line)
+	var fields []string
+	var field strings.Builder
+	inQuotes := false
+	quoted := false // the current field started with a quote
+
+	finish := func() {
+		text := field.String()
+		if s.TrimSpace && !quoted {
+			text = strings.TrimSpace(text)
+		}
+		fields = append(fields, text)
+		field.Reset()
+		quoted = false
+	}
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		if inQuotes {
+			switch {
+			case r == '\\' && i+1 < len(runes) && runes[i+1] == quote:
+				field.WriteRune(quote)
+				i++
+			case r == quote && i+1 < len(runes) && runes[i+1] == quote:
+				field.WriteRune(quote)
+				i++
+			case r == quote:
+				inQuotes = false
+			default:
+				field.WriteRune(r)
+			}
+			continue
+		}
+
+		switch {
+		case r == delim:
+			finish()
+		case r == quote && !s.NoQuotes && !quoted && s.atFieldStart(field.String()):
+			// Whitespace before an opening quote is dropped when trimming
+			field.Reset()
+			inQuotes, quoted = true, true
+		case quoted && s.TrimSpace && unicode.IsSpace(r):
+			// Ignore padding between a closing quote and the delimiter
+		default:
+			field.WriteRune(r)
+		}
+	}
+	finish()
+	return fields
+}
+
+// atFieldStart reports whether a quote seen after soFar opens a quoted field
+func (s FieldSplitter) atFieldStart(soFar string) bool {
+	if s.TrimSpace {
+		return strings.TrimSpace(soFar) == ""
+	}
+	return soFar == ""
+}
@@ -0,0 +1,38 @@
+// field_splitter_test.go
+package internal
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestFieldSplitterSplit(t *testing.T) {
+	tests := []struct {
+		name     string
+		splitter FieldSplitter
+		line     string
+		want     []string
+	}{
+		{"plain", FieldSplitter{}, "a,b,c", []string{"a", "b", "c"}},
+		{"quoted delimiter", FieldSplitter{}, `"Doe, John",30`, []string{"Doe, John", "30"}},
+		{"doubled quote", FieldSplitter{}, `"say ""hi""",x`, []string{`say "hi"`, "x"}},
+		{"backslash quote", FieldSplitter{}, `"a \"b\" c"`, []string{`a "b" c`}},
+		{"trailing empty", FieldSplitter{}, "a,b,", []string{"a", "b", ""}},
+		{"only delimiters", FieldSplitter{}, ",,", []string{"", "", ""}},
+		{"empty line", FieldSplitter{}, "", []string{""}},
+		{"custom delimiter", FieldSplitter{Delimiter: '|'}, "x|y,z|", []string{"x", "y,z", ""}},
+		{"custom quote", FieldSplitter{Delimiter: ';', Quote: '\''}, `'a;b';c`, []string{"a;b", "c"}},
+		{"trim", FieldSplitter{TrimSpace: true}, `  a , "  b  " ,c  `, []string{"a", "  b  ", "c"}},
+		{"no quotes", FieldSplitter{NoQuotes: true}, `"a,b"`, []string{`"a`, `b"`}},
+		{"quote mid-field", FieldSplitter{}, `ab"c,d`, []string{`ab"c`, "d"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.splitter.Split(tt.line)
+			testutil.AssertEqual(t, strings.Join(got, "\x1f"), strings.Join(tt.want, "\x1f"))
+			testutil.AssertEqual(t, len(got), len(tt.want))
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
 	"time"
 )
@@ -99,11 +101,18 @@ func RunFileIOExamples() {
 	bufferedIOExample()
 	fileProcessingExample()
 	csvFileExample()
+	csvStructsExample()
+	csvValidationExample()
 	binaryFileExample()
 	customReaderWriterExample()
 	streamingExample()
 	fileIOErrorHandlingExample()
 	advancedFileOperationsExample()
+	memFileExample()
+	grepExample()
+	dedupLinesExample()
+	blobStoreExample()
+	rotatingLogExample()
 }
 
 // Basic file operations
@@ -136,9 +145,9 @@ func basicFileOperationsExample() {
 		log.Printf("Error getting file info: %v", err)
 		return
 	}
-	fmt.Printf("File size: %d bytes\n", info.Size())
+	fmt.Printf("File size: %s\n", FormatBytes(info.Size()))
 	fmt.Printf("File mode: %v\n", info.Mode())
-	fmt.Printf("Modified time: %v\n", info.ModTime())
+	fmt.Printf("Modified time: %v (%s)\n", info.ModTime(), HumanizeSince(info.ModTime()))
 
 	// Clean up
 	os.Remove(tempFile)
@@ -295,7 +304,7 @@ func fileProcessingExample() {
 	inputFile := "input.txt"
 	inputContent := `apple,10,2.50
 banana,15,1.20
-cherry,8,3.00
+"cherry, black",8,3.00
 date,12,2.80
 elderberry,5,4.50`
 
@@ -310,8 +319,9 @@ elderberry,5,4.50`
 	processor := NewFileProcessor(inputFile, outputFile)
 
 	// Define processing function
+	splitter := FieldSplitter{TrimSpace: true}
 	processLine := func(line string) string {
-		parts := strings.Split(line, ",")
+		parts := splitter.Split(line)
 		if len(parts) == 3 {
 			return fmt.Sprintf("Product: %s, Stock: %s units, Price: $%s",
 				strings.Title(parts[0]), parts[1], parts[2])
@@ -347,7 +357,8 @@ func csvFileExample() {
 
 	// Create CSV content
 	csvContent := `Name,Age,City,Salary
-John Doe,30,New York,75000
+"Doe, John",30,New York,75000
+José Núñez,41,São Paulo,72000
 Jane Smith,25,Los Angeles,65000
 Bob Johnson,35,Chicago,80000
 Alice Brown,28,Boston,70000`
@@ -368,28 +379,32 @@ Alice Brown,28,Boston,70000`
 	defer file.Close()
 
 	scanner := bufio.NewScanner(file)
+	splitter := FieldSplitter{}
 
-	// Read header
-	if scanner.Scan() {
-		header := scanner.Text()
-		fmt.Printf("CSV Header: %s\n", header)
+	var records [][]string
+	for scanner.Scan() {
+		records = append(records, splitter.Split(scanner.Text()))
+	}
+	if err := scanner.Err(); err != nil {
+		log.Printf("Error reading CSV: %v", err)
+	}
+
+	if len(records) > 0 {
+		fmt.Printf("CSV Header: %s\n", strings.Join(records[0], ", "))
 
 		// Process each data row
 		fmt.Println(Bold("CSV Data:"))
-		rowNum := 1
-		for scanner.Scan() {
-			line := scanner.Text()
-			fields := strings.Split(line, ",")
+		for rowNum, fields := range records[1:] {
 			if len(fields) >= 4 {
 				fmt.Printf("Row %d: Name=%s, Age=%s, City=%s, Salary=$%s\n",
-					rowNum, fields[0], fields[1], fields[2], fields[3])
-				rowNum++
+					rowNum+1, fields[0], fields[1], fields[2], fields[3])
 			}
 		}
-	}
 
-	if err := scanner.Err(); err != nil {
-		log.Printf("Error reading CSV: %v", err)
+		fmt.Println(Bold("As a table:"))
+		RenderCSVTable(os.Stdout, records, TableOptions{
+			Align: []Alignment{AlignLeft, AlignRight, AlignLeft, AlignRight},
+		})
 	}
 
 	// Clean up
@@ -397,6 +412,81 @@ Alice Brown,28,Boston,70000`
 	fmt.Println()
 }
 
+// csvStaffRecord is a struct mapped to CSV columns through tags
+type csvStaffRecord struct {
+	Name     string  `csv:"name"`
+	Age      int     `csv:"age"`
+	City     string  `csv:"city"`
+	Salary   float64 `csv:"salary"`
+	Remote   bool    `csv:"remote"`
+	Password string  `csv:"-"`
+}
+
+// CSV struct encoding and decoding example
+func csvStructsExample() {
+	fmt.Println(Subtitle("🧾 CSV Struct Encoding"))
+
+	staff := []csvStaffRecord{
+		{Name: "Doe, John", Age: 30, City: "New York", Salary: 75000, Password: "hunter2"},
+		{Name: "Jane \"JJ\" Smith", Age: 25, City: "Los Angeles", Salary: 65000.5, Remote: true},
+	}
+
+	var buf bytes.Buffer
+	if err := EncodeCSVStructs(&buf, staff); err != nil {
+		log.Printf("Error encoding CSV: %v", err)
+		return
+	}
+	fmt.Println(Bold("Encoded (Password is tagged csv:\"-\"):"))
+	fmt.Print(buf.String())
+
+	var decoded []csvStaffRecord
+	if err := DecodeCSVStructs(&buf, &decoded); err != nil {
+		log.Printf("Error decoding CSV: %v", err)
+		return
+	}
+	fmt.Printf("Decoded %d records: %+v\n", len(decoded), decoded)
+
+	staff[0].Password = ""
+	fmt.Printf("Round trip equal (ignoring Password): %v\n", reflect.DeepEqual(staff, decoded))
+
+	bad := "name,age\nAlice,thirty\n"
+	if err := DecodeCSVStructs(strings.NewReader(bad), &decoded); err != nil {
+		fmt.Printf("Bad input: %s\n", ErrorText(err.Error()))
+	}
+	fmt.Println()
+}
+
+// csvValidationExample checks a CSV against a schema in a single pass
+func csvValidationExample() {
+	fmt.Println(Subtitle("✅ CSV Validation"))
+
+	schema := CSVSchema{Fields: []CSVField{
+		{Name: "id", Type: reflect.TypeOf(0), Rules: "required,min=1"},
+		{Name: "name", Type: reflect.TypeOf(""), Rules: "required,min=2"},
+		{Name: "email", Type: reflect.TypeOf(""), Rules: "required,email"},
+		{Name: "age", Type: reflect.TypeOf(0), Rules: "min=0,max=120"},
+	}}
+
+	input := `id,name,email,age
+1,Alice,alice@example.com,30
+2,B,bob@example.com,41
+x,Carol,carol-at-example,
+4,Dan,dan@example.com,150
+5,Eve
+6,Frank,frank@example.com,
+`
+	problems, err := ValidateCSV(strings.NewReader(input), schema)
+	if err != nil {
+		log.Printf("Error validating CSV: %v", err)
+		return
+	}
+	fmt.Printf("Found %d problems:\n", len(problems))
+	for _, problem := range problems {
+		fmt.Printf("  %s\n", ErrorText(problem.Error()))
+	}
+	fmt.Println()
+}
+
 // Binary file example
 func binaryFileExample() {
 	fmt.Println(Subtitle("🔢 Binary File Operations"))
@@ -726,7 +816,7 @@ func advancedFileOperationsExample() {
 	fmt.Printf("Directory contents:\n")
 	for _, entry := range entries {
 		info, _ := entry.Info()
-		fmt.Printf("  %s (%d bytes)\n", entry.Name(), info.Size())
+		fmt.Printf("  %s (%s)\n", entry.Name(), FormatBytes(info.Size()))
 	}
 
 	// 2. File copying
@@ -815,3 +905,213 @@ func copyFile(src, dst string) error {
 	_, err = io.Copy(destFile, sourceFile)
 	return err
 }
+
+// In-memory file with os.File seek semantics
+func memFileExample() {
+	fmt.Println(Subtitle("🧠 In-Memory File"))
+
+	file := NewMemFile([]byte("Hello, World!"))
+	defer file.Close()
+
+	// Seek relative to the end and overwrite in place
+	if _, err := file.Seek(-6, io.SeekEnd); err != nil {
+		log.Printf("Error seeking: %v", err)
+		return
+	}
+	file.WriteString("Gopher")
+	fmt.Printf("After overwrite: %q\n", file.Bytes())
+
+	// Writing past the end zero-fills the gap
+	file.Seek(3, io.SeekCurrent)
+	file.WriteString("!")
+	fmt.Printf("After write past end: %q\n", file.Bytes())
+
+	// Truncate and read back from the start
+	file.Truncate(5)
+	file.Seek(0, io.SeekStart)
+	content, err := io.ReadAll(file)
+	if err != nil {
+		log.Printf("Error reading: %v", err)
+		return
+	}
+	fmt.Printf("After truncate to 5 bytes: %q\n", content)
+	fmt.Println()
+}
+
+// Searching several files concurrently
+func grepExample() {
+	fmt.Println(Subtitle("🔎 Grep Across Files"))
+
+	files := map[string]string{
+		"grep_app.log":    "INFO starting server\nERROR database timeout\nINFO request served\n",
+		"grep_worker.log": "info worker ready\nWARN retrying job 42\nerror: job 42 failed\n",
+	}
+	var paths []string
+	for path, content := range files {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			log.Printf("Error writing %s: %v", path, err)
+			return
+		}
+		defer os.Remove(path)
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	searches := []struct {
+		pattern string
+		opts    GrepOptions
+	}{
+		{"ERROR", GrepOptions{}},
+		{"error", GrepOptions{IgnoreCase: true}},
+		{`job \d+`, GrepOptions{Regexp: true}},
+	}
+	for _, search := range searches {
+		results, err := GrepFiles(paths, search.pattern, search.opts)
+		if err != nil {
+			log.Printf("Error searching: %v", err)
+			return
+		}
+		fmt.Printf("%s %+v:\n", Bold(search.pattern), search.opts)
+		for _, path := range paths {
+			for _, m := range results[path] {
+				fmt.Printf("  %s:%d: %s\n", path, m.Line, m.Text)
+			}
+		}
+	}
+	fmt.Println()
+}
+
+// Collapsing repeated log lines as they stream through a writer
+func dedupLinesExample() {
+	fmt.Println(Subtitle("🧹 Deduplicating Repeated Lines"))
+
+	// Chunks deliberately split lines in awkward places
+	chunks := []string{
+		"INFO connected\nWARN ret", "ry\nWARN retry\nWA", "RN retry\n",
+		"INFO connected\nERROR timeout\nERROR timeout",
+	}
+
+	for _, withCounts := range []bool{false, true} {
+		var out bytes.Buffer
+		dedup := NewDedupLineWriter(&out, withCounts)
+		for _, chunk := range chunks {
+			if _, err := io.WriteString(dedup, chunk); err != nil {
+				log.Printf("Error writing chunk: %v", err)
+				return
+			}
+		}
+		if err := dedup.Flush(); err != nil {
+			log.Printf("Error flushing: %v", err)
+			return
+		}
+		fmt.Println(Bold(fmt.Sprintf("With counts: %v", withCounts)))
+		fmt.Print(out.String())
+	}
+	fmt.Println()
+}
+
+// Content-addressed storage: identical content is stored once
+func blobStoreExample() {
+	fmt.Println(Subtitle("🗄️ Content-Addressable Blob Store"))
+
+	dir, err := os.MkdirTemp("", "blobs_*")
+	if err != nil {
+		log.Printf("Error creating store directory: %v", err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := NewBlobStore(dir)
+	if err != nil {
+		log.Printf("Error opening blob store: %v", err)
+		return
+	}
+
+	var hashes []string
+	for _, content := range []string{"hello, blobs", "something else", "hello, blobs"} {
+		hash, err := store.Put(strings.NewReader(content))
+		if err != nil {
+			log.Printf("Error storing blob: %v", err)
+			return
+		}
+		hashes = append(hashes, hash)
+		fmt.Printf("Put %-16q -> %s…\n", content, hash[:12])
+	}
+	fmt.Printf("Same content, same hash: %v\n", hashes[0] == hashes[2])
+
+	var files int
+	filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			files++
+		}
+		return nil
+	})
+	fmt.Printf("Files on disk after 3 puts: %d\n", files)
+
+	blob, err := store.Get(hashes[0])
+	if err != nil {
+		log.Printf("Error reading blob: %v", err)
+		return
+	}
+	content, err := io.ReadAll(blob)
+	blob.Close()
+	if err != nil {
+		log.Printf("Error reading blob: %v", err)
+		return
+	}
+	fmt.Printf("Get %s… -> %q\n", hashes[0][:12], content)
+
+	store.Delete(hashes[1])
+	fmt.Printf("Has deleted blob: %v\n", store.Has(hashes[1]))
+	if _, err := store.Get(hashes[1]); err != nil {
+		fmt.Printf("Get deleted blob: %s\n", ErrorText(err.Error()))
+	}
+	fmt.Println()
+}
+
+// Size-based log rotation keeping a fixed number of old files
+func rotatingLogExample() {
+	fmt.Println(Subtitle("🔄 Rotating Log Files"))
+
+	dir, err := os.MkdirTemp("", "rotating_*")
+	if err != nil {
+		log.Printf("Error creating log directory: %v", err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	// A manual clock gives the rotated files predictable names
+	clock := NewManualClock(time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC))
+	path := filepath.Join(dir, "app.log")
+	w, err := NewRotatingFileWriter(path, 64, 2, clock)
+	if err != nil {
+		log.Printf("Error opening log: %v", err)
+		return
+	}
+
+	for i := 1; i <= 10; i++ {
+		clock.Advance(time.Second)
+		if _, err := fmt.Fprintf(w, "request %02d handled in %dms\n", i, 10*i); err != nil {
+			log.Printf("Error writing log: %v", err)
+			return
+		}
+	}
+	backups, err := w.Backups()
+	w.Close()
+	if err != nil {
+		log.Printf("Error listing backups: %v", err)
+		return
+	}
+
+	fmt.Printf("Wrote 10 lines with a 64-byte limit, keeping 2 backups:\n")
+	for _, backup := range append(backups, path) {
+		content, err := os.ReadFile(backup)
+		if err != nil {
+			log.Printf("Error reading %s: %v", backup, err)
+			return
+		}
+		lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+		fmt.Printf("  %-35s %2d bytes, %s … %s\n", filepath.Base(backup), len(content), lines[0], lines[len(lines)-1])
+	}
+	fmt.Println()
+}
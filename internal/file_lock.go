@@ -0,0 +1,133 @@
+// file_lock.go
+package internal
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	fileLockRetry      = 5 * time.Millisecond
+	fileLockTimeout    = 10 * time.Second
+	fileLockStaleAfter = time.Minute            // a lock untouched for this long is treated as abandoned
+	fileLockRefresh    = fileLockStaleAfter / 4 // how often a live holder touches its lock
+)
+
+// ErrLockTimeout is returned when WithFileLock cannot get the lock in time
+var ErrLockTimeout = errors.New("timed out waiting for file lock")
+
+// WithFileLock runs fn while holding an advisory lock on path. The lock is
+// a sibling file, path+".lock", created with O_CREATE|O_EXCL, so it works
+// across processes as well as goroutines, on any platform and filesystem.
+// Waiters poll until the lock is free, giving up after 10 seconds.
+//
+// The lock file holds a random owner token. While fn runs the holder
+// refreshes the file's mtime, so a lock is only reclaimed as stale once its
+// owner has stopped touching it for a minute (a crashed process), and the
+// holder removes the file on release only if it still holds its own token.
+// Only code that also calls WithFileLock is kept out.
+func WithFileLock(path string, fn func() error) error {
+	lockPath := path + ".lock"
+	token, err := acquireFileLock(lockPath)
+	if err != nil {
+		return fmt.Errorf("lock %s: %w", path, err)
+	}
+
+	done := make(chan struct{})
+	refreshed := make(chan struct{})
+	go func() {
+		defer close(refreshed)
+		refreshFileLock(lockPath, token, done)
+	}()
+	defer func() {
+		close(done)
+		<-refreshed
+		releaseFileLock(lockPath, token)
+	}()
+	return fn()
+}
+
+// acquireFileLock creates lockPath holding a fresh owner token and returns
+// the token
+func acquireFileLock(lockPath string) ([]byte, error) {
+	// The PID prefix is informational, for whoever finds a stuck lock
+	token := []byte(NewRandomIDGenerator(strconv.Itoa(os.Getpid())+"-", 8).Next())
+	deadline := time.Now().Add(fileLockTimeout)
+	for {
+		file, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			_, writeErr := file.Write(token)
+			if closeErr := file.Close(); writeErr == nil {
+				writeErr = closeErr
+			}
+			if writeErr != nil {
+				os.Remove(lockPath)
+				return nil, writeErr
+			}
+			return token, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, err
+		}
+
+		reclaimStaleLock(lockPath)
+		if time.Now().After(deadline) {
+			return nil, ErrLockTimeout
+		}
+		time.Sleep(fileLockRetry)
+	}
+}
+
+// reclaimStaleLock removes lockPath if its owner has stopped refreshing
+// it. The owner token is read before and after the staleness check so a
+// lock that another waiter reclaimed and re-created in between is left
+// alone.
+func reclaimStaleLock(lockPath string) {
+	owner, err := os.ReadFile(lockPath)
+	if err != nil {
+		return
+	}
+	info, err := os.Stat(lockPath)
+	if err != nil || time.Since(info.ModTime()) <= fileLockStaleAfter {
+		return
+	}
+	if current, err := os.ReadFile(lockPath); err == nil && bytes.Equal(current, owner) {
+		os.Remove(lockPath)
+	}
+}
+
+// refreshFileLock touches lockPath every fileLockRefresh until done is
+// closed or the lock no longer holds token
+func refreshFileLock(lockPath string, token []byte, done <-chan struct{}) {
+	ticker := time.NewTicker(fileLockRefresh)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if !ownsFileLock(lockPath, token) {
+				return
+			}
+			now := time.Now()
+			os.Chtimes(lockPath, now, now)
+		}
+	}
+}
+
+// releaseFileLock removes lockPath, unless another process has since
+// reclaimed it and the file now holds someone else's token
+func releaseFileLock(lockPath string, token []byte) {
+	if ownsFileLock(lockPath, token) {
+		os.Remove(lockPath)
+	}
+}
+
+func ownsFileLock(lockPath string, token []byte) bool {
+	current, err := os.ReadFile(lockPath)
+	return err == nil && bytes.Equal(current, token)
+}
@@ -0,0 +1,86 @@
+// file_lock_test.go
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestWithFileLockExcludes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.json")
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		inside  int
+		maxSeen int
+	)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := WithFileLock(path, func() error {
+				mu.Lock()
+				inside++
+				maxSeen = max(maxSeen, inside)
+				mu.Unlock()
+				time.Sleep(time.Millisecond)
+				mu.Lock()
+				inside--
+				mu.Unlock()
+				return nil
+			})
+			testutil.AssertNoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	testutil.AssertEqual(t, maxSeen, 1)
+	_, err := os.Stat(path + ".lock")
+	testutil.AssertErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestWithFileLockReclaimsStaleLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.json")
+	lockPath := path + ".lock"
+	testutil.AssertNoError(t, os.WriteFile(lockPath, []byte("crashed-owner"), 0644))
+	old := time.Now().Add(-2 * fileLockStaleAfter)
+	testutil.AssertNoError(t, os.Chtimes(lockPath, old, old))
+
+	ran := false
+	err := WithFileLock(path, func() error {
+		ran = true
+		owner, err := os.ReadFile(lockPath)
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, string(owner) != "crashed-owner", true)
+		return nil
+	})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, ran, true)
+}
+
+func TestWithFileLockKeepsForeignLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.json")
+	lockPath := path + ".lock"
+
+	// Simulate another process reclaiming the lock while fn still runs
+	err := WithFileLock(path, func() error {
+		return os.WriteFile(lockPath, []byte("new-owner"), 0644)
+	})
+	testutil.AssertNoError(t, err)
+
+	owner, err := os.ReadFile(lockPath)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, string(owner), "new-owner")
+}
+
+func TestWithFileLockReturnsFnError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.json")
+	err := WithFileLock(path, func() error { return os.ErrPermission })
+	testutil.AssertErrorIs(t, err, os.ErrPermission)
+}
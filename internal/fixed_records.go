@@ -0,0 +1,63 @@
+// fixed_records.go
+package internal
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// PartialRecordMode selects how ScanFixed treats a short final record
+type PartialRecordMode int
+
+const (
+	StrictRecords PartialRecordMode = iota // a short final record is an error (default)
+	PadRecords                             // a short final record is zero-padded to full size
+)
+
+// ErrPartialRecord reports input that ended partway through a record
+var ErrPartialRecord = errors.New("partial record")
+
+// ScanFixed calls fn with each recordSize-byte record read from r. The
+// slice passed to fn is only valid until fn returns. Input that ends
+// mid-record fails with ErrPartialRecord unless PadRecords is given; an
+// error from fn stops the scan and is returned with the record index.
+func ScanFixed(r io.Reader, recordSize int, fn func(record []byte) error, mode ...PartialRecordMode) error {
+	if recordSize <= 0 {
+		return fmt.Errorf("record size must be positive, got %d", recordSize)
+	}
+	pad := len(mode) > 0 && mode[0] == PadRecords
+
+	scanner := bufio.NewScanner(r)
+	if recordSize > bufio.MaxScanTokenSize {
+		scanner.Buffer(make([]byte, 0, recordSize), recordSize)
+	}
+	scanner.Split(splitFixed(recordSize, pad))
+
+	for index := 0; scanner.Scan(); index++ {
+		if err := fn(scanner.Bytes()); err != nil {
+			return fmt.Errorf("record %d: %w", index, err)
+		}
+	}
+	return scanner.Err()
+}
+
+// splitFixed is a bufio.SplitFunc yielding size-byte tokens. At EOF a
+// leftover shorter than size is padded with zeros or reported as an error.
+func splitFixed(size int, pad bool) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if len(data) >= size {
+			return size, data[:size], nil
+		}
+		if !atEOF || len(data) == 0 {
+			return 0, nil, nil // request more data, or stop at a clean EOF
+		}
+		if !pad {
+			return 0, nil, fmt.Errorf("%w: got %d of %d bytes", ErrPartialRecord, len(data), size)
+		}
+		record := make([]byte, size)
+		copy(record, data)
+		return len(data), record, nil
+	}
+}
@@ -0,0 +1,77 @@
+// fixed_records_test.go
+package internal
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// collectRecords runs ScanFixed and returns the records it delivered
+func collectRecords(input string, size int, mode ...PartialRecordMode) ([]string, error) {
+	var records []string
+	err := ScanFixed(iotest.OneByteReader(strings.NewReader(input)), size, func(record []byte) error {
+		records = append(records, string(record))
+		return nil
+	}, mode...)
+	return records, err
+}
+
+func TestScanFixedExactMultiple(t *testing.T) {
+	for _, mode := range []PartialRecordMode{StrictRecords, PadRecords} {
+		records, err := collectRecords("AAAABBBBCCCC", 4, mode)
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, strings.Join(records, ","), "AAAA,BBBB,CCCC")
+	}
+
+	records, err := collectRecords("", 4)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(records), 0)
+}
+
+func TestScanFixedPartialStrict(t *testing.T) {
+	records, err := collectRecords("AAAABBBBCC", 4)
+	testutil.AssertErrorIs(t, err, ErrPartialRecord)
+	testutil.AssertEqual(t, err.Error(), "partial record: got 2 of 4 bytes")
+	testutil.AssertEqual(t, strings.Join(records, ","), "AAAA,BBBB")
+}
+
+func TestScanFixedPartialPadded(t *testing.T) {
+	records, err := collectRecords("AAAABBBBCC", 4, PadRecords)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(records), 3)
+	testutil.AssertEqual(t, records[2], "CC\x00\x00")
+}
+
+func TestScanFixedLargeRecords(t *testing.T) {
+	size := 100_000 // beyond bufio.MaxScanTokenSize
+	input := strings.Repeat("x", size) + strings.Repeat("y", size)
+	var lengths []int
+	err := ScanFixed(strings.NewReader(input), size, func(record []byte) error {
+		lengths = append(lengths, len(record))
+		return nil
+	})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(lengths), 2)
+	testutil.AssertEqual(t, lengths[1], size)
+}
+
+func TestScanFixedCallbackErrorAndBadSize(t *testing.T) {
+	errStop := errors.New("stop")
+	calls := 0
+	err := ScanFixed(strings.NewReader("aabbcc"), 2, func([]byte) error {
+		calls++
+		if calls == 2 {
+			return errStop
+		}
+		return nil
+	})
+	testutil.AssertErrorIs(t, err, errStop)
+	testutil.AssertEqual(t, err.Error(), "record 1: stop")
+
+	err = ScanFixed(strings.NewReader("x"), 0, func([]byte) error { return nil })
+	testutil.AssertEqual(t, err.Error(), "record size must be positive, got 0")
+}
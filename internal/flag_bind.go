@@ -0,0 +1,77 @@
+// flag_bind.go
+package internal
+
+import (
+	"flag"
+	"fmt"
+	"reflect"
+)
+
+// BindFlags registers a flag on fs for every field of the struct dest
+// points to that carries a `flag:"name"` tag, using the `usage:"..."` tag
+// as help text and the field's current value as the default. Parsing fs
+// then writes straight into the struct. Supported kinds are strings, bools,
+// signed and unsigned integers, floats and time.Duration; nested structs
+// without a tag are bound recursively. Misuse (dest not a struct pointer,
+// an unsupported field kind) panics, as the flag package does for a
+// duplicate flag name.
+func BindFlags(fs *flag.FlagSet, dest interface{}) {
+	rv := reflect.ValueOf(dest)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("BindFlags: dest must be a non-nil pointer to a struct, got %T", dest))
+	}
+	bindStructFlags(fs, rv.Elem())
+}
+
+func bindStructFlags(fs *flag.FlagSet, rv reflect.Value) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, tagged := field.Tag.Lookup("flag")
+		if name == "-" {
+			continue
+		}
+		if !tagged {
+			if field.Type.Kind() == reflect.Struct && field.Type != durationType {
+				bindStructFlags(fs, rv.Field(i))
+			}
+			continue
+		}
+
+		value := &fieldFlag{v: rv.Field(i)}
+		if !value.supported() {
+			panic(fmt.Sprintf("BindFlags: field %s has unsupported type %s", field.Name, field.Type))
+		}
+		fs.Var(value, name, field.Tag.Get("usage"))
+	}
+}
+
+// fieldFlag adapts a settable struct field to flag.Value
+type fieldFlag struct {
+	v reflect.Value
+}
+
+func (f *fieldFlag) supported() bool {
+	return isScalarType(f.v.Type())
+}
+
+func (f *fieldFlag) String() string {
+	// Zero values render as "" so PrintDefaults omits them, matching the
+	// built-in flag types
+	if f == nil || !f.v.IsValid() || f.v.IsZero() {
+		return ""
+	}
+	return scalarString(f.v)
+}
+
+func (f *fieldFlag) Set(s string) error {
+	return setScalar(f.v, s)
+}
+
+// IsBoolFlag lets bool fields be given as a bare --name
+func (f *fieldFlag) IsBoolFlag() bool {
+	return f.v.IsValid() && f.v.Kind() == reflect.Bool
+}
@@ -0,0 +1,97 @@
+// flag_bind_test.go
+package internal
+
+import (
+	"flag"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+type flagLimits struct {
+	Rate    float64       `flag:"rate" usage:"requests per second"`
+	Workers uint          `flag:"workers"`
+	Timeout time.Duration `flag:"timeout" usage:"request timeout"`
+}
+
+type flagConfig struct {
+	Host    string `flag:"host" usage:"address to bind"`
+	Port    int    `flag:"port" usage:"port to listen on"`
+	Debug   bool   `flag:"debug" usage:"enable debug logging"`
+	Limits  flagLimits
+	Ignored string `flag:"-"`
+	NoTag   string
+	hidden  int
+}
+
+func newFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+func TestBindFlagsParses(t *testing.T) {
+	cfg := flagConfig{Host: "localhost", Port: 8080}
+	fs := newFlagSet()
+	BindFlags(fs, &cfg)
+
+	err := fs.Parse([]string{"--port=9090", "--debug", "-rate", "2.5", "--workers=4", "--timeout=1m30s", "extra"})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, cfg.Port, 9090)
+	testutil.AssertEqual(t, cfg.Debug, true)
+	testutil.AssertEqual(t, cfg.Host, "localhost") // defaults survive
+	testutil.AssertEqual(t, cfg.Limits.Rate, 2.5)
+	testutil.AssertEqual(t, cfg.Limits.Workers, uint(4))
+	testutil.AssertEqual(t, cfg.Limits.Timeout, 90*time.Second)
+	testutil.AssertEqual(t, fs.Arg(0), "extra")
+
+	for _, name := range []string{"Ignored", "NoTag", "hidden", "-"} {
+		if fs.Lookup(name) != nil {
+			t.Errorf("unexpected flag %q registered", name)
+		}
+	}
+}
+
+func TestBindFlagsUsageAndDefaults(t *testing.T) {
+	cfg := flagConfig{Host: "localhost", Limits: flagLimits{Timeout: 5 * time.Second}}
+	fs := newFlagSet()
+	BindFlags(fs, &cfg)
+
+	testutil.AssertEqual(t, fs.Lookup("host").Usage, "address to bind")
+	testutil.AssertEqual(t, fs.Lookup("host").DefValue, "localhost")
+	testutil.AssertEqual(t, fs.Lookup("timeout").DefValue, "5s")
+	testutil.AssertEqual(t, fs.Lookup("port").DefValue, "")
+
+	var help strings.Builder
+	fs.SetOutput(&help)
+	fs.PrintDefaults()
+	testutil.AssertContains(t, help.String(), "enable debug logging")
+	testutil.AssertContains(t, help.String(), "address to bind (default localhost)")
+}
+
+func TestBindFlagsInvalidValue(t *testing.T) {
+	var cfg flagConfig
+	fs := newFlagSet()
+	BindFlags(fs, &cfg)
+	err := fs.Parse([]string{"--port=eighty"})
+	testutil.AssertContains(t, err.Error(), `invalid value "eighty" for flag -port`)
+}
+
+func TestBindFlagsMisusePanics(t *testing.T) {
+	expectPanic := func(name string, dest interface{}, want string) {
+		t.Helper()
+		defer func() {
+			r, _ := recover().(string)
+			testutil.AssertContains(t, r, want)
+		}()
+		BindFlags(newFlagSet(), dest)
+		t.Errorf("%s: BindFlags did not panic", name)
+	}
+	expectPanic("non-pointer", flagConfig{}, "dest must be a non-nil pointer to a struct")
+	expectPanic("unsupported", &struct {
+		Tags []string `flag:"tags"`
+	}{}, "field Tags has unsupported type []string")
+}
@@ -2,7 +2,10 @@
 package internal
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 )
@@ -15,6 +18,12 @@ func RunGoroutineExamples() {
 	racConditionExample()
 	goroutinePoolExample()
 	selectStatementExample()
+	ttlCacheExample()
+	fanOutExample()
+	priorityQueueExample()
+	collectExample()
+	schedulerExample()
+	panicSafeGoExample()
 }
 
 // Example 1: Basic goroutine
@@ -167,10 +176,10 @@ func goroutinePoolExample() {
 	close(tasks)
 
 	// Close results channel when all workers are done
-	go func() {
+	Go(func() {
 		wg.Wait()
 		close(results)
-	}()
+	})
 
 	// Collect results
 	fmt.Println("Results:")
@@ -186,15 +195,15 @@ func selectStatementExample() {
 	ch1 := make(chan string)
 	ch2 := make(chan string)
 
-	go func() {
+	Go(func() {
 		time.Sleep(200 * time.Millisecond)
 		ch1 <- "Message from channel 1"
-	}()
+	})
 
-	go func() {
+	Go(func() {
 		time.Sleep(300 * time.Millisecond)
 		ch2 <- "Message from channel 2"
-	}()
+	})
 
 	// Select with timeout
 	timeout := time.After(500 * time.Millisecond)
@@ -212,6 +221,84 @@ func selectStatementExample() {
 	}
 }
 
+// Example 7: TTL cache with a background sweeper goroutine
+func ttlCacheExample() {
+	fmt.Println("\n=== TTL Cache Example ===")
+
+	// A manual clock lets us "wait" for expiry without sleeping
+	clock := NewManualClock(time.Now())
+	cache := NewTTLCache[string, int](time.Second, clock)
+	defer cache.Close() // stops the sweeper goroutine
+
+	cache.Set("session", 42, 5*time.Second)
+	cache.Set("token", 7, 2*time.Second)
+	fmt.Printf("Entries after Set: %d\n", cache.Len())
+
+	clock.Advance(3 * time.Second)
+	if _, ok := cache.Get("token"); !ok {
+		fmt.Println("token expired after 3s")
+	}
+	if v, ok := cache.Get("session"); ok {
+		fmt.Printf("session still cached: %d\n", v)
+	}
+
+	clock.Advance(3 * time.Second)
+	fmt.Printf("Entries after 6s: %d\n", cache.Len())
+}
+
+// Example 8: Generic fan-out with ordered results and error handling
+func fanOutExample() {
+	fmt.Println("\n=== Fan-Out Worker Pool Example ===")
+
+	square := func(ctx context.Context, n int) (int, error) {
+		if n == 4 {
+			return 0, errors.New("unlucky number")
+		}
+		select {
+		case <-time.After(time.Duration(n) * 10 * time.Millisecond):
+			return n * n, nil
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+	inputs := []int{1, 2, 3, 4, 5, 6}
+
+	results, err := FanOut(context.Background(), inputs, 3, square)
+	fmt.Printf("Fail-fast: results=%v err=%v\n", results, err)
+
+	results, err = FanOut(context.Background(), inputs, 3, square, ContinueOnError)
+	fmt.Printf("Continue on error: results=%v err=%v\n", results, err)
+}
+
+// Example 9: Scheduling tasks by priority with a heap
+type scheduledTask struct {
+	id       int
+	priority int // higher runs first
+	duration time.Duration
+}
+
+func priorityQueueExample() {
+	fmt.Println("\n=== Priority Queue Scheduling Example ===")
+
+	queue := NewPriorityQueue(func(a, b scheduledTask) bool {
+		return a.priority > b.priority
+	})
+	for i, priority := range []int{2, 5, 1, 4, 3} {
+		queue.Push(scheduledTask{id: i + 1, priority: priority, duration: time.Duration(priority) * 20 * time.Millisecond})
+	}
+
+	if next, ok := queue.Peek(); ok {
+		fmt.Printf("Next up: task %d (priority %d), %d tasks queued\n", next.id, next.priority, queue.Len())
+	}
+
+	// A single worker drains the queue in priority order
+	for queue.Len() > 0 {
+		task, _ := queue.Pop()
+		fmt.Printf("Priority %d -> ", task.priority)
+		longRunningTask(task.id, task.duration)
+	}
+}
+
 // Additional helper functions for demonstration
 func longRunningTask(id int, duration time.Duration) {
 	fmt.Printf("Task %d starting (duration: %v)\n", id, duration)
@@ -227,3 +314,113 @@ func fibonacci2(n int, ch chan int) {
 	}
 	close(ch)
 }
+
+// Example 10: Running heterogeneous calls concurrently with ordered results
+func collectExample() {
+	fmt.Println("\n=== Collect Example ===")
+
+	fetch := func(name string, delay time.Duration, fail bool) func(context.Context) (string, error) {
+		return func(ctx context.Context) (string, error) {
+			select {
+			case <-time.After(delay):
+				if fail {
+					return "", fmt.Errorf("%s unavailable", name)
+				}
+				return name + " ok", nil
+			case <-ctx.Done():
+				return "", fmt.Errorf("%s cancelled", name)
+			}
+		}
+	}
+
+	// Results come back in call order even though the slowest call is first
+	results, err := CollectContext(context.Background(), []func(context.Context) (string, error){
+		fetch("inventory", 60*time.Millisecond, false),
+		fetch("pricing", 20*time.Millisecond, false),
+		fetch("shipping", 40*time.Millisecond, false),
+	})
+	fmt.Printf("All succeed: results=%q err=%v\n", results, err)
+
+	// pricing fails at 20ms and cancels inventory, which would take 200ms
+	start := time.Now()
+	results, err = CollectContext(context.Background(), []func(context.Context) (string, error){
+		fetch("inventory", 200*time.Millisecond, false),
+		fetch("pricing", 20*time.Millisecond, true),
+	})
+	fmt.Printf("First failure: results=%q err=%v (after %s)\n",
+		results, err, HumanizeDuration(time.Since(start).Round(10*time.Millisecond)))
+}
+
+// Example 11: Staggering events with a delayed-task scheduler
+func schedulerExample() {
+	fmt.Println("\n=== Delayed Scheduler Example ===")
+
+	scheduler := NewScheduler(nil)
+	start := time.Now()
+	var wg sync.WaitGroup
+
+	event := func(name string) func() {
+		return func() {
+			defer wg.Done()
+			fmt.Printf("  +%-5s %s\n", HumanizeDuration(time.Since(start).Round(10*time.Millisecond)), name)
+		}
+	}
+
+	// Scheduled out of order; they fire by due time
+	wg.Add(4)
+	scheduler.Schedule(60*time.Millisecond, event("send reminder email"))
+	scheduler.Schedule(20*time.Millisecond, event("charge card"))
+	scheduler.Schedule(40*time.Millisecond, event("reserve stock"))
+	scheduler.ScheduleAt(start.Add(-time.Second), event("overdue audit log (runs immediately)"))
+
+	// Far enough out that Stop cancels it
+	scheduler.Schedule(time.Hour, func() { fmt.Println("  never printed") })
+
+	wg.Wait()
+	fmt.Printf("Pending before Stop: %d\n", scheduler.Pending())
+	scheduler.Stop()
+	fmt.Printf("Pending after Stop: %d\n", scheduler.Pending())
+}
+
+// Example 12: Goroutines launched with Go survive a panic
+func panicSafeGoExample() {
+	fmt.Println("\n=== Panic-Safe Goroutines Example ===")
+
+	var recovered []interface{}
+	var mu sync.Mutex
+	SetPanicHandler(func(r interface{}, stack []byte) {
+		mu.Lock()
+		recovered = append(recovered, r)
+		mu.Unlock()
+	})
+	defer SetPanicHandler(nil)
+
+	// Send the panic log (which includes the full stack) to a buffer so
+	// only its first line is shown here
+	var logs strings.Builder
+	logger := NewLogger("WORKER")
+	logger.SetOutput(&logs)
+	ctx := WithLogger(WithTrace(context.Background(), "job-7"), logger)
+
+	var wg sync.WaitGroup
+	for _, job := range []string{"resize", "explode", "upload"} {
+		wg.Add(1)
+		GoCtx(ctx, func() {
+			defer wg.Done() // still runs while the panic unwinds
+			if job == "explode" {
+				var cfg map[string]int
+				cfg["retries"] = 3 // assignment to a nil map panics
+			}
+			time.Sleep(10 * time.Millisecond)
+			mu.Lock()
+			fmt.Printf("Job %s finished\n", job)
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+
+	firstLine, _, _ := strings.Cut(logs.String(), "\n")
+	fmt.Printf("Logged: %s\n", firstLine)
+	fmt.Printf("Handler received %d panic(s): %v\n", len(recovered), recovered)
+	fmt.Println("The program kept running")
+}
@@ -0,0 +1,118 @@
+// grep.go
+package internal
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+// GrepOptions selects how Grep interprets its pattern
+type GrepOptions struct {
+	IgnoreCase bool // match regardless of letter case
+	Regexp     bool // treat pattern as a regular expression instead of a literal
+}
+
+// Match is one matching line; Line is 1-based
+type Match struct {
+	Line int
+	Text string
+}
+
+// maxGrepLine is the longest line Grep will scan
+const maxGrepLine = 1 << 20
+
+// grepMatcher compiles pattern according to opts into a line predicate
+func grepMatcher(pattern string, opts GrepOptions) (func(string) bool, error) {
+	if opts.Regexp {
+		if opts.IgnoreCase {
+			pattern = "(?i)" + pattern
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("grep: invalid pattern: %w", err)
+		}
+		return re.MatchString, nil
+	}
+
+	if opts.IgnoreCase {
+		lower := strings.ToLower(pattern)
+		return func(line string) bool {
+			return strings.Contains(strings.ToLower(line), lower)
+		}, nil
+	}
+	return func(line string) bool {
+		return strings.Contains(line, pattern)
+	}, nil
+}
+
+// Grep returns every line of r matching pattern. It stops early with the
+// context's error if ctx is cancelled.
+func Grep(ctx context.Context, r io.Reader, pattern string, opts GrepOptions) ([]Match, error) {
+	match, err := grepMatcher(pattern, opts)
+	if err != nil {
+		return nil, err
+	}
+	return grepLines(ctx, r, match)
+}
+
+func grepLines(ctx context.Context, r io.Reader, match func(string) bool) ([]Match, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxGrepLine)
+
+	var matches []Match
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		if err := ctx.Err(); err != nil {
+			return matches, err
+		}
+		if line := scanner.Text(); match(line) {
+			matches = append(matches, Match{Line: lineNum, Text: line})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return matches, fmt.Errorf("grep: %w", err)
+	}
+	return matches, nil
+}
+
+// GrepFiles searches several files concurrently and returns the matches
+// keyed by path; files without matches are omitted. The first file that
+// cannot be read aborts the search.
+func GrepFiles(paths []string, pattern string, opts GrepOptions) (map[string][]Match, error) {
+	match, err := grepMatcher(pattern, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	searchFile := func(ctx context.Context, path string) ([]Match, error) {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		matches, err := grepLines(ctx, file, match)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		return matches, nil
+	}
+
+	results, err := FanOut(context.Background(), paths, runtime.NumCPU(), searchFile)
+	if err != nil {
+		return nil, err
+	}
+
+	byPath := make(map[string][]Match)
+	for i, matches := range results {
+		if len(matches) > 0 {
+			byPath[paths[i]] = matches
+		}
+	}
+	return byPath, nil
+}
@@ -0,0 +1,82 @@
+// grep_test.go
+package internal
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+const grepInput = "error: disk full\nINFO started\nwarn: Error rate high\ndone\n"
+
+func grepTexts(matches []Match) string {
+	var parts []string
+	for _, m := range matches {
+		parts = append(parts, m.Text)
+	}
+	return strings.Join(parts, "|")
+}
+
+func TestGrepLiteral(t *testing.T) {
+	matches, err := Grep(context.Background(), strings.NewReader(grepInput), "error", GrepOptions{})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(matches), 1)
+	testutil.AssertEqual(t, matches[0], Match{Line: 1, Text: "error: disk full"})
+
+	// A literal pattern's metacharacters match themselves
+	matches, _ = Grep(context.Background(), strings.NewReader("a.b\naxb\n"), "a.b", GrepOptions{})
+	testutil.AssertEqual(t, grepTexts(matches), "a.b")
+}
+
+func TestGrepIgnoreCase(t *testing.T) {
+	matches, err := Grep(context.Background(), strings.NewReader(grepInput), "ERROR", GrepOptions{IgnoreCase: true})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, grepTexts(matches), "error: disk full|warn: Error rate high")
+	testutil.AssertEqual(t, matches[1].Line, 3)
+}
+
+func TestGrepRegexp(t *testing.T) {
+	matches, err := Grep(context.Background(), strings.NewReader(grepInput), `^[a-z]+:`, GrepOptions{Regexp: true})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, grepTexts(matches), "error: disk full|warn: Error rate high")
+
+	matches, _ = Grep(context.Background(), strings.NewReader("a.b\naxb\n"), "A.B", GrepOptions{Regexp: true, IgnoreCase: true})
+	testutil.AssertEqual(t, grepTexts(matches), "a.b|axb")
+
+	_, err = Grep(context.Background(), strings.NewReader(grepInput), "(", GrepOptions{Regexp: true})
+	testutil.AssertContains(t, err.Error(), "grep: invalid pattern")
+}
+
+func TestGrepCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := Grep(ctx, strings.NewReader(grepInput), "e", GrepOptions{})
+	testutil.AssertErrorIs(t, err, context.Canceled)
+}
+
+func TestGrepFiles(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		testutil.AssertNoError(t, os.WriteFile(path, []byte(content), 0o644))
+		return path
+	}
+	a := write("a.log", "ok\nTODO: fix\n")
+	b := write("b.log", "nothing here\n")
+	c := write("c.log", "todo one\nskip\ntodo two\n")
+
+	got, err := GrepFiles([]string{a, b, c}, "todo", GrepOptions{IgnoreCase: true})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(got), 2)
+	testutil.AssertEqual(t, grepTexts(got[a]), "TODO: fix")
+	testutil.AssertEqual(t, got[c][1], Match{Line: 3, Text: "todo two"})
+	_, hasB := got[b]
+	testutil.AssertEqual(t, hasB, false)
+
+	_, err = GrepFiles([]string{a, filepath.Join(dir, "missing.log")}, "todo", GrepOptions{})
+	testutil.AssertErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,33 @@
+// group_by.go
+package internal
+
+import "fmt"
+
+// GroupByKey buckets items by the key keyer derives from each one. Items
+// keep their original order within a group. Any comparable type works as
+// a key, including structs, which makes composite keys such as
+// {Position, Department} as cheap as a single field.
+func GroupByKey[T any, K comparable](items []T, keyer func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, item := range items {
+		key := keyer(item)
+		groups[key] = append(groups[key], item)
+	}
+	return groups
+}
+
+// IndexByUnique maps each item's key to the item, failing if two items
+// share a key rather than letting the later one silently win
+func IndexByUnique[T any, K comparable](items []T, keyer func(T) K) (map[K]T, error) {
+	index := make(map[K]T, len(items))
+	firstAt := make(map[K]int, len(items))
+	for i, item := range items {
+		key := keyer(item)
+		if j, dup := firstAt[key]; dup {
+			return nil, fmt.Errorf("duplicate key %+v for items %d and %d", key, j, i)
+		}
+		firstAt[key] = i
+		index[key] = item
+	}
+	return index, nil
+}
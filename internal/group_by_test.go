@@ -0,0 +1,46 @@
+// group_by_test.go
+package internal
+
+import (
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+type roleKey struct {
+	Position   string
+	Department string
+}
+
+func employeeRole(e Employee) roleKey {
+	return roleKey{e.Position, e.Department}
+}
+
+func TestGroupByKeyCompositeStruct(t *testing.T) {
+	groups := GroupByKey(EmployeeDirectory(), employeeRole)
+	testutil.AssertEqual(t, len(groups), 4)
+
+	platformEngineers := groups[roleKey{"Engineer", "Platform"}]
+	testutil.AssertEqual(t, len(platformEngineers), 2)
+	testutil.AssertEqual(t, platformEngineers[0].Name, "Alice") // input order is kept
+	testutil.AssertEqual(t, platformEngineers[1].Name, "Evan")
+	testutil.AssertEqual(t, len(groups[roleKey{"Manager", "Platform"}]), 1)
+	testutil.AssertEqual(t, len(groups[roleKey{"Manager", "Product"}]), 0)
+}
+
+func TestGroupByKeyEmpty(t *testing.T) {
+	groups := GroupByKey([]int(nil), func(n int) bool { return n%2 == 0 })
+	testutil.AssertEqual(t, len(groups), 0)
+}
+
+func TestIndexByUnique(t *testing.T) {
+	index, err := IndexByUnique(EmployeeDirectory(), func(e Employee) int { return e.ID })
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(index), 5)
+	testutil.AssertEqual(t, index[3].Name, "Charlie")
+}
+
+func TestIndexByUniqueDuplicateKey(t *testing.T) {
+	_, err := IndexByUnique(EmployeeDirectory(), employeeRole)
+	testutil.AssertEqual(t, err.Error(), "duplicate key {Position:Engineer Department:Platform} for items 0 and 4")
+}
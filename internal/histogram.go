@@ -0,0 +1,150 @@
+// histogram.go
+package internal
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// histogramBarWidth is the length of the bar for the fullest bucket
+const histogramBarWidth = 30
+
+// Histogram counts observations into buckets with fixed upper bounds plus
+// an overflow bucket, keeping memory constant however many values it sees.
+// It is safe for concurrent use.
+type Histogram struct {
+	mu       sync.Mutex
+	bounds   []float64 // sorted upper bounds; bucket i holds values <= bounds[i]
+	counts   []int     // len(bounds)+1, the last being the overflow bucket
+	count    int
+	sum      float64
+	min, max float64
+}
+
+// NewHistogram creates a histogram with the given bucket upper bounds,
+// which are sorted and de-duplicated
+func NewHistogram(bounds []float64) *Histogram {
+	sorted := append([]float64(nil), bounds...)
+	sort.Float64s(sorted)
+	unique := sorted[:0]
+	for i, b := range sorted {
+		if i == 0 || b != sorted[i-1] {
+			unique = append(unique, b)
+		}
+	}
+	return &Histogram{bounds: unique, counts: make([]int, len(unique)+1)}
+}
+
+// ExponentialBuckets returns count bounds starting at start and growing by
+// factor, e.g. ExponentialBuckets(10, 2, 4) is [10 20 40 80]
+func ExponentialBuckets(start, factor float64, count int) []float64 {
+	bounds := make([]float64, count)
+	for i := range bounds {
+		bounds[i] = start
+		start *= factor
+	}
+	return bounds
+}
+
+// Observe records one value
+func (h *Histogram) Observe(value float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.counts[sort.SearchFloat64s(h.bounds, value)]++
+	if h.count == 0 || value < h.min {
+		h.min = value
+	}
+	if h.count == 0 || value > h.max {
+		h.max = value
+	}
+	h.count++
+	h.sum += value
+}
+
+// Count returns the number of observations
+func (h *Histogram) Count() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.count
+}
+
+// Sum returns the total of all observations
+func (h *Histogram) Sum() float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.sum
+}
+
+// Quantile estimates the q-th quantile (0 <= q <= 1) by finding the bucket
+// holding that rank and interpolating linearly inside it. The outer edges
+// use the observed min and max, so the estimate never leaves the data's
+// range. It returns NaN with no observations.
+func (h *Histogram) Quantile(q float64) float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.count == 0 {
+		return math.NaN()
+	}
+	q = math.Max(0, math.Min(1, q))
+
+	rank := q * float64(h.count)
+	seen := 0
+	for i, n := range h.counts {
+		if n == 0 || float64(seen+n) < rank {
+			seen += n
+			continue
+		}
+		lower, upper := h.bucketRange(i)
+		return lower + (upper-lower)*(rank-float64(seen))/float64(n)
+	}
+	return h.max
+}
+
+// bucketRange returns bucket i's bounds clamped to the observed range
+func (h *Histogram) bucketRange(i int) (lower, upper float64) {
+	lower, upper = h.min, h.max
+	if i > 0 && h.bounds[i-1] > lower {
+		lower = h.bounds[i-1]
+	}
+	if i < len(h.bounds) && h.bounds[i] < upper {
+		upper = h.bounds[i]
+	}
+	return lower, upper
+}
+
+// String draws one bar per bucket, scaled to the fullest bucket
+func (h *Histogram) String() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	labels := make([]string, len(h.counts))
+	for i := range h.bounds {
+		labels[i] = fmt.Sprintf("<= %g", h.bounds[i])
+	}
+	if len(h.bounds) > 0 {
+		labels[len(h.bounds)] = fmt.Sprintf(">  %g", h.bounds[len(h.bounds)-1])
+	} else {
+		labels[0] = "all"
+	}
+
+	width, peak := 0, 0
+	for i, n := range h.counts {
+		width = max(width, len(labels[i]))
+		peak = max(peak, n)
+	}
+
+	var b strings.Builder
+	for i, n := range h.counts {
+		bar := 0
+		if peak > 0 {
+			bar = (n*histogramBarWidth + peak - 1) / peak
+		}
+		fmt.Fprintf(&b, "%-*s %s %d\n", width, labels[i], Green(strings.Repeat("█", bar)), n)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
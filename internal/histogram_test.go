@@ -0,0 +1,72 @@
+// histogram_test.go
+package internal
+
+import (
+	"math"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// uniformHistogram observes 1..100 into buckets 10, 20, 40 and 80
+func uniformHistogram() *Histogram {
+	h := NewHistogram(ExponentialBuckets(10, 2, 4))
+	for v := 1; v <= 100; v++ {
+		h.Observe(float64(v))
+	}
+	return h
+}
+
+func TestHistogramCountAndSum(t *testing.T) {
+	h := uniformHistogram()
+	testutil.AssertEqual(t, h.Count(), 100)
+	testutil.AssertEqual(t, h.Sum(), 5050.0)
+}
+
+func TestHistogramQuantile(t *testing.T) {
+	h := uniformHistogram()
+
+	median := h.Quantile(0.5)
+	if median <= 40 || median > 80 {
+		t.Fatalf("Quantile(0.5) = %v, want it in the (40, 80] bucket", median)
+	}
+	testutil.AssertEqual(t, median, 50.0)
+	testutil.AssertEqual(t, h.Quantile(0), 1.0)   // clamped to the observed min
+	testutil.AssertEqual(t, h.Quantile(1), 100.0) // and max
+	testutil.AssertEqual(t, h.Quantile(2), 100.0)
+	testutil.AssertEqual(t, h.Quantile(0.1), 10.0)
+
+	testutil.AssertEqual(t, math.IsNaN(NewHistogram(nil).Quantile(0.5)), true)
+}
+
+func TestHistogramBoundsAndConcurrency(t *testing.T) {
+	h := NewHistogram([]float64{5, 1, 5, 3})
+	testutil.AssertEqual(t, len(h.bounds), 3) // sorted and de-duplicated
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				h.Observe(3)
+			}
+		}()
+	}
+	wg.Wait()
+	testutil.AssertEqual(t, h.Count(), 800)
+	testutil.AssertEqual(t, h.counts[1], 800) // 3 lands in the <= 3 bucket
+}
+
+func TestHistogramString(t *testing.T) {
+	withColorMode(t, ColorNever)
+	lines := strings.Split(uniformHistogram().String(), "\n")
+	testutil.AssertEqual(t, len(lines), 5)
+	testutil.AssertEqual(t, lines[0], "<= 10 "+strings.Repeat("█", 8)+" 10")
+	testutil.AssertEqual(t, lines[3], "<= 80 "+strings.Repeat("█", 30)+" 40")
+	testutil.AssertEqual(t, lines[4], ">  80 "+strings.Repeat("█", 15)+" 20")
+
+	testutil.AssertEqual(t, NewHistogram(nil).String(), "all  0")
+}
@@ -0,0 +1,91 @@
+// http_json.go
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// HTTPError describes a non-2xx response. Message is taken from an
+// {"error": ...} or {"message": ...} JSON body, or the raw body otherwise.
+type HTTPError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *HTTPError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("http %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("http %d: %s", e.StatusCode, e.Message)
+}
+
+// PostJSON sends body as JSON to url and decodes a 2xx response into out
+// (which may be nil to discard it). It returns the response status code;
+// non-2xx responses are reported as an *HTTPError.
+func PostJSON(ctx context.Context, client *http.Client, url string, body interface{}, out interface{}) (int, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return 0, fmt.Errorf("encode request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, fmt.Errorf("POST %s: %w", url, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("POST %s: %w", url, err)
+	}
+	defer drainAndClose(resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, decodeHTTPError(resp)
+	}
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return resp.StatusCode, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil && !errors.Is(err, io.EOF) {
+		return resp.StatusCode, fmt.Errorf("decode response from %s: %w", url, err)
+	}
+	return resp.StatusCode, nil
+}
+
+// decodeHTTPError builds an HTTPError from an error response body
+func decodeHTTPError(resp *http.Response) *HTTPError {
+	httpErr := &HTTPError{StatusCode: resp.StatusCode}
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, maxDrainBytes))
+	if err != nil || len(raw) == 0 {
+		return httpErr
+	}
+
+	var body struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(raw, &body) == nil && (body.Error != "" || body.Message != "") {
+		httpErr.Message = body.Error
+		if httpErr.Message == "" {
+			httpErr.Message = body.Message
+		}
+		return httpErr
+	}
+
+	httpErr.Message = strings.TrimSpace(string(raw))
+	return httpErr
+}
@@ -0,0 +1,75 @@
+// http_json_test.go
+package internal
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func echoServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/json" {
+			http.Error(w, `{"error":"want application/json"}`, http.StatusUnsupportedMediaType)
+			return
+		}
+		switch r.URL.Path {
+		case "/echo":
+			w.Header().Set("Content-Type", "application/json")
+			io.Copy(w, r.Body)
+		case "/invalid":
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, `{"error":"email is required"}`)
+		case "/plain":
+			http.Error(w, "upstream exploded", http.StatusBadGateway)
+		case "/empty":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestPostJSONRoundTrip(t *testing.T) {
+	server := echoServer(t)
+	sent := JSONUser{ID: 1, Name: "Alice", Email: "alice@example.com", Age: 30, IsActive: true}
+
+	var got JSONUser
+	status, err := PostJSON(context.Background(), server.Client(), server.URL+"/echo", sent, &got)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, status, http.StatusOK)
+	testutil.AssertEqual(t, got.Name, sent.Name)
+	testutil.AssertEqual(t, got.Email, sent.Email)
+	testutil.AssertEqual(t, got.Age, 30)
+
+	status, err = PostJSON(context.Background(), server.Client(), server.URL+"/empty", sent, &got)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, status, http.StatusNoContent)
+}
+
+func TestPostJSONErrorBodies(t *testing.T) {
+	server := echoServer(t)
+
+	status, err := PostJSON(context.Background(), server.Client(), server.URL+"/invalid", JSONUser{}, nil)
+	testutil.AssertEqual(t, status, http.StatusBadRequest)
+	var httpErr *HTTPError
+	testutil.AssertEqual(t, errors.As(err, &httpErr), true)
+	testutil.AssertEqual(t, httpErr.Message, "email is required")
+	testutil.AssertEqual(t, err.Error(), "http 400: email is required")
+
+	_, err = PostJSON(context.Background(), server.Client(), server.URL+"/plain", JSONUser{}, nil)
+	testutil.AssertEqual(t, err.Error(), "http 502: upstream exploded")
+
+	testutil.AssertEqual(t, (&HTTPError{StatusCode: 404}).Error(), "http 404 Not Found")
+}
+
+func TestPostJSONEncodeError(t *testing.T) {
+	_, err := PostJSON(context.Background(), nil, "http://unused.invalid", make(chan int), nil)
+	testutil.AssertContains(t, err.Error(), "encode request body")
+}
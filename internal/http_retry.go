@@ -0,0 +1,77 @@
+// http_retry.go
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// retryBaseDelay is the wait before the second attempt; it doubles after
+// every further failure, up to retryMaxDelay
+var retryBaseDelay = 200 * time.Millisecond
+
+const retryMaxDelay = 10 * time.Second
+
+// maxDrainBytes bounds how much of a discarded body is read so the
+// connection can be reused without downloading an arbitrarily large error page
+const maxDrainBytes = 64 << 10
+
+// GetWithRetry issues a GET request, retrying transport errors and 5xx
+// responses with exponential backoff. Other responses, including 4xx, are
+// returned to the caller as-is. The wait between attempts is cut short if
+// ctx is cancelled.
+func GetWithRetry(ctx context.Context, client *http.Client, url string, attempts int) (*http.Response, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var lastErr error
+	backoff := NewBackoff(retryBaseDelay, retryMaxDelay, 2, false)
+	for attempt := 1; attempt <= attempts; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("GET %s: %w", url, err)
+		}
+
+		resp, err := client.Do(req)
+		switch {
+		case err != nil:
+			lastErr = err
+		case resp.StatusCode >= 500:
+			lastErr = fmt.Errorf("server returned %s", resp.Status)
+			drainAndClose(resp.Body)
+		default:
+			return resp, nil
+		}
+
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("GET %s: %w", url, ctx.Err())
+		}
+		if attempt == attempts {
+			break
+		}
+
+		timer := time.NewTimer(backoff.Next())
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("GET %s: %w", url, ctx.Err())
+		}
+	}
+
+	return nil, fmt.Errorf("GET %s: giving up after %d attempts: %w", url, attempts, lastErr)
+}
+
+// drainAndClose discards the rest of a body so the underlying connection
+// can return to the pool
+func drainAndClose(body io.ReadCloser) {
+	io.Copy(io.Discard, io.LimitReader(body, maxDrainBytes))
+	body.Close()
+}
@@ -0,0 +1,88 @@
+// http_retry_test.go
+package internal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// fastRetries shrinks the backoff for the duration of a test
+func fastRetries(t *testing.T) {
+	t.Helper()
+	saved := retryBaseDelay
+	retryBaseDelay = time.Millisecond
+	t.Cleanup(func() { retryBaseDelay = saved })
+}
+
+// flakyServer answers 503 for the first failures requests, then 200
+func flakyServer(t *testing.T, failures int32) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			http.Error(w, "try later", http.StatusServiceUnavailable)
+			return
+		}
+		io.WriteString(w, "ok")
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestGetWithRetryEventuallySucceeds(t *testing.T) {
+	fastRetries(t)
+	server, calls := flakyServer(t, 2)
+
+	resp, err := GetWithRetry(context.Background(), server.Client(), server.URL, 5)
+	testutil.AssertNoError(t, err)
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	testutil.AssertEqual(t, string(body), "ok")
+	testutil.AssertEqual(t, calls.Load(), int32(3))
+}
+
+func TestGetWithRetryGivesUp(t *testing.T) {
+	fastRetries(t)
+	server, calls := flakyServer(t, 100)
+
+	_, err := GetWithRetry(context.Background(), server.Client(), server.URL, 3)
+	testutil.AssertContains(t, err.Error(), "giving up after 3 attempts")
+	testutil.AssertContains(t, err.Error(), "503")
+	testutil.AssertEqual(t, calls.Load(), int32(3))
+}
+
+func TestGetWithRetryReturnsClientErrors(t *testing.T) {
+	fastRetries(t)
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	resp, err := GetWithRetry(context.Background(), server.Client(), server.URL, 3)
+	testutil.AssertNoError(t, err)
+	resp.Body.Close()
+	testutil.AssertEqual(t, resp.StatusCode, http.StatusNotFound)
+	testutil.AssertEqual(t, calls.Load(), int32(1))
+}
+
+func TestGetWithRetryRespectsContext(t *testing.T) {
+	server, _ := flakyServer(t, 100) // default backoff: the wait outlasts ctx
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := GetWithRetry(ctx, server.Client(), server.URL, 5)
+	testutil.AssertErrorIs(t, err, context.DeadlineExceeded)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("GetWithRetry took %v after the context expired", elapsed)
+	}
+}
@@ -0,0 +1,118 @@
+// humanize.go
+package internal
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HumanizeDuration renders a duration compactly, picking the unit by
+// magnitude: "850ns", "2.3µs", "450ms", "1.5s", "1h2m3s"
+func HumanizeDuration(d time.Duration) string {
+	if d == 0 {
+		return "0s"
+	}
+	if d < 0 {
+		if d == math.MinInt64 {
+			d++ // -d would overflow back to d; 1ns is far below the printed precision
+		}
+		return "-" + HumanizeDuration(-d)
+	}
+
+	if d < time.Microsecond {
+		return fmt.Sprintf("%dns", int64(d))
+	}
+	units := []struct {
+		size, limit time.Duration
+		name        string
+	}{
+		{time.Microsecond, time.Millisecond, "µs"},
+		{time.Millisecond, time.Second, "ms"},
+		{time.Second, time.Minute, "s"},
+	}
+	for _, unit := range units {
+		if d >= unit.limit {
+			continue
+		}
+		// Round to the printed tenth first, so 999.96ms moves up to the
+		// next unit as "1s" instead of printing "1000ms"
+		d = d.Round(unit.size / 10)
+		if d < unit.limit {
+			return formatUnit(float64(d)/float64(unit.size), unit.name)
+		}
+	}
+
+	// Minutes and above: whole seconds broken into h/m/s, skipping zero parts
+	d = d.Round(time.Second)
+	hours := int64(d / time.Hour)
+	minutes := int64(d % time.Hour / time.Minute)
+	seconds := int64(d % time.Minute / time.Second)
+
+	var b strings.Builder
+	if hours > 0 {
+		fmt.Fprintf(&b, "%dh", hours)
+	}
+	if minutes > 0 {
+		fmt.Fprintf(&b, "%dm", minutes)
+	}
+	if seconds > 0 {
+		fmt.Fprintf(&b, "%ds", seconds)
+	}
+	return b.String()
+}
+
+// formatUnit prints value with one decimal place, dropping a trailing ".0"
+func formatUnit(value float64, unit string) string {
+	text := strconv.FormatFloat(value, 'f', 1, 64)
+	return strings.TrimSuffix(text, ".0") + unit
+}
+
+// HumanizeSince describes t relative to now, e.g. "3 minutes ago" or
+// "in 2 hours"
+func HumanizeSince(t time.Time) string {
+	return humanizeRelative(t, time.Now())
+}
+
+func humanizeRelative(t, now time.Time) string {
+	diff := now.Sub(t)
+	future := diff < 0
+	if future {
+		diff = -diff
+	}
+
+	if diff < time.Second {
+		return "just now"
+	}
+
+	units := []struct {
+		size time.Duration
+		name string
+	}{
+		{365 * 24 * time.Hour, "year"},
+		{30 * 24 * time.Hour, "month"},
+		{24 * time.Hour, "day"},
+		{time.Hour, "hour"},
+		{time.Minute, "minute"},
+		{time.Second, "second"},
+	}
+
+	var phrase string
+	for _, unit := range units {
+		if diff >= unit.size {
+			count := int64(diff / unit.size)
+			phrase = fmt.Sprintf("%d %s", count, unit.name)
+			if count != 1 {
+				phrase += "s"
+			}
+			break
+		}
+	}
+
+	if future {
+		return "in " + phrase
+	}
+	return phrase + " ago"
+}
@@ -0,0 +1,57 @@
+// humanize_test.go
+package internal
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestHumanizeDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "0s"},
+		{850 * time.Nanosecond, "850ns"},
+		{2300 * time.Nanosecond, "2.3µs"},
+		{450 * time.Millisecond, "450ms"},
+		{1500 * time.Millisecond, "1.5s"},
+		{time.Hour + 2*time.Minute + 3*time.Second, "1h2m3s"},
+		{26 * time.Hour, "26h"},
+		{90*time.Second + 400*time.Millisecond, "1m30s"},
+		{-450 * time.Millisecond, "-450ms"},
+		// Values that round up to a unit boundary are shown in the next unit
+		{999960 * time.Nanosecond, "1ms"},
+		{999960 * time.Microsecond, "1s"},
+		{59960 * time.Millisecond, "1m"},
+		{59940 * time.Millisecond, "59.9s"},
+		{math.MaxInt64, "2562047h47m16s"},
+		{math.MinInt64, "-2562047h47m16s"},
+	}
+	for _, tt := range tests {
+		testutil.AssertEqual(t, HumanizeDuration(tt.d), tt.want)
+	}
+}
+
+func TestHumanizeRelative(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		at   time.Time
+		want string
+	}{
+		{now.Add(-300 * time.Millisecond), "just now"},
+		{now.Add(-time.Second), "1 second ago"},
+		{now.Add(-3 * time.Minute), "3 minutes ago"},
+		{now.Add(2 * time.Hour), "in 2 hours"},
+		{now.Add(-49 * time.Hour), "2 days ago"},
+		{now.Add(400 * 24 * time.Hour), "in 1 year"},
+	}
+	for _, tt := range tests {
+		testutil.AssertEqual(t, humanizeRelative(tt.at, now), tt.want)
+	}
+
+	testutil.AssertEqual(t, HumanizeSince(time.Now().Add(-10*time.Minute)), "10 minutes ago")
+}
@@ -0,0 +1,57 @@
+// id_generator.go
+package internal
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync/atomic"
+)
+
+// IDGenerator produces identifiers for requests, orders and similar entities
+type IDGenerator interface {
+	Next() string
+}
+
+// SequentialIDGenerator yields prefix-1, prefix-2, ... and is safe for
+// concurrent use. Its output is deterministic, which makes it ideal in tests.
+type SequentialIDGenerator struct {
+	prefix  string
+	counter atomic.Uint64
+}
+
+// NewSequentialIDGenerator creates a generator whose IDs start with prefix
+func NewSequentialIDGenerator(prefix string) *SequentialIDGenerator {
+	return &SequentialIDGenerator{prefix: prefix}
+}
+
+// Next returns the next ID in the sequence
+func (g *SequentialIDGenerator) Next() string {
+	return fmt.Sprintf("%s%d", g.prefix, g.counter.Add(1))
+}
+
+// RandomIDGenerator yields prefix followed by random hex digits read from
+// crypto/rand
+type RandomIDGenerator struct {
+	prefix  string
+	byteLen int
+}
+
+// NewRandomIDGenerator creates a generator producing byteLen random bytes
+// (2*byteLen hex characters) per ID
+func NewRandomIDGenerator(prefix string, byteLen int) *RandomIDGenerator {
+	if byteLen <= 0 {
+		byteLen = 8
+	}
+	return &RandomIDGenerator{prefix: prefix, byteLen: byteLen}
+}
+
+// Next returns a new random ID
+func (g *RandomIDGenerator) Next() string {
+	buf := make([]byte, g.byteLen)
+	if _, err := rand.Read(buf); err != nil {
+		// crypto/rand only fails if the OS entropy source is broken
+		panic(fmt.Sprintf("reading random bytes: %v", err))
+	}
+	return g.prefix + hex.EncodeToString(buf)
+}
@@ -0,0 +1,58 @@
+// id_generator_test.go
+package internal
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestSequentialIDGeneratorConcurrent(t *testing.T) {
+	gen := NewSequentialIDGenerator("req-")
+	const workers, perWorker = 8, 250
+
+	var (
+		mu   sync.Mutex
+		seen = make(map[string]bool)
+		wg   sync.WaitGroup
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				id := gen.Next()
+				mu.Lock()
+				if seen[id] {
+					t.Errorf("duplicate id %s", id)
+				}
+				seen[id] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	testutil.AssertEqual(t, len(seen), workers*perWorker)
+	testutil.AssertEqual(t, seen["req-1"], true)
+	testutil.AssertEqual(t, seen["req-2000"], true)
+	testutil.AssertEqual(t, gen.Next(), "req-2001")
+}
+
+func TestRandomIDGeneratorFormat(t *testing.T) {
+	var gen IDGenerator = NewRandomIDGenerator("order-", 6)
+	first := gen.Next()
+	testutil.AssertEqual(t, len(first), len("order-")+12)
+	for i := 0; i < 50; i++ {
+		id := gen.Next()
+		hexPart, ok := strings.CutPrefix(id, "order-")
+		testutil.AssertEqual(t, ok, true)
+		testutil.AssertEqual(t, strings.Trim(hexPart, "0123456789abcdef"), "")
+		testutil.AssertEqual(t, id == first, false)
+	}
+
+	// a non-positive length falls back to 8 bytes
+	testutil.AssertEqual(t, len(NewRandomIDGenerator("", 0).Next()), 16)
+}
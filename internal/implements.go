@@ -0,0 +1,82 @@
+// implements.go
+package internal
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Implements reports whether v's type, or a pointer to it, satisfies the
+// interface ifacePtr points to, e.g. Implements(f, (*io.Reader)(nil)).
+// Accepting the pointer type means a value whose methods have pointer
+// receivers still counts, since &v would satisfy the interface. A nil v
+// implements nothing.
+func Implements(v interface{}, ifacePtr interface{}) bool {
+	iface := interfaceType(ifacePtr)
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return false
+	}
+	return widestMethodSet(t).Implements(iface)
+}
+
+// widestMethodSet returns *t for a non-pointer t, whose method set includes
+// both value and pointer receivers, and t itself when it is already a
+// pointer
+func widestMethodSet(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Pointer {
+		return t
+	}
+	return reflect.PointerTo(t)
+}
+
+// MissingMethods lists the methods of the interface ifacePtr points to
+// that v's type (or a pointer to it) lacks, in the interface's method
+// order. A method that exists with the wrong signature is listed with both
+// signatures, which is usually the real cause of a "does not implement"
+// error. The result is empty exactly when Implements returns true.
+func MissingMethods(v interface{}, ifacePtr interface{}) []string {
+	iface := interfaceType(ifacePtr)
+	t := reflect.TypeOf(v)
+	var missing []string
+	for i := 0; i < iface.NumMethod(); i++ {
+		want := iface.Method(i)
+		if t == nil {
+			missing = append(missing, want.Name)
+			continue
+		}
+		got, ok := widestMethodSet(t).MethodByName(want.Name)
+		if !ok {
+			missing = append(missing, want.Name)
+			continue
+		}
+		if has := methodSignature(got.Type); has != want.Type {
+			missing = append(missing, fmt.Sprintf("%s (has %s, want %s)", want.Name, has, want.Type))
+		}
+	}
+	return missing
+}
+
+// interfaceType unwraps a pointer-to-interface such as (*error)(nil),
+// panicking on anything else since that is a programming error
+func interfaceType(ifacePtr interface{}) reflect.Type {
+	t := reflect.TypeOf(ifacePtr)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Interface {
+		panic(fmt.Sprintf("interface argument must be a pointer to an interface, such as (*io.Reader)(nil), got %T", ifacePtr))
+	}
+	return t.Elem()
+}
+
+// methodSignature drops the receiver from a method's func type so it can be
+// compared with an interface method
+func methodSignature(method reflect.Type) reflect.Type {
+	in := make([]reflect.Type, 0, method.NumIn()-1)
+	for i := 1; i < method.NumIn(); i++ {
+		in = append(in, method.In(i))
+	}
+	out := make([]reflect.Type, 0, method.NumOut())
+	for i := 0; i < method.NumOut(); i++ {
+		out = append(out, method.Out(i))
+	}
+	return reflect.FuncOf(in, out, method.IsVariadic())
+}
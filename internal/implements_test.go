@@ -0,0 +1,80 @@
+// implements_test.go
+package internal
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// fullRW implements io.ReadWriter with value receivers
+type fullRW struct{}
+
+func (fullRW) Read(p []byte) (int, error)  { return 0, io.EOF }
+func (fullRW) Write(p []byte) (int, error) { return len(p), nil }
+
+// readOnly lacks Write
+type readOnly struct{}
+
+func (readOnly) Read(p []byte) (int, error) { return 0, io.EOF }
+
+// ptrCloser implements io.Closer only through its pointer
+type ptrCloser struct{}
+
+func (*ptrCloser) Close() error { return nil }
+
+// wrongWrite has Write with the wrong signature
+type wrongWrite struct{ readOnly }
+
+func (wrongWrite) Write(s string) error { return nil }
+
+func TestImplementsFull(t *testing.T) {
+	testutil.AssertEqual(t, Implements(fullRW{}, (*io.ReadWriter)(nil)), true)
+	testutil.AssertEqual(t, len(MissingMethods(fullRW{}, (*io.ReadWriter)(nil))), 0)
+	testutil.AssertEqual(t, Implements(&strings.Builder{}, (*fmt.Stringer)(nil)), true)
+}
+
+func TestImplementsMissingMethod(t *testing.T) {
+	testutil.AssertEqual(t, Implements(readOnly{}, (*io.ReadWriter)(nil)), false)
+	testutil.AssertEqual(t, fmt.Sprint(MissingMethods(readOnly{}, (*io.ReadWriter)(nil))), "[Write]")
+
+	testutil.AssertEqual(t, Implements(wrongWrite{}, (*io.Writer)(nil)), false)
+	testutil.AssertEqual(t, fmt.Sprint(MissingMethods(wrongWrite{}, (*io.Writer)(nil))),
+		"[Write (has func(string) error, want func([]uint8) (int, error))]")
+}
+
+func TestImplementsPointerReceivers(t *testing.T) {
+	// The value's method set lacks Close, but &v has it, which Implements accepts
+	testutil.AssertEqual(t, Implements(ptrCloser{}, (*io.Closer)(nil)), true)
+	testutil.AssertEqual(t, Implements(&ptrCloser{}, (*io.Closer)(nil)), true)
+	testutil.AssertEqual(t, len(MissingMethods(ptrCloser{}, (*io.Closer)(nil))), 0)
+
+	var asValue interface{} = ptrCloser{}
+	_, ok := asValue.(io.Closer)
+	testutil.AssertEqual(t, ok, false)
+}
+
+func TestMissingMethodsPointerArgument(t *testing.T) {
+	var buf strings.Builder
+	testutil.AssertEqual(t, Implements(&buf, (*io.Writer)(nil)), true)
+	testutil.AssertEqual(t, len(MissingMethods(&buf, (*io.Writer)(nil))), 0)
+	testutil.AssertEqual(t, len(MissingMethods(&ptrCloser{}, (*io.Closer)(nil))), 0)
+
+	testutil.AssertEqual(t, Implements(&readOnly{}, (*io.ReadWriter)(nil)), false)
+	testutil.AssertEqual(t, fmt.Sprint(MissingMethods(&readOnly{}, (*io.ReadWriter)(nil))), "[Write]")
+}
+
+func TestImplementsNilAndMisuse(t *testing.T) {
+	testutil.AssertEqual(t, Implements(nil, (*io.Reader)(nil)), false)
+	testutil.AssertEqual(t, fmt.Sprint(MissingMethods(nil, (*io.ReadCloser)(nil))), "[Close Read]")
+
+	defer func() {
+		msg, _ := recover().(string)
+		testutil.AssertContains(t, msg, "must be a pointer to an interface")
+	}()
+	Implements(fullRW{}, io.Reader(nil))
+	t.Errorf("Implements accepted a non-pointer interface argument")
+}
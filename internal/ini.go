@@ -0,0 +1,109 @@
+// ini.go
+package internal
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ParseINI reads an INI file into section → key → value. The format:
+//
+//	; comment          # also a comment
+//	top = level        keys before any [section] go in section ""
+//	[server]
+//	host = example.com ; trailing comments follow whitespace
+//	motd = "a=b; c"    quotes keep '=', ';' and '#' literal
+//	path = /usr/bin:\
+//	       /bin        a trailing backslash continues the value
+//
+// Later duplicates of a key overwrite earlier ones. Errors name the line
+// the malformed entry starts on.
+func ParseINI(r io.Reader) (map[string]map[string]string, error) {
+	result := map[string]map[string]string{}
+	section := ""
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		start := lineNum
+		line := strings.TrimSpace(scanner.Text())
+
+		// Join continuation lines before interpreting the entry
+		for strings.HasSuffix(line, `\`) {
+			line = strings.TrimSuffix(line, `\`)
+			if !scanner.Scan() {
+				break
+			}
+			lineNum++
+			line += strings.TrimSpace(scanner.Text())
+		}
+
+		switch {
+		case line == "" || line[0] == ';' || line[0] == '#':
+			continue
+
+		case line[0] == '[':
+			end := strings.IndexByte(line, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("ini: line %d: unterminated section header", start)
+			}
+			section = strings.TrimSpace(line[1:end])
+			if section == "" {
+				return nil, fmt.Errorf("ini: line %d: empty section name", start)
+			}
+			if _, ok := result[section]; !ok {
+				result[section] = map[string]string{}
+			}
+			continue
+		}
+
+		key, raw, found := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			return nil, fmt.Errorf("ini: line %d: expected key = value, got %q", start, line)
+		}
+		value, err := parseINIValue(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, fmt.Errorf("ini: line %d: %w", start, err)
+		}
+
+		if result[section] == nil {
+			result[section] = map[string]string{}
+		}
+		result[section][key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("ini: %w", err)
+	}
+	return result, nil
+}
+
+// parseINIValue unquotes a quoted value or strips a trailing comment from
+// a bare one
+func parseINIValue(raw string) (string, error) {
+	if raw != "" && (raw[0] == '"' || raw[0] == '\'') {
+		quote := raw[0]
+		end := strings.IndexByte(raw[1:], quote)
+		if end < 0 {
+			return "", fmt.Errorf("unterminated quoted value")
+		}
+		rest := strings.TrimSpace(raw[end+2:])
+		if rest != "" && rest[0] != ';' && rest[0] != '#' {
+			return "", fmt.Errorf("unexpected text after quoted value: %q", rest)
+		}
+		return raw[1 : end+1], nil
+	}
+
+	for i := 1; i < len(raw); i++ {
+		if (raw[i] == ';' || raw[i] == '#') && (raw[i-1] == ' ' || raw[i-1] == '\t') {
+			return strings.TrimSpace(raw[:i]), nil
+		}
+	}
+	if raw != "" && (raw[0] == ';' || raw[0] == '#') {
+		return "", nil
+	}
+	return raw, nil
+}
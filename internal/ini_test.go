@@ -0,0 +1,63 @@
+// ini_test.go
+package internal
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestParseINI(t *testing.T) {
+	input := `; leading comment
+# another comment
+name = goedge
+
+[server]
+host = example.com ; trailing comment
+port=8080
+motd = "a=b; c # d"
+single = 'say "hi"'
+url = http://x/#frag
+empty =
+
+[paths]
+bin = /usr/bin:\
+      /bin
+[server]
+port = 9090
+`
+	got, err := ParseINI(strings.NewReader(input))
+	testutil.AssertNoError(t, err)
+
+	testutil.AssertEqual(t, got[""]["name"], "goedge")
+	testutil.AssertEqual(t, got["server"]["host"], "example.com")
+	testutil.AssertEqual(t, got["server"]["port"], "9090") // a reopened section overwrites
+	testutil.AssertEqual(t, got["server"]["motd"], "a=b; c # d")
+	testutil.AssertEqual(t, got["server"]["single"], `say "hi"`)
+	testutil.AssertEqual(t, got["server"]["url"], "http://x/#frag") // '#' without whitespace stays
+	testutil.AssertEqual(t, got["server"]["empty"], "")
+	testutil.AssertEqual(t, got["paths"]["bin"], "/usr/bin:/bin")
+	testutil.AssertEqual(t, len(got), 3)
+}
+
+func TestParseINIErrors(t *testing.T) {
+	cases := []struct {
+		input, want string
+	}{
+		{"[ok]\na = 1\njust text\n", `ini: line 3: expected key = value, got "just text"`},
+		{"a = 1\n[broken\n", "ini: line 2: unterminated section header"},
+		{"[ ]\n", "ini: line 1: empty section name"},
+		{"\n\nmotd = \"open\n", "ini: line 3: unterminated quoted value"},
+		{"k = \"v\" extra\n", `ini: line 1: unexpected text after quoted value: "extra"`},
+		{"a = x\\\nb\n= oops\n", `ini: line 3: expected key = value, got "= oops"`},
+	}
+	for _, c := range cases {
+		_, err := ParseINI(strings.NewReader(c.input))
+		if err == nil {
+			t.Errorf("ParseINI(%q) succeeded, want %q", c.input, c.want)
+			continue
+		}
+		testutil.AssertEqual(t, err.Error(), c.want)
+	}
+}
@@ -0,0 +1,48 @@
+// interner.go
+package internal
+
+import (
+	"strings"
+	"sync"
+)
+
+// Interner hands out one canonical copy of each distinct string, so data
+// parsed from many repeated tokens (log levels, field names, city names)
+// keeps a single allocation per value instead of one per occurrence.
+type Interner struct {
+	mu      sync.RWMutex
+	strings map[string]string
+}
+
+// NewInterner creates an empty interning pool
+func NewInterner() *Interner {
+	return &Interner{strings: make(map[string]string)}
+}
+
+// Intern returns the canonical instance of s. The first time a value is
+// seen it is cloned, so a token sliced out of a large buffer does not keep
+// that whole buffer alive.
+func (in *Interner) Intern(s string) string {
+	in.mu.RLock()
+	canonical, ok := in.strings[s]
+	in.mu.RUnlock()
+	if ok {
+		return canonical
+	}
+
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	if canonical, ok := in.strings[s]; ok {
+		return canonical
+	}
+	canonical = strings.Clone(s)
+	in.strings[canonical] = canonical
+	return canonical
+}
+
+// Len returns the number of distinct strings interned
+func (in *Interner) Len() int {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+	return len(in.strings)
+}
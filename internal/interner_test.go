@@ -0,0 +1,53 @@
+// interner_test.go
+package internal
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"unsafe"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestInternerCanonicalInstances(t *testing.T) {
+	in := NewInterner()
+	line := "INFO WARN INFO ERROR INFO WARN"
+	var interned []string
+	for _, token := range strings.Fields(line) {
+		interned = append(interned, in.Intern(token))
+	}
+
+	testutil.AssertEqual(t, in.Len(), 3)
+	testutil.AssertEqual(t, interned[0] == interned[2], true)
+	// equal strings share one backing array, not just equal contents
+	testutil.AssertEqual(t, unsafe.StringData(interned[0]), unsafe.StringData(interned[4]))
+	testutil.AssertEqual(t, unsafe.StringData(interned[1]), unsafe.StringData(interned[5]))
+	// the canonical copy does not point into the parsed line
+	testutil.AssertEqual(t, unsafe.StringData(interned[0]) == unsafe.StringData(line), false)
+}
+
+func TestInternerConcurrent(t *testing.T) {
+	in := NewInterner()
+	words := []string{"alpha", "beta", "gamma", "delta"}
+	results := make([][]string, 8)
+
+	var wg sync.WaitGroup
+	for g := range results {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				// build a fresh copy each time so interning has work to do
+				word := strings.Clone(words[i%len(words)])
+				results[g] = append(results[g], in.Intern(word))
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	testutil.AssertEqual(t, in.Len(), len(words))
+	for g := range results {
+		testutil.AssertEqual(t, unsafe.StringData(results[g][0]), unsafe.StringData(results[0][0]))
+	}
+}
@@ -3,10 +3,13 @@ package internal
 
 import (
 	"bytes"
+	"compress/gzip"
 	"crypto/rand"
 	"fmt"
 	"io"
+	"log/slog"
 	"os"
+	"sort"
 	"strings"
 )
 
@@ -26,8 +29,14 @@ func RunIOExamples() {
 	limitedReaderDemo()
 	pipeDemo()
 	sectionReaderDemo()
+	chunkedReadDemo()
 	teeReaderDemo()
 	ioUtilityFunctionsDemo()
+	tokenizerDemo()
+	fixedRecordDemo()
+	promptDemo()
+	contentSniffDemo()
+	levelRouterDemo()
 }
 
 // Reader Interface Examples
@@ -357,6 +366,32 @@ func sectionReaderDemo() {
 	fmt.Println()
 }
 
+// Concurrent chunked reading with ReaderAt and SectionReader
+func chunkedReadDemo() {
+	fmt.Println(Yellow("📌 Concurrent Chunked Reads (ReaderAt):"))
+
+	// bytes.Reader implements io.ReaderAt, like *os.File does
+	source := bytes.Repeat([]byte("0123456789"), 10)
+	source = append(source, "tail"...)
+	ra := bytes.NewReader(source)
+
+	data, err := ReadChunksConcurrent(ra, int64(len(source)), 16, 4)
+	if err != nil {
+		fmt.Printf("Error reading chunks: %v\n", err)
+	} else {
+		chunks := (len(source) + 15) / 16
+		fmt.Printf("Read %d bytes in %d chunks of 16 (last chunk %d bytes)\n",
+			len(data), chunks, len(source)-(chunks-1)*16)
+		fmt.Printf("Reassembled matches source: %s\n", Green(fmt.Sprint(bytes.Equal(data, source))))
+	}
+
+	// Asking for more bytes than the source holds fails on the short chunk
+	if _, err := ReadChunksConcurrent(ra, int64(len(source))+10, 16, 4); err != nil {
+		fmt.Printf("Oversized read: %s\n", ErrorText(err.Error()))
+	}
+	fmt.Println()
+}
+
 // TeeReader Example
 func teeReaderDemo() {
 	fmt.Println(Yellow("📌 TeeReader:"))
@@ -452,3 +487,161 @@ func ioUtilityFunctionsDemo() {
 	}
 	fmt.Println()
 }
+
+// Tokenizer Example
+func tokenizerDemo() {
+	fmt.Println(Yellow("📌 Streaming Tokenizer:"))
+
+	source := "total := price * 1.5\nlabel = \"Tax \\\"incl.\\\"\"\n"
+	tokens, err := Tokenize(strings.NewReader(source))
+	if err != nil {
+		fmt.Printf("Error tokenizing: %v\n", err)
+		return
+	}
+	for _, tok := range tokens {
+		if tok.Kind == TokenWhitespace {
+			continue
+		}
+		fmt.Printf("  %-10s %-14q (line %d, col %d)\n", tok.Kind, tok.Value, tok.Line, tok.Col)
+	}
+
+	// Errors carry the position of the offending input
+	_, err = Tokenize(strings.NewReader("x = 1\ny = \"never closed"))
+	fmt.Printf("Unterminated input: %s\n", Red(err.Error()))
+	fmt.Println()
+}
+
+// Prompts driven by scripted input instead of a keyboard
+func promptDemo() {
+	fmt.Println(Yellow("📌 Prompt and Confirm:"))
+
+	// TeeReader echoes each answer as it is read, like a terminal would
+	script := strings.NewReader("my-service\nmaybe\ny\n\n")
+	in := io.TeeReader(script, os.Stdout)
+
+	name, err := Prompt(in, os.Stdout, "Service name: ")
+	if err != nil {
+		fmt.Printf("Error reading name: %v\n", err)
+		return
+	}
+	metrics, err := Confirm(in, os.Stdout, "Enable metrics?", false)
+	if err != nil {
+		fmt.Printf("Error reading answer: %v\n", err)
+		return
+	}
+	overwrite, err := Confirm(in, os.Stdout, "Overwrite existing config?", true)
+	if err != nil {
+		fmt.Printf("Error reading answer: %v\n", err)
+		return
+	}
+	fmt.Printf("Answers: name=%q metrics=%v overwrite=%v\n", name, metrics, overwrite)
+
+	// The script is used up, so the next question hits EOF
+	if _, err := Confirm(in, os.Stdout, "Deploy now?", true); err != nil {
+		fmt.Printf("\nOut of input: %s\n", ErrorText(err.Error()))
+	}
+	fmt.Println()
+}
+
+// Fixed-size records with a custom bufio.SplitFunc
+func fixedRecordDemo() {
+	fmt.Println(Yellow("📌 Fixed-Size Records:"))
+
+	// Each record: 4-byte SKU followed by a 3-digit quantity
+	exact := "A001010B002105C003007"
+	err := ScanFixed(strings.NewReader(exact), 7, func(record []byte) error {
+		fmt.Printf("  sku=%s qty=%s\n", record[:4], record[4:])
+		return nil
+	})
+	if err != nil {
+		fmt.Printf("Error scanning records: %v\n", err)
+	}
+
+	truncated := exact + "D00"
+	err = ScanFixed(strings.NewReader(truncated), 7, func([]byte) error { return nil })
+	fmt.Printf("Strict mode, trailing partial record: %s\n", Red(err.Error()))
+
+	fmt.Println("Pad mode, trailing partial record:")
+	ScanFixed(strings.NewReader(truncated), 7, func(record []byte) error {
+		fmt.Printf("  %q\n", record)
+		return nil
+	}, PadRecords)
+	fmt.Println()
+}
+
+// Content sniffing example
+func contentSniffDemo() {
+	fmt.Println(Yellow("📌 Content-Type Sniffing:"))
+
+	var gzipped bytes.Buffer
+	zw := gzip.NewWriter(&gzipped)
+	zw.Write([]byte("compressed payload"))
+	zw.Close()
+
+	streams := map[string]io.Reader{
+		"notes.txt":  strings.NewReader("Shopping list:\n- milk\n- café au lait\n"),
+		"logo.png":   bytes.NewReader([]byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")),
+		"backup.gz":  &gzipped,
+		"index.html": strings.NewReader("<!DOCTYPE html><html><body>hi</body></html>"),
+	}
+
+	textHandler := func(r io.Reader) string {
+		data, _ := io.ReadAll(r)
+		return fmt.Sprintf("text handler: %d lines, %d bytes", len(strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")), len(data))
+	}
+	binaryHandler := func(r io.Reader) string {
+		data, _ := io.ReadAll(r)
+		return fmt.Sprintf("binary handler: %d bytes, starts % x", len(data), data[:min(4, len(data))])
+	}
+
+	names := make([]string, 0, len(streams))
+	for name := range streams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		contentType, stream, err := SniffContentType(streams[name])
+		if err != nil {
+			fmt.Printf("  %s: %v\n", name, err)
+			continue
+		}
+		// The returned stream still starts at byte 0
+		handle := binaryHandler
+		if strings.HasPrefix(contentType, "text/") {
+			handle = textHandler
+		}
+		fmt.Printf("  %-10s %-26s -> %s\n", name, contentType, handle(stream))
+	}
+	fmt.Println()
+}
+
+func levelRouterDemo() {
+	fmt.Println(Yellow("📌 Routing Log Records by Level:"))
+
+	// Stand-ins for stderr and a log file
+	var errorsOut, allOut bytes.Buffer
+	router := NewLevelRouter().
+		Route(slog.LevelWarn, &errorsOut).
+		Route(slog.LevelDebug, &allOut)
+
+	// Drop timestamps so the output is stable
+	handler := slog.NewJSONHandler(router, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	logger := slog.New(handler)
+	logger.Debug("cache warmed", "entries", 120)
+	logger.Info("request served", "path", "/users", "status", 200)
+	logger.Warn("slow query", "ms", 850)
+	logger.Error("payment failed", "order", 42, "err", "card declined")
+
+	fmt.Printf("Warnings and errors (%d records):\n%s", strings.Count(errorsOut.String(), "\n"), errorsOut.String())
+	fmt.Printf("Everything (%d records):\n%s", strings.Count(allOut.String(), "\n"), allOut.String())
+	fmt.Println()
+}
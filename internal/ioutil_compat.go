@@ -0,0 +1,83 @@
+// ioutil_compat.go
+package internal
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+)
+
+// These wrappers keep the io/ioutil API (and its exact behavior) while
+// delegating to the os and io functions that replaced it in Go 1.16.
+
+// ioutilReplacement records where a deprecated io/ioutil function went
+type ioutilReplacement struct {
+	Deprecated  string
+	Replacement string
+	Note        string
+}
+
+// ioutilReplacements lists every wrapper below with its modern equivalent
+var ioutilReplacements = []ioutilReplacement{
+	{"ioutil.ReadFile", "os.ReadFile", ""},
+	{"ioutil.WriteFile", "os.WriteFile", ""},
+	{"ioutil.ReadDir", "os.ReadDir", "returns fs.DirEntry; call Info() for Size()"},
+	{"ioutil.ReadAll", "io.ReadAll", ""},
+	{"ioutil.TempFile", "os.CreateTemp", ""},
+	{"ioutil.TempDir", "os.MkdirTemp", ""},
+	{"ioutil.NopCloser", "io.NopCloser", ""},
+	{"ioutil.Discard", "io.Discard", ""},
+}
+
+// ReadFile reads the whole named file, like ioutil.ReadFile
+func ReadFile(filename string) ([]byte, error) {
+	return os.ReadFile(filename)
+}
+
+// WriteFile writes data to the named file, creating it with perm if needed
+// and truncating it otherwise, like ioutil.WriteFile
+func WriteFile(filename string, data []byte, perm fs.FileMode) error {
+	return os.WriteFile(filename, data, perm)
+}
+
+// ReadDir returns the directory's entries sorted by name as fs.FileInfo,
+// like ioutil.ReadDir, so callers can still use Size() and Mode()
+func ReadDir(dirname string) ([]fs.FileInfo, error) {
+	entries, err := os.ReadDir(dirname)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]fs.FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name() < infos[j].Name() })
+	return infos, nil
+}
+
+// ReadAll reads r until EOF, like ioutil.ReadAll
+func ReadAll(r io.Reader) ([]byte, error) {
+	return io.ReadAll(r)
+}
+
+// TempFile creates a new temporary file in dir (the default temp directory
+// if empty) named from pattern, like ioutil.TempFile
+func TempFile(dir, pattern string) (*os.File, error) {
+	return os.CreateTemp(dir, pattern)
+}
+
+// TempDir creates a new temporary directory, like ioutil.TempDir
+func TempDir(dir, pattern string) (string, error) {
+	return os.MkdirTemp(dir, pattern)
+}
+
+// NopCloser wraps r with a no-op Close method, like ioutil.NopCloser
+func NopCloser(r io.Reader) io.ReadCloser {
+	return io.NopCloser(r)
+}
@@ -0,0 +1,78 @@
+// ioutil_compat_test.go
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestReadWriteFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "note.txt")
+	testutil.AssertNoError(t, WriteFile(path, []byte("first version"), 0o600))
+	testutil.AssertNoError(t, WriteFile(path, []byte("second"), 0o600)) // truncates
+
+	data, err := ReadFile(path)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, string(data), "second")
+
+	info, err := os.Stat(path)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, info.Mode().Perm(), os.FileMode(0o600))
+
+	_, err = ReadFile(filepath.Join(filepath.Dir(path), "missing"))
+	testutil.AssertErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestReadDirSortedWithSizes(t *testing.T) {
+	dir := t.TempDir()
+	testutil.AssertNoError(t, WriteFile(filepath.Join(dir, "b.txt"), []byte("12345"), 0o644))
+	testutil.AssertNoError(t, WriteFile(filepath.Join(dir, "a.txt"), []byte("12"), 0o644))
+	testutil.AssertNoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0o755))
+
+	infos, err := ReadDir(dir)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(infos), 3)
+	testutil.AssertEqual(t, infos[0].Name(), "a.txt")
+	testutil.AssertEqual(t, infos[0].Size(), int64(2))
+	testutil.AssertEqual(t, infos[1].Size(), int64(5))
+	testutil.AssertEqual(t, infos[2].IsDir(), true)
+
+	_, err = ReadDir(filepath.Join(dir, "missing"))
+	testutil.AssertErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestReadAllAndNopCloser(t *testing.T) {
+	rc := NopCloser(strings.NewReader("payload"))
+	data, err := ReadAll(rc)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, string(data), "payload")
+	testutil.AssertNoError(t, rc.Close())
+}
+
+func TestTempFileAndDir(t *testing.T) {
+	parent := t.TempDir()
+
+	dir, err := TempDir(parent, "work-*")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, filepath.Dir(dir), parent)
+	testutil.AssertEqual(t, strings.HasPrefix(filepath.Base(dir), "work-"), true)
+
+	file, err := TempFile(dir, "*.log")
+	testutil.AssertNoError(t, err)
+	defer file.Close()
+	testutil.AssertEqual(t, filepath.Ext(file.Name()), ".log")
+	testutil.AssertEqual(t, filepath.Dir(file.Name()), dir)
+}
+
+func TestIOUtilExamplesAvoidIOUtil(t *testing.T) {
+	src, err := os.ReadFile("ioutil_examples.go")
+	testutil.AssertNoError(t, err)
+	if strings.Contains(string(src), `"io/ioutil"`) {
+		t.Errorf("ioutil_examples.go still imports io/ioutil")
+	}
+	testutil.AssertEqual(t, len(ioutilReplacements), 8)
+}
@@ -4,7 +4,6 @@ package internal
 import (
 	"fmt"
 	"io"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -14,6 +13,13 @@ import (
 func RunIOUtilExamples() {
 	fmt.Println(Subtitle("📁 IO/ioutil Package Examples"))
 	fmt.Println(Yellow("⚠️  Note: io/ioutil is deprecated since Go 1.16, but still widely used"))
+	fmt.Println("These examples call wrappers that keep the ioutil API on top of its replacements:")
+	table := NewTable("Deprecated", "Replacement", "Note")
+	table.Options.NoBorder = true
+	for _, r := range ioutilReplacements {
+		table.AddRow(r.Deprecated, r.Replacement, r.Note)
+	}
+	table.Render(os.Stdout)
 	fmt.Println()
 
 	readFileExample()
@@ -38,7 +44,7 @@ Line 4: Special characters !@#$%^&*()
 Final line with some content.`
 
 	fileName := "test_read_file.txt"
-	err := ioutil.WriteFile(fileName, []byte(testContent), 0644)
+	err := WriteFile(fileName, []byte(testContent), 0644)
 	if err != nil {
 		fmt.Printf("Error creating test file: %v\n", err)
 		return
@@ -46,7 +52,7 @@ Final line with some content.`
 	defer os.Remove(fileName) // Cleanup
 
 	// Read the entire file
-	data, err := ioutil.ReadFile(fileName)
+	data, err := ReadFile(fileName)
 	if err != nil {
 		fmt.Printf("Error reading file: %v\n", err)
 		return
@@ -56,7 +62,7 @@ Final line with some content.`
 	fmt.Printf("File content:\n%s\n", Green(string(data)))
 
 	// Read non-existent file
-	_, err = ioutil.ReadFile("non_existent_file.txt")
+	_, err = ReadFile("non_existent_file.txt")
 	if err != nil {
 		fmt.Printf("Expected error for non-existent file: %s\n", Red(err.Error()))
 	}
@@ -71,7 +77,7 @@ func writeFileExample() {
 	content1 := "Hello, this is content written using ioutil.WriteFile!"
 	fileName1 := "write_test1.txt"
 
-	err := ioutil.WriteFile(fileName1, []byte(content1), 0644)
+	err := WriteFile(fileName1, []byte(content1), 0644)
 	if err != nil {
 		fmt.Printf("Error writing file: %v\n", err)
 		return
@@ -81,7 +87,7 @@ func writeFileExample() {
 	fmt.Printf("Successfully wrote to: %s\n", Green(fileName1))
 
 	// Verify by reading back
-	readBack, err := ioutil.ReadFile(fileName1)
+	readBack, err := ReadFile(fileName1)
 	if err != nil {
 		fmt.Printf("Error reading back: %v\n", err)
 	} else {
@@ -92,14 +98,14 @@ func writeFileExample() {
 	binaryData := []byte{0x48, 0x65, 0x6C, 0x6C, 0x6F, 0x20, 0x42, 0x69, 0x6E, 0x61, 0x72, 0x79}
 	fileName2 := "binary_test.bin"
 
-	err = ioutil.WriteFile(fileName2, binaryData, 0644)
+	err = WriteFile(fileName2, binaryData, 0644)
 	if err != nil {
 		fmt.Printf("Error writing binary file: %v\n", err)
 	} else {
 		fmt.Printf("Binary file written: %s\n", Yellow(fileName2))
 
 		// Read and display binary data
-		binRead, _ := ioutil.ReadFile(fileName2)
+		binRead, _ := ReadFile(fileName2)
 		fmt.Printf("Binary content: %x\n", binRead)
 		fmt.Printf("As string: %s\n", Green(string(binRead)))
 	}
@@ -109,7 +115,7 @@ func writeFileExample() {
 	restrictedContent := "This file has restricted permissions"
 	restrictedFile := "restricted.txt"
 
-	err = ioutil.WriteFile(restrictedFile, []byte(restrictedContent), 0400) // Read-only
+	err = WriteFile(restrictedFile, []byte(restrictedContent), 0400) // Read-only
 	if err != nil {
 		fmt.Printf("Error writing restricted file: %v\n", err)
 	} else {
@@ -142,7 +148,7 @@ func readDirExample() {
 	for i, file := range files {
 		filePath := filepath.Join(testDir, file)
 		content := fmt.Sprintf("Content of %s (file %d)", file, i+1)
-		ioutil.WriteFile(filePath, []byte(content), 0644)
+		WriteFile(filePath, []byte(content), 0644)
 	}
 
 	// Create subdirectories
@@ -152,11 +158,11 @@ func readDirExample() {
 
 		// Add file to subdirectory
 		subFile := filepath.Join(testDir, subdir, "nested_file.txt")
-		ioutil.WriteFile(subFile, []byte("Nested file content"), 0644)
+		WriteFile(subFile, []byte("Nested file content"), 0644)
 	}
 
 	// Read directory contents
-	entries, err := ioutil.ReadDir(testDir)
+	entries, err := ReadDir(testDir)
 	if err != nil {
 		fmt.Printf("Error reading directory: %v\n", err)
 		return
@@ -170,16 +176,16 @@ func readDirExample() {
 			entryType = "DIR "
 		}
 
-		fmt.Printf("  [%s] %s (size: %d, mode: %s)\n",
+		fmt.Printf("  [%s] %s (size: %s, mode: %s)\n",
 			Yellow(entryType),
 			entry.Name(),
-			entry.Size(),
+			FormatBytes(entry.Size()),
 			Cyan(entry.Mode().String()))
 	}
 
 	// Read subdirectory
 	subDirPath := filepath.Join(testDir, "subdir1")
-	subEntries, err := ioutil.ReadDir(subDirPath)
+	subEntries, err := ReadDir(subDirPath)
 	if err != nil {
 		fmt.Printf("Error reading subdirectory: %v\n", err)
 	} else {
@@ -196,7 +202,7 @@ func tempFileExample() {
 	fmt.Println(Yellow("📌 TempFile Operations:"))
 
 	// Create temporary file with default temp directory
-	tempFile1, err := ioutil.TempFile("", "example_*.txt")
+	tempFile1, err := TempFile("", "example_*.txt")
 	if err != nil {
 		fmt.Printf("Error creating temp file: %v\n", err)
 		return
@@ -220,7 +226,7 @@ func tempFileExample() {
 	os.Mkdir(tempDir, 0755)
 	defer os.RemoveAll(tempDir) // Cleanup
 
-	tempFile2, err := ioutil.TempFile(tempDir, "custom_temp_*.log")
+	tempFile2, err := TempFile(tempDir, "custom_temp_*.log")
 	if err != nil {
 		fmt.Printf("Error creating custom temp file: %v\n", err)
 	} else {
@@ -248,7 +254,7 @@ func tempFileExample() {
 
 	fmt.Println(Bold("Creating multiple temp files:"))
 	for i, pattern := range patterns {
-		tf, err := ioutil.TempFile("", pattern)
+		tf, err := TempFile("", pattern)
 		if err != nil {
 			fmt.Printf("Error creating temp file %d: %v\n", i+1, err)
 			continue
@@ -267,7 +273,7 @@ func tempDirExample() {
 	fmt.Println(Yellow("📌 TempDir Operations:"))
 
 	// Create temporary directory
-	tempDir1, err := ioutil.TempDir("", "example_dir_*")
+	tempDir1, err := TempDir("", "example_dir_*")
 	if err != nil {
 		fmt.Printf("Error creating temp directory: %v\n", err)
 		return
@@ -287,7 +293,7 @@ func tempDirExample() {
 
 	for _, file := range files {
 		filePath := filepath.Join(tempDir1, file.name)
-		err := ioutil.WriteFile(filePath, []byte(file.content), 0644)
+		err := WriteFile(filePath, []byte(file.content), 0644)
 		if err != nil {
 			fmt.Printf("Error creating file %s: %v\n", file.name, err)
 		} else {
@@ -305,41 +311,22 @@ func tempDirExample() {
 
 		// Add file to nested directory
 		nestedFile := filepath.Join(nestedDir, "deep_file.txt")
-		ioutil.WriteFile(nestedFile, []byte("File in deep nested directory"), 0644)
+		WriteFile(nestedFile, []byte("File in deep nested directory"), 0644)
 	}
 
 	// List all contents recursively
 	fmt.Println(Bold("Directory contents:"))
-	err = filepath.Walk(tempDir1, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-
-		relPath, _ := filepath.Rel(tempDir1, path)
-		if relPath == "." {
-			return nil
-		}
+	PrintTree(os.Stdout, tempDir1, TreeOptions{})
 
-		indent := strings.Repeat("  ", strings.Count(relPath, string(filepath.Separator)))
-		entryType := "📄"
-		if info.IsDir() {
-			entryType = "📁"
-		}
-
-		fmt.Printf("%s%s %s\n", indent, entryType, relPath)
-		return nil
-	})
-
-	if err != nil {
-		fmt.Printf("Error walking directory: %v\n", err)
-	}
+	fmt.Println(Bold("Only .txt files, two levels deep:"))
+	PrintTree(os.Stdout, tempDir1, TreeOptions{MaxDepth: 2, Extensions: []string{".txt"}})
 
 	// Create temp directory in custom location
 	customBase := "custom_base"
 	os.Mkdir(customBase, 0755)
 	defer os.RemoveAll(customBase) // Cleanup
 
-	tempDir2, err := ioutil.TempDir(customBase, "app_temp_*")
+	tempDir2, err := TempDir(customBase, "app_temp_*")
 	if err != nil {
 		fmt.Printf("Error creating custom temp dir: %v\n", err)
 	} else {
@@ -357,7 +344,7 @@ func readAllExample() {
 	content := "This is content that will be read all at once using ioutil.ReadAll"
 	reader := strings.NewReader(content)
 
-	data, err := ioutil.ReadAll(reader)
+	data, err := ReadAll(reader)
 	if err != nil {
 		fmt.Printf("Error reading all: %v\n", err)
 		return
@@ -373,7 +360,7 @@ Line 3: More detailed explanation
 Line 4: Additional notes and references
 Line 5: Conclusion and summary`
 
-	err = ioutil.WriteFile(testFile, []byte(fileContent), 0644)
+	err = WriteFile(testFile, []byte(fileContent), 0644)
 	if err != nil {
 		fmt.Printf("Error creating test file: %v\n", err)
 		return
@@ -388,7 +375,7 @@ Line 5: Conclusion and summary`
 	}
 	defer file.Close()
 
-	fileData, err := ioutil.ReadAll(file)
+	fileData, err := ReadAll(file)
 	if err != nil {
 		fmt.Printf("Error reading all from file: %v\n", err)
 	} else {
@@ -403,7 +390,7 @@ Line 5: Conclusion and summary`
 	limitedContent := "Short content"
 	limitedReader := strings.NewReader(limitedContent)
 
-	limitedData, err := ioutil.ReadAll(limitedReader)
+	limitedData, err := ReadAll(limitedReader)
 	if err != nil {
 		fmt.Printf("Error reading limited content: %v\n", err)
 	} else {
@@ -421,13 +408,13 @@ func nopCloserExample() {
 	reader := strings.NewReader(content)
 
 	// Wrap with NopCloser to make it a ReadCloser
-	readCloser := ioutil.NopCloser(reader)
+	readCloser := NopCloser(reader)
 
 	fmt.Printf("Original reader type: %T\n", reader)
 	fmt.Printf("NopCloser type: %T\n", readCloser)
 
 	// Read from the NopCloser
-	data, err := ioutil.ReadAll(readCloser)
+	data, err := ReadAll(readCloser)
 	if err != nil {
 		fmt.Printf("Error reading from NopCloser: %v\n", err)
 	} else {
@@ -445,11 +432,11 @@ func nopCloserExample() {
 	// Practical example: HTTP response body simulation
 	responseBody := "HTTP response body content that needs to be a ReadCloser"
 	responseReader := strings.NewReader(responseBody)
-	httpBodyCloser := ioutil.NopCloser(responseReader)
+	httpBodyCloser := NopCloser(responseReader)
 
 	// Simulate reading HTTP response
 	fmt.Println(Bold("Simulated HTTP response reading:"))
-	bodyContent, err := ioutil.ReadAll(httpBodyCloser)
+	bodyContent, err := ReadAll(httpBodyCloser)
 	if err != nil {
 		fmt.Printf("Error reading response body: %v\n", err)
 	} else {
@@ -466,11 +453,11 @@ func nopCloserExample() {
 func discardExample() {
 	fmt.Println(Yellow("📌 Discard Operations:"))
 
-	// Demonstrate ioutil.Discard
+	// Demonstrate io.Discard
 	content := "This content will be discarded - it goes nowhere!"
 
 	// Write to discard
-	n, err := ioutil.Discard.Write([]byte(content))
+	n, err := io.Discard.Write([]byte(content))
 	if err != nil {
 		fmt.Printf("Error writing to discard: %v\n", err)
 	} else {
@@ -485,7 +472,7 @@ func discardExample() {
 	fmt.Printf("Original content size: %d bytes\n", len(largeContent))
 
 	// Copy all content to discard
-	discardedBytes, err := io.Copy(ioutil.Discard, reader)
+	discardedBytes, err := io.Copy(io.Discard, reader)
 	if err != nil {
 		fmt.Printf("Error copying to discard: %v\n", err)
 	} else {
@@ -493,7 +480,7 @@ func discardExample() {
 	}
 
 	// Practical example: draining a reader without storing content
-	tempFile, err := ioutil.TempFile("", "discard_test_*.txt")
+	tempFile, err := TempFile("", "discard_test_*.txt")
 	if err != nil {
 		fmt.Printf("Error creating temp file: %v\n", err)
 		return
@@ -507,7 +494,7 @@ func discardExample() {
 	tempFile.Seek(0, 0) // Reset to beginning
 
 	// Drain the file content
-	drainedBytes, err := io.Copy(ioutil.Discard, tempFile)
+	drainedBytes, err := io.Copy(io.Discard, tempFile)
 	if err != nil {
 		fmt.Printf("Error draining file: %v\n", err)
 	} else {
@@ -525,7 +512,7 @@ func discardExample() {
 
 	totalDiscarded := 0
 	for i, msg := range messages {
-		n, err := ioutil.Discard.Write([]byte(msg))
+		n, err := io.Discard.Write([]byte(msg))
 		if err != nil {
 			fmt.Printf("Error in write %d: %v\n", i+1, err)
 		} else {
@@ -0,0 +1,86 @@
+// json_array_writer.go
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrArrayWriterClosed is returned by writes to a closed JSONArrayWriter
+var ErrArrayWriterClosed = errors.New("json array writer is closed")
+
+// JSONArrayWriter streams a JSON array to w one element at a time, so a
+// large result set never has to be held in memory. Every element goes
+// straight to w, so a slow destination slows the producer down instead of
+// letting output pile up. It is not safe for concurrent use.
+type JSONArrayWriter struct {
+	w      io.Writer
+	opened bool
+	closed bool
+	count  int
+}
+
+// NewJSONArrayWriter returns a writer emitting an array to w
+func NewJSONArrayWriter(w io.Writer) *JSONArrayWriter {
+	return &JSONArrayWriter{w: w}
+}
+
+// Open writes the opening bracket. Write calls it automatically, so it is
+// only needed to start the array before the first element is ready.
+func (a *JSONArrayWriter) Open() error {
+	if a.closed {
+		return ErrArrayWriterClosed
+	}
+	if a.opened {
+		return nil
+	}
+	if _, err := io.WriteString(a.w, "["); err != nil {
+		return err
+	}
+	a.opened = true
+	return nil
+}
+
+// Write encodes v as the next array element. A value that fails to
+// encode is reported without writing anything, leaving the array valid.
+func (a *JSONArrayWriter) Write(v interface{}) error {
+	if a.closed {
+		return ErrArrayWriterClosed
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encode element %d: %w", a.count, err)
+	}
+	if err := a.Open(); err != nil {
+		return err
+	}
+	if a.count > 0 {
+		data = append([]byte{','}, data...)
+	}
+	if _, err := a.w.Write(data); err != nil {
+		return err
+	}
+	a.count++
+	return nil
+}
+
+// Count reports how many elements have been written
+func (a *JSONArrayWriter) Count() int {
+	return a.count
+}
+
+// Close writes the closing bracket, emitting "[]" if nothing was written.
+// Closing twice is a no-op; it does not close the underlying writer.
+func (a *JSONArrayWriter) Close() error {
+	if a.closed {
+		return nil
+	}
+	if err := a.Open(); err != nil {
+		return err
+	}
+	a.closed = true
+	_, err := io.WriteString(a.w, "]")
+	return err
+}
@@ -0,0 +1,69 @@
+// json_array_writer_test.go
+package internal
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+type arrayItem struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestJSONArrayWriterRoundTrip(t *testing.T) {
+	var b strings.Builder
+	a := NewJSONArrayWriter(&b)
+	testutil.AssertNoError(t, a.Open())
+	testutil.AssertEqual(t, b.String(), "[") // the bracket goes out before any element
+
+	for i := 1; i <= 3; i++ {
+		testutil.AssertNoError(t, a.Write(arrayItem{ID: i, Name: strings.Repeat("x", i)}))
+	}
+	testutil.AssertNoError(t, a.Close())
+	testutil.AssertEqual(t, a.Count(), 3)
+
+	var decoded []arrayItem
+	testutil.AssertNoError(t, json.Unmarshal([]byte(b.String()), &decoded))
+	testutil.AssertEqual(t, len(decoded), 3)
+	for i, item := range decoded {
+		testutil.AssertEqual(t, item, arrayItem{ID: i + 1, Name: strings.Repeat("x", i+1)})
+	}
+}
+
+func TestJSONArrayWriterEmpty(t *testing.T) {
+	var b strings.Builder
+	a := NewJSONArrayWriter(&b)
+	testutil.AssertNoError(t, a.Close())
+	testutil.AssertNoError(t, a.Close())
+	testutil.AssertEqual(t, b.String(), "[]")
+
+	var decoded []arrayItem
+	testutil.AssertNoError(t, json.Unmarshal([]byte(b.String()), &decoded))
+	testutil.AssertEqual(t, len(decoded), 0)
+}
+
+func TestJSONArrayWriterAfterClose(t *testing.T) {
+	var b strings.Builder
+	a := NewJSONArrayWriter(&b)
+	a.Write(1)
+	a.Close()
+	testutil.AssertErrorIs(t, a.Write(2), ErrArrayWriterClosed)
+	testutil.AssertErrorIs(t, a.Open(), ErrArrayWriterClosed)
+	testutil.AssertEqual(t, b.String(), "[1]")
+}
+
+func TestJSONArrayWriterEncodeErrorKeepsArrayValid(t *testing.T) {
+	var b strings.Builder
+	a := NewJSONArrayWriter(&b)
+	a.Write("ok")
+	err := a.Write(make(chan int))
+	testutil.AssertContains(t, err.Error(), "encode element 1: ")
+	a.Write("also ok")
+	a.Close()
+	testutil.AssertEqual(t, b.String(), `["ok","also ok"]`)
+	testutil.AssertEqual(t, json.Valid([]byte(b.String())), true)
+}
@@ -0,0 +1,122 @@
+// json_canonical.go
+package internal
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+)
+
+// CanonicalJSON rewrites data so that semantically equal documents come
+// out byte-for-byte identical: object keys are sorted recursively, all
+// insignificant whitespace is dropped and numbers are normalized (1.0,
+// 1e0 and 1 all become 1). The result is suitable as a cache key or a
+// signing input.
+func CanonicalJSON(data []byte) ([]byte, error) {
+	value, err := decodeJSONValue(data)
+	if err != nil {
+		return nil, fmt.Errorf("decode JSON: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := writeCanonical(&buf, value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// JSONFingerprint returns the hex SHA-256 of the canonical form of data
+func JSONFingerprint(data []byte) (string, error) {
+	canonical, err := CanonicalJSON(data)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func writeCanonical(buf *bytes.Buffer, value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case json.Number:
+		n, err := canonicalNumber(v)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(n)
+	case string:
+		writeCanonicalString(buf, v)
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, elem := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeCanonical(buf, elem); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeCanonicalString(buf, key)
+			buf.WriteByte(':')
+			if err := writeCanonical(buf, v[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		return fmt.Errorf("unexpected JSON value of type %T", value)
+	}
+	return nil
+}
+
+// writeCanonicalString quotes s without HTML escaping, so "<" stays "<"
+// whatever the source document used
+func writeCanonicalString(buf *bytes.Buffer, s string) {
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)               // encoding a string cannot fail
+	buf.Truncate(buf.Len() - 1) // drop the newline Encode appends
+}
+
+// canonicalNumber normalizes a JSON number. Integers written without a
+// fraction or exponent keep full precision however large they are;
+// anything else goes through float64 and comes out in its shortest form,
+// so 1.50, 15e-1 and 1.5 agree. Negative zero becomes 0.
+func canonicalNumber(n json.Number) (string, error) {
+	if i, ok := new(big.Int).SetString(n.String(), 10); ok {
+		return i.String(), nil
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return "", fmt.Errorf("number %s: %w", n, err)
+	}
+	if f == 0 {
+		return "0", nil
+	}
+	if f == math.Trunc(f) && math.Abs(f) < 1e21 {
+		// Integral values print as integers, matching the exact branch above
+		i, _ := big.NewFloat(f).Int(nil)
+		return i.String(), nil
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64), nil
+}
@@ -0,0 +1,48 @@
+// json_canonical_test.go
+package internal
+
+import (
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{`{"b": 1, "a": {"d": [1.0, 1e0, 10E-1], "c": null}}`, `{"a":{"c":null,"d":[1,1,1]},"b":1}`},
+		{`"<tag> & é"`, `"<tag> & é"`},
+		{`12345678901234567890123`, `12345678901234567890123`},
+		{` [ true , false ] `, `[true,false]`},
+		{`2.5e-3`, `0.0025`},
+	}
+	for _, tt := range tests {
+		got, err := CanonicalJSON([]byte(tt.in))
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, string(got), tt.want)
+	}
+}
+
+func TestJSONFingerprintIgnoresFormatting(t *testing.T) {
+	a, err := JSONFingerprint([]byte(`{"id": 1, "tags": ["x", "y"]}`))
+	testutil.AssertNoError(t, err)
+	b, err := JSONFingerprint([]byte("{\n  \"tags\": [\"x\",\"y\"],\n  \"id\": 1.0\n}"))
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, a, b)
+	testutil.AssertEqual(t, len(a), 64)
+
+	c, err := JSONFingerprint([]byte(`{"id": 2, "tags": ["x", "y"]}`))
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, a == c, false)
+}
+
+func TestCanonicalJSONRejectsTrailingData(t *testing.T) {
+	for _, in := range []string{`{"a":1}}`, `[1]]`, `1 2`, `{"a":1} {"b":2}`, `{"a":1`} {
+		if _, err := CanonicalJSON([]byte(in)); err == nil {
+			t.Errorf("CanonicalJSON(%s) accepted malformed input", in)
+		}
+	}
+	_, err := CanonicalJSON([]byte("{\"a\":1}\n\t "))
+	testutil.AssertNoError(t, err)
+}
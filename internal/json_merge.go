@@ -0,0 +1,66 @@
+// json_merge.go
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// MergeJSON applies patch to base following RFC 7386 JSON Merge Patch:
+// objects merge key by key, recursively; a null in the patch deletes the
+// key; arrays, scalars and anything that is not an object replace the
+// base value outright. Numbers keep their exact text, and object keys come
+// out sorted.
+func MergeJSON(base, patch []byte) ([]byte, error) {
+	baseValue, err := decodeJSONValue(base)
+	if err != nil {
+		return nil, fmt.Errorf("decode base: %w", err)
+	}
+	patchValue, err := decodeJSONValue(patch)
+	if err != nil {
+		return nil, fmt.Errorf("decode patch: %w", err)
+	}
+	return json.Marshal(mergePatch(baseValue, patchValue))
+}
+
+// decodeJSONValue decodes a single JSON document, keeping numbers as
+// json.Number so large integers survive the round trip
+func decodeJSONValue(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	// More would accept a stray "}" or "]"; only a clean EOF ends the input
+	if tok, err := dec.Token(); err != io.EOF {
+		if err != nil {
+			return nil, fmt.Errorf("unexpected data after JSON value: %w", err)
+		}
+		return nil, fmt.Errorf("unexpected data after JSON value: %v", tok)
+	}
+	return v, nil
+}
+
+// mergePatch is the MergePatch(Target, Patch) function from RFC 7386
+func mergePatch(target, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = make(map[string]interface{})
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergePatch(targetObject[key], value)
+	}
+	return targetObject
+}
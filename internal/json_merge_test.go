@@ -0,0 +1,43 @@
+// json_merge_test.go
+package internal
+
+import (
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func assertMerge(t *testing.T, base, patch, want string) {
+	t.Helper()
+	got, err := MergeJSON([]byte(base), []byte(patch))
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, string(got), want)
+}
+
+func TestMergeJSONNestedObjects(t *testing.T) {
+	assertMerge(t,
+		`{"server":{"host":"localhost","port":8080,"tls":{"enabled":false}},"name":"app"}`,
+		`{"server":{"port":9090,"tls":{"cert":"c.pem"}}}`,
+		`{"name":"app","server":{"host":"localhost","port":9090,"tls":{"cert":"c.pem","enabled":false}}}`)
+}
+
+func TestMergeJSONReplacesArraysAndScalars(t *testing.T) {
+	assertMerge(t, `{"tags":["a","b","c"],"debug":false}`, `{"tags":["z"],"debug":true}`, `{"debug":true,"tags":["z"]}`)
+	assertMerge(t, `{"limit":{"max":1}}`, `{"limit":5}`, `{"limit":5}`)         // a scalar replaces an object
+	assertMerge(t, `{"limit":5}`, `{"limit":{"max":1}}`, `{"limit":{"max":1}}`) // and vice versa
+	assertMerge(t, `{"a":1}`, `["whole"]`, `["whole"]`)                         // a non-object patch replaces everything
+	assertMerge(t, `{"big":1}`, `{"big":12345678901234567890}`, `{"big":12345678901234567890}`)
+}
+
+func TestMergeJSONNullDeletes(t *testing.T) {
+	assertMerge(t, `{"a":1,"b":{"c":2,"d":3}}`, `{"a":null,"b":{"d":null}}`, `{"b":{"c":2}}`)
+	assertMerge(t, `{"a":1}`, `{"missing":null}`, `{"a":1}`)
+	assertMerge(t, `[1,2]`, `{"x":{"y":null,"z":1}}`, `{"x":{"z":1}}`) // nulls never survive into new objects
+}
+
+func TestMergeJSONInvalidInput(t *testing.T) {
+	_, err := MergeJSON([]byte(`{"a":`), []byte(`{}`))
+	testutil.AssertContains(t, err.Error(), "decode base: ")
+	_, err = MergeJSON([]byte(`{}`), []byte(`{} extra`))
+	testutil.AssertContains(t, err.Error(), "decode patch: unexpected data after JSON value")
+}
@@ -2,10 +2,20 @@
 package internal
 
 import (
+	"bytes"
+	"context"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -140,16 +150,33 @@ func (ct *CustomTime) UnmarshalJSON(data []byte) error {
 
 // RunJSONSerializationExamples - main function to run all JSON serialization examples
 func RunJSONSerializationExamples() {
-	basicMarshalingExample()
-	structTagsExample()
-	customMarshalingExample()
-	nestedStructExample()
-	arraySliceExample()
-	mapExample()
-	customTimeExample()
-	jsonStreamingExample()
-	errorHandlingExample()
-	configFileExample()
+	for _, example := range jsonExamples {
+		example.Run()
+	}
+}
+
+// jsonExamples lists the JSON sub-examples in run order, by CLI name
+var jsonExamples = []NamedExample{
+	{"basic-marshaling", basicMarshalingExample},
+	{"struct-tags", structTagsExample},
+	{"custom-marshaling", customMarshalingExample},
+	{"nested-structs", nestedStructExample},
+	{"arrays-slices", arraySliceExample},
+	{"maps", mapExample},
+	{"custom-time", customTimeExample},
+	{"streaming", jsonStreamingExample},
+	{"array-writer", jsonArrayWriterExample},
+	{"error-handling", errorHandlingExample},
+	{"config-file", configFileExample},
+	{"merge-patch", mergePatchExample},
+	{"canonical", canonicalJSONExample},
+	{"masking", maskFieldsExample},
+	{"flatten-csv", flattenJSONToCSVExample},
+	{"ini-config", iniConfigExample},
+	{"json-lines", jsonLinesExample},
+	{"aggregate-logs", aggregateLogsExample},
+	{"http-post", httpPostJSONExample},
+	{"watch-config", watchConfigExample},
 }
 
 // Basic marshaling and unmarshaling
@@ -372,6 +399,39 @@ func mapExample() {
 
 	fmt.Println(Bold("Map marshaling:"))
 	printJSON(response)
+
+	// Decoding into interface{} turns every number into float64
+	data, err := json.Marshal(response.Data)
+	if err != nil {
+		log.Printf("Error marshaling data: %v", err)
+		return
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		log.Printf("Error unmarshaling data: %v", err)
+		return
+	}
+
+	fmt.Println(Bold("Coercing decoded values:"))
+	fmt.Printf("user_id arrives as %T\n", decoded["user_id"])
+	if id, ok := AsInt(decoded["user_id"]); ok {
+		fmt.Printf("AsInt(user_id) = %d\n", id)
+	}
+	if balance, ok := AsFloat(decoded["balance"]); ok {
+		fmt.Printf("AsFloat(balance) = %.2f\n", balance)
+	}
+	if name, ok := AsString(decoded["username"]); ok {
+		fmt.Printf("AsString(username) = %s\n", name)
+	}
+	if active, ok := AsBool(decoded["active"]); ok {
+		fmt.Printf("AsBool(active) = %t\n", active)
+	}
+	if _, ok := AsInt(decoded["balance"]); !ok {
+		fmt.Println("AsInt(balance) refused: 1250.5 has a fractional part")
+	}
+	if n, ok := AsInt("42"); ok {
+		fmt.Printf("AsInt(\"42\") = %d\n", n)
+	}
 }
 
 // Custom time example
@@ -450,6 +510,47 @@ func jsonStreamingExample() {
 	fmt.Println()
 }
 
+// Streaming a JSON array element by element
+func jsonArrayWriterExample() {
+	fmt.Println(Subtitle("🧮 Streaming JSON Array Writer"))
+
+	type row struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+
+	var buf bytes.Buffer
+	aw := NewJSONArrayWriter(&buf)
+	for i := 1; i <= 3; i++ {
+		if err := aw.Write(row{ID: i, Name: fmt.Sprintf("User %d", i)}); err != nil {
+			log.Printf("Error writing element: %v", err)
+			return
+		}
+	}
+	if err := aw.Close(); err != nil {
+		log.Printf("Error closing array: %v", err)
+		return
+	}
+	fmt.Printf("Streamed %d elements:\n%s\n", aw.Count(), buf.String())
+
+	var users []row
+	if err := json.Unmarshal(buf.Bytes(), &users); err != nil {
+		log.Printf("Error decoding streamed array: %v", err)
+		return
+	}
+	fmt.Printf("Decoded back: %d users, first %q, last %q\n", len(users), users[0].Name, users[len(users)-1].Name)
+
+	var empty bytes.Buffer
+	emptyWriter := NewJSONArrayWriter(&empty)
+	emptyWriter.Close()
+	fmt.Printf("No elements: %s\n", empty.String())
+
+	if err := emptyWriter.Write("late"); err != nil {
+		fmt.Printf("Write after Close: %s\n", ErrorText(err.Error()))
+	}
+	fmt.Println()
+}
+
 // Error handling example
 func errorHandlingExample() {
 	fmt.Println(Subtitle("🚨 Error Handling"))
@@ -481,56 +582,71 @@ func errorHandlingExample() {
 	_, err = json.Marshal(a)
 	if err != nil {
 		fmt.Printf("Marshal error (circular reference): %v\n", err)
+		fmt.Printf("SafeSprint still renders it: %s\n", SafeSprint(a))
 	}
 	fmt.Println()
 }
 
+// sampleConfigJSON simulates the contents of a config file
+const sampleConfigJSON = `{
+	"app_name": "WebService",
+	"version": "2.1.0",
+	"debug": false,
+	"database": {
+		"host": "db.example.com",
+		"port": 5432,
+		"username": "webapp",
+		"ssl": true
+	},
+	"features": {
+		"authentication": true,
+		"logging": true,
+		"metrics": true,
+		"caching": false
+	},
+	"servers": [
+		{
+			"name": "primary",
+			"host": "web1.example.com",
+			"port": 80,
+			"weight": 100
+		},
+		{
+			"name": "secondary",
+			"host": "web2.example.com",
+			"port": 80,
+			"weight": 50
+		}
+	],
+	"metadata": {
+		"environment": "staging",
+		"region": "eu-west-1",
+		"deployment_id": "dep-123456"
+	}
+}`
+
+// SampleConfig parses the sample configuration used by the config examples
+func SampleConfig() (JSONConfig, error) {
+	var config JSONConfig
+	if err := json.Unmarshal([]byte(sampleConfigJSON), &config); err != nil {
+		return JSONConfig{}, fmt.Errorf("parse sample config: %w", err)
+	}
+	return config, nil
+}
+
 // Config file example
 func configFileExample() {
 	fmt.Println(Subtitle("⚙️ Configuration File Example"))
 
-	// Simulate reading a config file
-	configJSON := `{
-		"app_name": "WebService",
-		"version": "2.1.0",
-		"debug": false,
-		"database": {
-			"host": "db.example.com",
-			"port": 5432,
-			"username": "webapp",
-			"ssl": true
-		},
-		"features": {
-			"authentication": true,
-			"logging": true,
-			"metrics": true,
-			"caching": false
-		},
-		"servers": [
-			{
-				"name": "primary",
-				"host": "web1.example.com",
-				"port": 80,
-				"weight": 100
-			},
-			{
-				"name": "secondary",
-				"host": "web2.example.com",
-				"port": 80,
-				"weight": 50
-			}
-		],
-		"metadata": {
-			"environment": "staging",
-			"region": "eu-west-1",
-			"deployment_id": "dep-123456"
-		}
-	}`
-
+	// Must turns each error check into a panic; Try is the boundary that
+	// converts such a panic back into an ordinary error
 	var config JSONConfig
-	err := json.Unmarshal([]byte(configJSON), &config)
-	if err != nil {
-		log.Printf("Error parsing config: %v", err)
+	if err := Try(func() error {
+		config = Must(SampleConfig())
+		Check(config.Validate())
+		return nil
+	}); err != nil {
+		log.Printf("Error loading config: %v", err)
 		return
 	}
 
@@ -551,11 +667,364 @@ func configFileExample() {
 	// Pretty print the entire config
 	fmt.Println(Bold("Full configuration:"))
 	printJSON(config)
+
+	// A broken config panics inside Try and comes back as an error
+	err := Try(func() error {
+		broken := config
+		broken.AppName = ""
+		Check(broken.Validate())
+		return nil
+	})
+	fmt.Printf("Invalid config recovered by Try: %s\n", ErrorText(err.Error()))
+}
+
+// Overlaying environment-specific settings with a JSON merge patch
+func mergePatchExample() {
+	fmt.Println(Subtitle("🩹 JSON Merge Patch Example"))
+
+	base := []byte(`{"app_name":"WebService","debug":true,"database":{"host":"localhost","port":5432,"ssl":false},"servers":[{"host":"dev1"}],"features":{"beta":true}}`)
+	production := []byte(`{"debug":false,"database":{"host":"db.internal","ssl":true},"servers":[{"host":"web1"},{"host":"web2"}],"features":null}`)
+
+	merged, err := MergeJSON(base, production)
+	if err != nil {
+		log.Printf("Error merging config: %v", err)
+		return
+	}
+
+	fmt.Println("Overrides: nested database keys merge, servers array is replaced, features is deleted")
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, merged, "", "  "); err != nil {
+		log.Printf("Error formatting merged config: %v", err)
+		return
+	}
+	fmt.Println(pretty.String())
+
+	if _, err := MergeJSON(base, []byte(`{"debug":`)); err != nil {
+		fmt.Printf("Broken patch: %s\n", ErrorText(err.Error()))
+	}
+	fmt.Println()
+}
+
+// canonicalJSONExample fingerprints documents that differ only in layout
+func canonicalJSONExample() {
+	fmt.Println(Subtitle("🔏 Canonical JSON Example"))
+
+	documents := []string{
+		`{"name":"Alice","roles":["admin","dev"],"limits":{"cpu":2,"memory":512}}`,
+		`{
+  "limits": {"memory": 5.12e2, "cpu": 2.0},
+  "roles":  ["admin", "dev"],
+  "name":   "Alice"
+}`,
+		`{"name":"Alice","roles":["dev","admin"],"limits":{"cpu":2,"memory":512}}`,
+	}
+
+	canonical, err := CanonicalJSON([]byte(documents[1]))
+	if err != nil {
+		log.Printf("Error canonicalizing JSON: %v", err)
+		return
+	}
+	fmt.Printf("Canonical form: %s\n", canonical)
+
+	for i, doc := range documents {
+		fingerprint, err := JSONFingerprint([]byte(doc))
+		if err != nil {
+			log.Printf("Error fingerprinting document %d: %v", i+1, err)
+			continue
+		}
+		fmt.Printf("Document %d fingerprint: %s\n", i+1, fingerprint[:16])
+	}
+	fmt.Println("Documents 1 and 2 match; 3 differs because array order is significant")
+	fmt.Println()
+}
+
+// supportTicket mixes public fields with personal and secret ones
+type supportTicket struct {
+	ID       int           `json:"id"`
+	Subject  string        `json:"subject"`
+	Customer ticketContact `json:"customer"`
+	Agent    string        `json:"agent,omitempty"`
+	APIKey   string        `json:"-"`
+	Opened   time.Time     `json:"opened"`
+}
+
+type ticketContact struct {
+	Name  string `json:"name"`
+	Email string `json:"email" mask:"pii"`
+	Phone string `json:"phone" mask:"pii"`
+	Card  string `json:"card_number" mask:"true"`
+}
+
+// maskFieldsExample hides sensitive values while keeping their keys
+func maskFieldsExample() {
+	fmt.Println(Subtitle("🎭 Field Masking Example"))
+
+	ticket := supportTicket{
+		ID:      1042,
+		Subject: "Refund not received",
+		Customer: ticketContact{
+			Name:  "Dana Reyes",
+			Email: "dana@example.com",
+			Phone: "+1-555-0142",
+			Card:  "4111 1111 1111 1111",
+		},
+		APIKey: "sk_live_123",
+		Opened: time.Date(2024, 3, 9, 14, 30, 0, 0, time.UTC),
+	}
+
+	for _, view := range []struct {
+		label string
+		tag   string
+	}{{"Masking secrets only", ""}, {`Masking secrets and "pii"`, "pii"}} {
+		masked, err := MaskFields(ticket, view.tag)
+		if err != nil {
+			log.Printf("Error masking ticket: %v", err)
+			return
+		}
+		data, err := json.Marshal(masked)
+		if err != nil {
+			log.Printf("Error encoding masked ticket: %v", err)
+			return
+		}
+		fmt.Printf("%s:\n  %s\n", Bold(view.label), data)
+	}
+
+	if _, err := MaskFields("not a struct", ""); err != nil {
+		fmt.Printf("Bad input: %s\n", ErrorText(err.Error()))
+	}
+	fmt.Println()
+}
+
+// flattenJSONToCSVExample turns nested, uneven JSON records into a CSV
+func flattenJSONToCSVExample() {
+	fmt.Println(Subtitle("🧮 JSON to CSV Flattening Example"))
+
+	input := `[
+  {"id": 1, "name": "Alice", "profile": {"bio": "Go developer", "links": {"github": "alice"}}, "tags": ["go", "k8s"]},
+  {"id": 2, "name": "Bob", "email": "bob@example.com", "active": true},
+  {"id": 3, "name": "Carol", "profile": {"bio": null}, "tags": ["sql"]}
+]`
+
+	var out bytes.Buffer
+	if err := FlattenJSONToCSV(strings.NewReader(input), &out); err != nil {
+		log.Printf("Error flattening JSON: %v", err)
+		return
+	}
+	fmt.Print(out.String())
+
+	records, err := csv.NewReader(bytes.NewReader(out.Bytes())).ReadAll()
+	if err != nil {
+		log.Printf("Error reading flattened CSV: %v", err)
+		return
+	}
+	RenderCSVTable(os.Stdout, records, TableOptions{MaxWidth: -1})
+
+	if err := FlattenJSONToCSV(strings.NewReader(`{"id": 1}`), io.Discard); err != nil {
+		fmt.Printf("Not an array: %s\n", ErrorText(err.Error()))
+	}
+	fmt.Println()
+}
+
+// JSON Lines: one record per line, appended incrementally
+func jsonLinesExample() {
+	fmt.Println(Subtitle("📜 JSON Lines Example"))
+
+	path := "users_example.jsonl"
+	defer os.Remove(path)
+
+	users := []JSONUser{
+		{ID: 1, Name: "Alice", Email: "alice@example.com", IsActive: true},
+		{ID: 2, Name: "Bob", Email: "bob@example.com", Age: 30},
+		{ID: 3, Name: "Carol", Email: "carol@example.com", IsActive: true},
+	}
+
+	// Appends from several goroutines never interleave within a line.
+	// AppendJSONL's own mutex only covers this process; the file lock also
+	// keeps out other processes appending to the same file.
+	var wg sync.WaitGroup
+	for _, user := range users {
+		wg.Add(1)
+		go func(u JSONUser) {
+			defer wg.Done()
+			err := WithFileLock(path, func() error {
+				return AppendJSONL(path, u)
+			})
+			if err != nil {
+				log.Printf("Error appending record: %v", err)
+			}
+		}(user)
+	}
+	wg.Wait()
+
+	loaded, err := ReadJSONL[JSONUser](path)
+	if err != nil {
+		log.Printf("Error reading JSON lines: %v", err)
+		return
+	}
+
+	fmt.Printf("Read %d typed records back:\n", len(loaded))
+	for _, u := range loaded {
+		fmt.Printf("  #%d %s <%s>\n", u.ID, u.Name, u.Email)
+	}
+	fmt.Println()
+}
+
+// The same kind of settings in INI form, parsed without encoding/json
+func iniConfigExample() {
+	fmt.Println(Subtitle("📄 INI Configuration Example"))
+
+	configINI := `; WebService settings
+app_name = WebService
+version = 2.1.0
+
+[database]
+host = db.example.com   ; primary replica
+port = 5432
+dsn  = "postgres://webapp@db.example.com/app?sslmode=require"
+
+[features]
+# comma separated, wrapped for readability
+enabled = authentication, logging, \
+          metrics
+`
+	config, err := ParseINI(strings.NewReader(configINI))
+	if err != nil {
+		log.Printf("Error parsing INI: %v", err)
+		return
+	}
+
+	sections := make([]string, 0, len(config))
+	for section := range config {
+		sections = append(sections, section)
+	}
+	sort.Strings(sections)
+	for _, section := range sections {
+		if section == "" {
+			fmt.Println("(top level)")
+		} else {
+			fmt.Printf("[%s]\n", section)
+		}
+		keys := make([]string, 0, len(config[section]))
+		for key := range config[section] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Printf("  %s = %q\n", key, config[section][key])
+		}
+	}
+
+	if _, err := ParseINI(strings.NewReader("[database]\nhost = db\nport 5432\n")); err != nil {
+		fmt.Printf("Malformed input: %s\n", ErrorText(err.Error()))
+	}
+	fmt.Println()
+}
+
+// Summing a field per group while streaming NDJSON records
+func aggregateLogsExample() {
+	fmt.Println(Subtitle("📊 Streaming Log Aggregation Example"))
+
+	logs := `{"service":"api","latency_ms":120,"status":200}
+{"service":"auth","latency_ms":45,"status":200}
+{"service":"api","latency_ms":80,"status":500}
+{"service":"billing","latency_ms":300,"status":200}
+{"service":"auth","latency_ms":15,"status":401}
+`
+	totals, err := AggregateLogs(strings.NewReader(logs), "service", "latency_ms")
+	if err != nil {
+		log.Printf("Error aggregating logs: %v", err)
+		return
+	}
+	fmt.Println("Total latency per service:")
+	services := make([]string, 0, len(totals))
+	for service := range totals {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	for _, service := range services {
+		fmt.Printf("  %-8s %6.0fms\n", service, totals[service])
+	}
+
+	broken := logs + `{"service":"api","latency_ms":}` + "\n"
+	if _, err := AggregateLogs(strings.NewReader(broken), "service", "latency_ms"); err != nil {
+		fmt.Printf("Malformed input: %s\n", ErrorText(err.Error()))
+	}
+	fmt.Println()
+}
+
+// POSTing JSON to an HTTP endpoint and decoding the reply
+func httpPostJSONExample() {
+	fmt.Println(Subtitle("🌐 HTTP JSON Client Example"))
+
+	// A local endpoint that validates and echoes users
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var user JSONUser
+		if err := json.NewDecoder(r.Body).Decode(&user); err != nil || user.Email == "" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error": "email is required"}`)
+			return
+		}
+		user.ID = 42
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(user)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	var created JSONUser
+	status, err := PostJSON(ctx, server.Client(), server.URL, JSONUser{Name: "Dana", Email: "dana@example.com"}, &created)
+	if err != nil {
+		log.Printf("Error posting user: %v", err)
+		return
+	}
+	fmt.Printf("Status %d, created user #%d %s <%s>\n", status, created.ID, created.Name, created.Email)
+
+	status, err = PostJSON(ctx, server.Client(), server.URL, JSONUser{Name: "NoEmail"}, &created)
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		fmt.Printf("Status %d, server said: %s\n", status, httpErr.Message)
+	}
+	fmt.Println()
+}
+
+// Reloading a config file when it changes on disk
+func watchConfigExample() {
+	fmt.Println(Subtitle("👀 Config Reload Example"))
+
+	path := "watched_config.json"
+	defer os.Remove(path)
+	os.WriteFile(path, []byte(`{"app_name": "WebService", "version": "1.0.0"}`), 0644)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		WatchConfig(ctx, path, func(config *JSONConfig) {
+			fmt.Printf("Config reloaded: %s v%s\n", config.AppName, config.Version)
+		})
+	}()
+
+	// A valid update is picked up; an invalid one is logged and ignored
+	time.Sleep(100 * time.Millisecond)
+	fmt.Println("Writing version 1.1.0...")
+	os.WriteFile(path, []byte(`{"app_name": "WebService", "version": "1.1.0"}`), 0644)
+	time.Sleep(configPollInterval + 200*time.Millisecond)
+
+	fmt.Println("Writing a config with no version...")
+	os.WriteFile(path, []byte(`{"app_name": "WebService"}`), 0644)
+	time.Sleep(configPollInterval + 200*time.Millisecond)
+
+	cancel()
+	<-done
+	fmt.Println()
 }
 
 // Helper function to print JSON with proper formatting
 func printJSON(v interface{}) {
-	jsonData, err := json.MarshalIndent(v, "", "  ")
+	jsonData, err := marshalForDisplay(v, jsonOptions)
 	if err != nil {
 		log.Printf("Error marshaling: %v", err)
 		return
@@ -563,6 +1032,38 @@ func printJSON(v interface{}) {
 	fmt.Printf("%s\n\n", string(jsonData))
 }
 
+// JSONOptions controls how the JSON examples print their output
+type JSONOptions struct {
+	Indent   int  // spaces per nesting level
+	SortKeys bool // sort struct fields by key, not just map keys
+}
+
+var jsonOptions = JSONOptions{Indent: 2}
+
+// SetJSONOptions changes how the JSON examples print values
+func SetJSONOptions(opts JSONOptions) {
+	jsonOptions = opts
+}
+
+// marshalForDisplay indents v per opts. Sorting struct fields works by
+// round-tripping through generic maps, which encoding/json always orders.
+func marshalForDisplay(v interface{}, opts JSONOptions) ([]byte, error) {
+	if opts.SortKeys {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber() // keep numbers exactly as marshaled
+		var generic interface{}
+		if err := decoder.Decode(&generic); err != nil {
+			return nil, err
+		}
+		v = generic
+	}
+	return json.MarshalIndent(v, "", strings.Repeat(" ", opts.Indent))
+}
+
 // Place these at the top level, outside any function
 
 type CircularA struct {
@@ -0,0 +1,122 @@
+// json_to_csv.go
+package internal
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FlattenJSONToCSV reads a JSON array of objects from r and writes it to w
+// as CSV. Nested objects and arrays are flattened into dotted column names
+// ("profile.bio", "tags.0"); the header is the sorted union of every
+// object's columns and cells for keys an object lacks are left empty, as
+// are nulls. Keys that contain dots can make two different paths flatten
+// to the same column ({"a.b": 1} and {"a": {"b": 2}} both give "a.b");
+// that is reported as an error rather than silently merging them. The
+// array is decoded one element at a time and only the flattened rows are
+// kept, since the header cannot be written until the last object has
+// been seen.
+func FlattenJSONToCSV(r io.Reader, w io.Writer) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	if tok, err := dec.Token(); err != nil {
+		return fmt.Errorf("read JSON: %w", err)
+	} else if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected a JSON array, got %v", tok)
+	}
+
+	columns := make(map[string]string) // column -> %q of the key path that produced it
+	var rows []map[string]string
+	for index := 0; dec.More(); index++ {
+		var element interface{}
+		if err := dec.Decode(&element); err != nil {
+			return fmt.Errorf("element %d: %w", index, err)
+		}
+		object, ok := element.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("element %d: expected an object, got %T", index, element)
+		}
+		row := make(map[string]string)
+		if err := flattenJSON(nil, object, row, columns); err != nil {
+			return fmt.Errorf("element %d: %w", index, err)
+		}
+		rows = append(rows, row)
+	}
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("read JSON: %w", err)
+	}
+
+	header := make([]string, 0, len(columns))
+	for column := range columns {
+		header = append(header, column)
+	}
+	sort.Strings(header)
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	record := make([]string, len(header))
+	for _, row := range rows {
+		for i, column := range header {
+			record[i] = row[column]
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// flattenJSON stores value in row under the column for keys, recursing
+// into objects and arrays. An empty object or array still gets a column,
+// holding "". columns records which key path owns each column, so that a
+// second, different path flattening to the same name is an error.
+func flattenJSON(keys []string, value interface{}, row map[string]string, columns map[string]string) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 && len(keys) > 0 {
+			return setFlatCell(keys, "", row, columns)
+		}
+		for key, child := range v {
+			if err := flattenJSON(append(keys[:len(keys):len(keys)], key), child, row, columns); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			return setFlatCell(keys, "", row, columns)
+		}
+		for i, child := range v {
+			if err := flattenJSON(append(keys[:len(keys):len(keys)], strconv.Itoa(i)), child, row, columns); err != nil {
+				return err
+			}
+		}
+	case nil:
+		return setFlatCell(keys, "", row, columns)
+	case string:
+		return setFlatCell(keys, v, row, columns)
+	case json.Number:
+		return setFlatCell(keys, v.String(), row, columns)
+	case bool:
+		return setFlatCell(keys, strconv.FormatBool(v), row, columns)
+	}
+	return nil
+}
+
+func setFlatCell(keys []string, cell string, row map[string]string, columns map[string]string) error {
+	column := strings.Join(keys, ".")
+	source := fmt.Sprintf("%q", keys)
+	if owner, ok := columns[column]; ok && owner != source {
+		return fmt.Errorf("keys %s and %s both flatten to column %q", owner, source, column)
+	}
+	columns[column] = source
+	row[column] = cell
+	return nil
+}
@@ -0,0 +1,63 @@
+// json_to_csv_test.go
+package internal
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func flattenString(t *testing.T, in string) (string, error) {
+	t.Helper()
+	var out bytes.Buffer
+	err := FlattenJSONToCSV(strings.NewReader(in), &out)
+	return out.String(), err
+}
+
+func TestFlattenJSONToCSV(t *testing.T) {
+	in := `[
+		{"id": 1, "name": "Alice", "profile": {"bio": "hi, there"}, "tags": ["a", "b"]},
+		{"id": 2, "active": true, "profile": {}, "tags": [], "note": null}
+	]`
+	got, err := flattenString(t, in)
+	testutil.AssertNoError(t, err)
+	want := "active,id,name,note,profile,profile.bio,tags,tags.0,tags.1\n" +
+		",1,Alice,,,\"hi, there\",,a,b\n" +
+		"true,2,,,,,,,\n"
+	testutil.AssertEqual(t, got, want)
+}
+
+func TestFlattenJSONToCSVKeepsNumbers(t *testing.T) {
+	got, err := flattenString(t, `[{"big": 12345678901234567890, "f": 1.50}]`)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, got, "big,f\n12345678901234567890,1.50\n")
+}
+
+func TestFlattenJSONToCSVColumnCollision(t *testing.T) {
+	for _, in := range []string{
+		`[{"a.b": 1}, {"a": {"b": 2}}]`,
+		`[{"a.b": 1, "a": {"b": 2}}]`,
+		`[{"tags.0": "x", "tags": ["y"]}]`,
+	} {
+		_, err := flattenString(t, in)
+		if err == nil {
+			t.Errorf("FlattenJSONToCSV(%s) merged colliding columns", in)
+			continue
+		}
+		testutil.AssertContains(t, err.Error(), "both flatten to column")
+	}
+
+	// the same path in several rows is one column, not a collision
+	got, err := flattenString(t, `[{"a.b": 1}, {"a.b": 2}]`)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, got, "a.b\n1\n2\n")
+}
+
+func TestFlattenJSONToCSVRejectsNonObjects(t *testing.T) {
+	_, err := flattenString(t, `{"id": 1}`)
+	testutil.AssertContains(t, err.Error(), "expected a JSON array")
+	_, err = flattenString(t, `[{"id": 1}, 2]`)
+	testutil.AssertContains(t, err.Error(), "element 1")
+}
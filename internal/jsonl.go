@@ -0,0 +1,86 @@
+// jsonl.go
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// jsonlLocks holds one mutex per cleaned file path so concurrent appends
+// and reads of the same file never interleave partial lines
+var jsonlLocks sync.Map
+
+func jsonlLock(path string) *sync.Mutex {
+	key := path
+	if abs, err := filepath.Abs(path); err == nil {
+		key = abs
+	}
+	mu, _ := jsonlLocks.LoadOrStore(key, &sync.Mutex{})
+	return mu.(*sync.Mutex)
+}
+
+// AppendJSONL encodes v as a single JSON line and appends it to path,
+// creating the file if needed
+func AppendJSONL(path string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encode jsonl record: %w", err)
+	}
+	data = append(data, '\n')
+
+	mu := jsonlLock(path)
+	mu.Lock()
+	defer mu.Unlock()
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("open %s: %w", path, err)
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return fmt.Errorf("append to %s: %w", path, err)
+	}
+	return file.Close()
+}
+
+// ReadJSONL decodes every line of path into a T. Blank lines are skipped;
+// a malformed line fails the read with its line number.
+func ReadJSONL[T any](path string) ([]T, error) {
+	mu := jsonlLock(path)
+	mu.Lock()
+	defer mu.Unlock()
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var records []T
+	reader := bufio.NewReader(file)
+	for lineNum := 1; ; lineNum++ {
+		line, readErr := reader.ReadBytes('\n')
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			return records, fmt.Errorf("read %s: %w", path, readErr)
+		}
+
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			var record T
+			if err := json.Unmarshal(line, &record); err != nil {
+				return records, fmt.Errorf("%s line %d: %w", path, lineNum, err)
+			}
+			records = append(records, record)
+		}
+
+		if readErr != nil {
+			return records, nil
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// jsonl_test.go
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestAppendAndReadJSONL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "users.jsonl")
+	users := []JSONUser{
+		{ID: 1, Name: "Alice", Email: "alice@example.com", Password: "secret", IsActive: true},
+		{ID: 2, Name: "Bob", Email: "bob@example.com", Age: 41},
+		{ID: 3, Name: "Charlie"},
+	}
+	for _, u := range users {
+		testutil.AssertNoError(t, AppendJSONL(path, u))
+	}
+
+	got, err := ReadJSONL[JSONUser](path)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(got), 3)
+	testutil.AssertEqual(t, got[0].Name, "Alice")
+	testutil.AssertEqual(t, got[0].Password, "") // excluded by its json tag
+	testutil.AssertEqual(t, got[1].Age, 41)
+	testutil.AssertEqual(t, got[2].ID, 3)
+}
+
+func TestReadJSONLBlankAndBadLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mixed.jsonl")
+	testutil.AssertNoError(t, os.WriteFile(path, []byte("{\"id\":1}\n\n  \n{\"id\":2}"), 0644))
+	got, err := ReadJSONL[JSONUser](path)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(got), 2) // blank lines skipped, last line unterminated
+
+	testutil.AssertNoError(t, os.WriteFile(path, []byte("{\"id\":1}\nnot json\n"), 0644))
+	got, err = ReadJSONL[JSONUser](path)
+	testutil.AssertContains(t, err.Error(), "line 2")
+	testutil.AssertEqual(t, len(got), 1)
+
+	_, err = ReadJSONL[JSONUser](filepath.Join(t.TempDir(), "missing.jsonl"))
+	testutil.AssertErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestAppendJSONLConcurrent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			testutil.AssertNoError(t, AppendJSONL(path, JSONUser{ID: id, Name: "worker"}))
+		}(i)
+	}
+	wg.Wait()
+
+	got, err := ReadJSONL[JSONUser](path)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(got), 50)
+}
@@ -0,0 +1,132 @@
+// kv_store.go
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Store is a goroutine-safe key-value store with optional per-key expiry
+// that can be persisted to and restored from a JSON file
+type Store struct {
+	mu    sync.RWMutex
+	data  map[string]storeEntry
+	clock Clock
+}
+
+// storeEntry is also the on-disk representation of a key
+type storeEntry struct {
+	Value     interface{} `json:"value"`
+	ExpiresAt *time.Time  `json:"expires_at,omitempty"`
+}
+
+func (e storeEntry) expired(now time.Time) bool {
+	return e.ExpiresAt != nil && !now.Before(*e.ExpiresAt)
+}
+
+// NewStore creates an empty store. A nil clock uses the system clock.
+func NewStore(clock Clock) *Store {
+	return &Store{
+		data:  make(map[string]storeEntry),
+		clock: clockOrSystem(clock),
+	}
+}
+
+// Set stores value under key. A ttl of zero or less never expires.
+func (s *Store) Set(key string, value interface{}, ttl time.Duration) {
+	entry := storeEntry{Value: value}
+	if ttl > 0 {
+		expiresAt := s.clock.Now().Add(ttl)
+		entry.ExpiresAt = &expiresAt
+	}
+
+	s.mu.Lock()
+	s.data[key] = entry
+	s.mu.Unlock()
+}
+
+// Get returns the value for key, pruning it if it has expired
+func (s *Store) Get(key string) (interface{}, bool) {
+	s.mu.RLock()
+	entry, ok := s.data[key]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+
+	if entry.expired(s.clock.Now()) {
+		s.mu.Lock()
+		// Re-check: another goroutine may have replaced the entry meanwhile
+		if current, ok := s.data[key]; ok && current.expired(s.clock.Now()) {
+			delete(s.data, key)
+		}
+		s.mu.Unlock()
+		return nil, false
+	}
+	return entry.Value, true
+}
+
+// Delete removes key from the store
+func (s *Store) Delete(key string) {
+	s.mu.Lock()
+	delete(s.data, key)
+	s.mu.Unlock()
+}
+
+// Len returns the number of stored keys, including expired ones that have
+// not been pruned yet
+func (s *Store) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// SaveToFile prunes expired entries and writes the rest to path as JSON
+func (s *Store) SaveToFile(path string) error {
+	s.mu.Lock()
+	s.pruneLocked()
+	data, err := json.MarshalIndent(s.data, "", "  ")
+	s.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("encode store: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("save store to %s: %w", path, err)
+	}
+	return nil
+}
+
+// LoadFromFile replaces the store contents with the entries saved in path,
+// skipping any that expired since they were written. Values come back as
+// the generic JSON types (float64, string, map[string]interface{}, ...).
+func (s *Store) LoadFromFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("load store from %s: %w", path, err)
+	}
+
+	loaded := make(map[string]storeEntry)
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		return fmt.Errorf("decode store %s: %w", path, err)
+	}
+
+	s.mu.Lock()
+	s.data = loaded
+	s.pruneLocked()
+	s.mu.Unlock()
+	return nil
+}
+
+// pruneLocked removes expired entries; the caller must hold s.mu
+func (s *Store) pruneLocked() {
+	now := s.clock.Now()
+	for key, entry := range s.data {
+		if entry.expired(now) {
+			delete(s.data, key)
+		}
+	}
+}
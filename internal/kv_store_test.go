@@ -0,0 +1,87 @@
+// kv_store_test.go
+package internal
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestStoreTTLExpiry(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	store := NewStore(clock)
+	store.Set("session", "abc", time.Minute)
+	store.Set("config", "forever", 0)
+
+	v, ok := store.Get("session")
+	testutil.AssertEqual(t, ok, true)
+	testutil.AssertEqual(t, v, any("abc"))
+
+	clock.Advance(time.Minute)
+	_, ok = store.Get("session")
+	testutil.AssertEqual(t, ok, false)
+	testutil.AssertEqual(t, store.Len(), 1) // pruned on access
+
+	store.Delete("config")
+	_, ok = store.Get("config")
+	testutil.AssertEqual(t, ok, false)
+}
+
+func TestStorePersistenceSkipsExpired(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	path := filepath.Join(t.TempDir(), "store.json")
+
+	store := NewStore(clock)
+	store.Set("user", map[string]interface{}{"name": "Alice"}, 0)
+	store.Set("count", 3, time.Hour)
+	store.Set("token", "short-lived", time.Second)
+	clock.Advance(2 * time.Second)
+	testutil.AssertNoError(t, store.SaveToFile(path))
+
+	data, err := os.ReadFile(path)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, strings.Contains(string(data), "token"), false)
+
+	restored := NewStore(clock)
+	testutil.AssertNoError(t, restored.LoadFromFile(path))
+	testutil.AssertEqual(t, restored.Len(), 2)
+	count, ok := restored.Get("count")
+	testutil.AssertEqual(t, ok, true)
+	testutil.AssertEqual(t, count, any(3.0)) // JSON numbers load as float64
+	user, _ := restored.Get("user")
+	testutil.AssertEqual(t, user.(map[string]interface{})["name"], any("Alice"))
+
+	// entries that expire after saving are dropped on load
+	clock.Advance(time.Hour)
+	testutil.AssertNoError(t, restored.LoadFromFile(path))
+	testutil.AssertEqual(t, restored.Len(), 1)
+}
+
+func TestStoreConcurrentAccess(t *testing.T) {
+	store := NewStore(nil)
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				key := fmt.Sprintf("k%d", i%10)
+				store.Set(key, w, time.Millisecond)
+				store.Get(key)
+				if i%7 == 0 {
+					store.Delete(key)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	if store.Len() > 10 {
+		t.Errorf("Len = %d, want at most 10 keys", store.Len())
+	}
+}
@@ -0,0 +1,79 @@
+// latency_simulator.go
+package internal
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// LatencyDistribution selects how a LatencySimulator draws delays
+type LatencyDistribution int
+
+const (
+	LatencyConstant LatencyDistribution = iota // always Mean
+	LatencyUniform                             // evenly spread over [Min, Max)
+	LatencyNormal                              // bell curve around Mean, clamped at zero
+)
+
+// LatencyConfig describes a delay distribution. Constant uses Mean,
+// uniform uses Min and Max, and normal uses Mean and StdDev.
+type LatencyConfig struct {
+	Distribution LatencyDistribution
+	Mean         time.Duration
+	StdDev       time.Duration
+	Min, Max     time.Duration
+}
+
+// ConstantLatency is a config whose every delay is d
+func ConstantLatency(d time.Duration) LatencyConfig {
+	return LatencyConfig{Distribution: LatencyConstant, Mean: d}
+}
+
+// UniformLatency is a config drawing delays evenly from [min, max)
+func UniformLatency(min, max time.Duration) LatencyConfig {
+	return LatencyConfig{Distribution: LatencyUniform, Min: min, Max: max}
+}
+
+// NormalLatency is a config drawing normally distributed delays
+func NormalLatency(mean, stddev time.Duration) LatencyConfig {
+	return LatencyConfig{Distribution: LatencyNormal, Mean: mean, StdDev: stddev}
+}
+
+// LatencySimulator draws simulated service delays from a LatencyConfig.
+// All randomness comes from its *rand.Rand, so a seeded source replays the
+// same delays. It is safe for concurrent use.
+type LatencySimulator struct {
+	mu     sync.Mutex
+	config LatencyConfig
+	rand   *rand.Rand
+}
+
+// NewLatencySimulator creates a simulator for config. A nil r uses a
+// source seeded from the current time.
+func NewLatencySimulator(config LatencyConfig, r *rand.Rand) *LatencySimulator {
+	if r == nil {
+		r = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return &LatencySimulator{config: config, rand: r}
+}
+
+// Delay returns the next simulated delay, never negative
+func (s *LatencySimulator) Delay() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var d time.Duration
+	switch c := s.config; c.Distribution {
+	case LatencyUniform:
+		d = c.Min
+		if c.Max > c.Min {
+			d += time.Duration(s.rand.Int63n(int64(c.Max - c.Min)))
+		}
+	case LatencyNormal:
+		d = c.Mean + time.Duration(s.rand.NormFloat64()*float64(c.StdDev))
+	default:
+		d = c.Mean
+	}
+	return max(d, 0)
+}
@@ -0,0 +1,71 @@
+// latency_simulator_test.go
+package internal
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestLatencySimulatorConstant(t *testing.T) {
+	sim := NewLatencySimulator(ConstantLatency(50*time.Millisecond), rand.New(rand.NewSource(1)))
+	for i := 0; i < 10; i++ {
+		testutil.AssertEqual(t, sim.Delay(), 50*time.Millisecond)
+	}
+}
+
+func TestLatencySimulatorUniformBounds(t *testing.T) {
+	sim := NewLatencySimulator(UniformLatency(10*time.Millisecond, 20*time.Millisecond), rand.New(rand.NewSource(1)))
+	lowest, highest := time.Hour, time.Duration(0)
+	for i := 0; i < 5000; i++ {
+		d := sim.Delay()
+		if d < lowest {
+			lowest = d
+		}
+		if d > highest {
+			highest = d
+		}
+	}
+	testutil.AssertEqual(t, lowest >= 10*time.Millisecond, true)
+	testutil.AssertEqual(t, highest < 20*time.Millisecond, true)
+	// Over many samples the whole range is covered
+	testutil.AssertEqual(t, lowest < 11*time.Millisecond && highest > 19*time.Millisecond, true)
+
+	degenerate := NewLatencySimulator(UniformLatency(5*time.Millisecond, 5*time.Millisecond), nil)
+	testutil.AssertEqual(t, degenerate.Delay(), 5*time.Millisecond)
+}
+
+func TestLatencySimulatorNormal(t *testing.T) {
+	mean, stddev := 100*time.Millisecond, 10*time.Millisecond
+	sim := NewLatencySimulator(NormalLatency(mean, stddev), rand.New(rand.NewSource(1)))
+	var stats Stats
+	for i := 0; i < 5000; i++ {
+		d := sim.Delay()
+		testutil.AssertEqual(t, d > mean-6*stddev && d < mean+6*stddev, true)
+		stats.Add(float64(d) / float64(time.Millisecond))
+	}
+	testutil.AssertEqual(t, stats.Mean() > 99 && stats.Mean() < 101, true)
+	testutil.AssertEqual(t, stats.StdDev() > 9 && stats.StdDev() < 11, true)
+
+	// A wide spread around a small mean is clamped rather than going negative
+	clamped := NewLatencySimulator(NormalLatency(time.Millisecond, time.Second), rand.New(rand.NewSource(2)))
+	zeros := 0
+	for i := 0; i < 1000; i++ {
+		d := clamped.Delay()
+		testutil.AssertEqual(t, d >= 0, true)
+		if d == 0 {
+			zeros++
+		}
+	}
+	testutil.AssertEqual(t, zeros > 0, true)
+}
+
+func TestLatencySimulatorSeedReplays(t *testing.T) {
+	a := NewLatencySimulator(NormalLatency(time.Second, time.Second), rand.New(rand.NewSource(7)))
+	b := NewLatencySimulator(NormalLatency(time.Second, time.Second), rand.New(rand.NewSource(7)))
+	for i := 0; i < 20; i++ {
+		testutil.AssertEqual(t, a.Delay(), b.Delay())
+	}
+}
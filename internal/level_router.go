@@ -0,0 +1,115 @@
+// level_router.go
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"sync"
+)
+
+// LevelRouter is an io.Writer that sends each log record to every route
+// whose minimum level the record meets, e.g. errors to stderr and
+// everything to a file. Records are newline-terminated lines as written by
+// slog's JSONHandler or TextHandler; the level is read from the JSON
+// "level" field or the text level= pair, and lines without one count as
+// INFO. It is safe for concurrent use.
+type LevelRouter struct {
+	mu      sync.Mutex
+	routes  []levelRoute
+	partial []byte
+}
+
+type levelRoute struct {
+	min slog.Level
+	w   io.Writer
+}
+
+// NewLevelRouter creates a router with no routes
+func NewLevelRouter() *LevelRouter {
+	return &LevelRouter{}
+}
+
+// Route adds a destination for records at min or above and returns the
+// router so routes can be chained
+func (r *LevelRouter) Route(min slog.Level, w io.Writer) *LevelRouter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.routes = append(r.routes, levelRoute{min: min, w: w})
+	return r
+}
+
+// Write routes every complete line in p, holding back a trailing partial
+// line until its newline arrives. Every route gets each record even if an
+// earlier one fails; the first error is returned.
+func (r *LevelRouter) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.partial = append(r.partial, p...)
+
+	var firstErr error
+	for {
+		end := bytes.IndexByte(r.partial, '\n')
+		if end < 0 {
+			break
+		}
+		if err := r.dispatch(r.partial[:end+1]); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		r.partial = r.partial[end+1:]
+	}
+	return len(p), firstErr
+}
+
+// Flush routes a buffered partial line, if any
+func (r *LevelRouter) Flush() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.partial) == 0 {
+		return nil
+	}
+	err := r.dispatch(r.partial)
+	r.partial = nil
+	return err
+}
+
+func (r *LevelRouter) dispatch(record []byte) error {
+	level := recordLevel(record)
+	var firstErr error
+	for _, route := range r.routes {
+		if level < route.min {
+			continue
+		}
+		if _, err := route.w.Write(record); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// recordLevel extracts the level of one JSON or key=value log line
+func recordLevel(record []byte) slog.Level {
+	var text []byte
+	if trimmed := bytes.TrimSpace(record); len(trimmed) > 0 && trimmed[0] == '{' {
+		var fields struct {
+			Level string `json:"level"`
+		}
+		if json.Unmarshal(trimmed, &fields) == nil {
+			text = []byte(fields.Level)
+		}
+	} else {
+		for _, pair := range bytes.Fields(trimmed) {
+			if value, ok := bytes.CutPrefix(pair, []byte("level=")); ok {
+				text = value
+				break
+			}
+		}
+	}
+
+	var level slog.Level
+	if len(text) == 0 || level.UnmarshalText(text) != nil {
+		return slog.LevelInfo
+	}
+	return level
+}
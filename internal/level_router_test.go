@@ -0,0 +1,56 @@
+// level_router_test.go
+package internal
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestLevelRouterJSONHandler(t *testing.T) {
+	var errs, all bytes.Buffer
+	router := NewLevelRouter().
+		Route(slog.LevelError, &errs).
+		Route(slog.LevelDebug, &all)
+	logger := slog.New(slog.NewJSONHandler(router, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	logger.Info("started")
+	logger.Error("disk full")
+
+	testutil.AssertEqual(t, strings.Count(all.String(), "\n"), 2)
+	testutil.AssertContains(t, all.String(), `"msg":"started"`)
+	testutil.AssertContains(t, all.String(), `"msg":"disk full"`)
+	testutil.AssertEqual(t, strings.Count(errs.String(), "\n"), 1)
+	testutil.AssertContains(t, errs.String(), `"msg":"disk full"`)
+}
+
+func TestLevelRouterTextAndPartialLines(t *testing.T) {
+	var warn bytes.Buffer
+	router := NewLevelRouter().Route(slog.LevelWarn, &warn)
+
+	router.Write([]byte("time=now level=WARN msg=sl"))
+	testutil.AssertEqual(t, warn.Len(), 0)
+	router.Write([]byte("ow\nlevel=INFO msg=ok\nno level here\n"))
+	testutil.AssertEqual(t, warn.String(), "time=now level=WARN msg=slow\n")
+
+	router.Write([]byte(`{"level":"ERROR+2","msg":"tail"}`))
+	testutil.AssertNoError(t, router.Flush())
+	testutil.AssertContains(t, warn.String(), `"msg":"tail"`)
+}
+
+func TestLevelRouterKeepsRoutingAfterError(t *testing.T) {
+	boom := errors.New("boom")
+	var good bytes.Buffer
+	router := NewLevelRouter().
+		Route(slog.LevelInfo, failingWriter{boom}).
+		Route(slog.LevelInfo, &good)
+
+	n, err := router.Write([]byte("level=INFO msg=a\n"))
+	testutil.AssertErrorIs(t, err, boom)
+	testutil.AssertEqual(t, n, 17)
+	testutil.AssertEqual(t, good.String(), "level=INFO msg=a\n")
+}
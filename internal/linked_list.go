@@ -0,0 +1,152 @@
+// linked_list.go
+package internal
+
+// ListNode is an element of a LinkedList. Value may be changed freely; the
+// links are managed by the list.
+type ListNode[T any] struct {
+	Value      T
+	prev, next *ListNode[T]
+	list       *LinkedList[T]
+}
+
+// Next returns the following node, or nil at the back of the list
+func (n *ListNode[T]) Next() *ListNode[T] {
+	if n.list == nil || n.next == &n.list.root {
+		return nil
+	}
+	return n.next
+}
+
+// Prev returns the preceding node, or nil at the front of the list
+func (n *ListNode[T]) Prev() *ListNode[T] {
+	if n.list == nil || n.prev == &n.list.root {
+		return nil
+	}
+	return n.prev
+}
+
+// LinkedList is a type-safe doubly linked list. A sentinel root node keeps
+// every insertion and removal free of nil checks. The zero value is an
+// empty list ready to use. It is not safe for concurrent use.
+type LinkedList[T any] struct {
+	root ListNode[T] // root.next is the front, root.prev the back
+	len  int
+}
+
+// NewLinkedList creates an empty list
+func NewLinkedList[T any]() *LinkedList[T] {
+	return new(LinkedList[T]).lazyInit()
+}
+
+func (l *LinkedList[T]) lazyInit() *LinkedList[T] {
+	if l.root.next == nil {
+		l.root.next = &l.root
+		l.root.prev = &l.root
+	}
+	return l
+}
+
+// Len returns the number of nodes
+func (l *LinkedList[T]) Len() int {
+	return l.len
+}
+
+// Front returns the first node, or nil if the list is empty
+func (l *LinkedList[T]) Front() *ListNode[T] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+// Back returns the last node, or nil if the list is empty
+func (l *LinkedList[T]) Back() *ListNode[T] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+// PushFront inserts value at the front and returns its node
+func (l *LinkedList[T]) PushFront(value T) *ListNode[T] {
+	l.lazyInit()
+	return l.insertAfter(&ListNode[T]{Value: value}, &l.root)
+}
+
+// PushBack inserts value at the back and returns its node
+func (l *LinkedList[T]) PushBack(value T) *ListNode[T] {
+	l.lazyInit()
+	return l.insertAfter(&ListNode[T]{Value: value}, l.root.prev)
+}
+
+// InsertBefore inserts value just before mark and returns its node. It
+// returns nil, leaving the list unchanged, if mark is not in this list.
+func (l *LinkedList[T]) InsertBefore(value T, mark *ListNode[T]) *ListNode[T] {
+	if mark == nil || mark.list != l {
+		return nil
+	}
+	return l.insertAfter(&ListNode[T]{Value: value}, mark.prev)
+}
+
+// PopFront removes and returns the front value; ok is false if the list is
+// empty
+func (l *LinkedList[T]) PopFront() (value T, ok bool) {
+	if l.len == 0 {
+		return value, false
+	}
+	return l.Remove(l.root.next), true
+}
+
+// PopBack removes and returns the back value; ok is false if the list is
+// empty
+func (l *LinkedList[T]) PopBack() (value T, ok bool) {
+	if l.len == 0 {
+		return value, false
+	}
+	return l.Remove(l.root.prev), true
+}
+
+// Remove unlinks node and returns its value. Removing a node that belongs
+// to another list, or was already removed, only returns the value.
+func (l *LinkedList[T]) Remove(node *ListNode[T]) T {
+	if node.list == l {
+		l.unlink(node)
+	}
+	return node.Value
+}
+
+// MoveToFront makes node the first node without reallocating it
+func (l *LinkedList[T]) MoveToFront(node *ListNode[T]) {
+	if node.list != l || l.root.next == node {
+		return
+	}
+	l.unlink(node)
+	l.insertAfter(node, &l.root)
+}
+
+// ForEach calls fn with each value from front to back, stopping early if
+// fn returns false
+func (l *LinkedList[T]) ForEach(fn func(value T) bool) {
+	for node := l.Front(); node != nil; node = node.Next() {
+		if !fn(node.Value) {
+			return
+		}
+	}
+}
+
+func (l *LinkedList[T]) insertAfter(node, at *ListNode[T]) *ListNode[T] {
+	node.prev = at
+	node.next = at.next
+	at.next.prev = node
+	at.next = node
+	node.list = l
+	l.len++
+	return node
+}
+
+func (l *LinkedList[T]) unlink(node *ListNode[T]) {
+	node.prev.next = node.next
+	node.next.prev = node.prev
+	node.prev, node.next, node.list = nil, nil, nil
+	l.len--
+}
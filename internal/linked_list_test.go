@@ -0,0 +1,99 @@
+// linked_list_test.go
+package internal
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// listValues collects l front to back with ForEach
+func listValues[T any](l *LinkedList[T]) string {
+	var values []T
+	l.ForEach(func(v T) bool {
+		values = append(values, v)
+		return true
+	})
+	return fmt.Sprint(values)
+}
+
+func TestLinkedListEnds(t *testing.T) {
+	var l LinkedList[int] // the zero value is usable
+	l.PushBack(2)
+	l.PushBack(3)
+	l.PushFront(1)
+	testutil.AssertEqual(t, listValues(&l), "[1 2 3]")
+	testutil.AssertEqual(t, l.Len(), 3)
+	testutil.AssertEqual(t, l.Front().Value, 1)
+	testutil.AssertEqual(t, l.Back().Value, 3)
+
+	v, ok := l.PopFront()
+	testutil.AssertEqual(t, v, 1)
+	testutil.AssertEqual(t, ok, true)
+	v, _ = l.PopBack()
+	testutil.AssertEqual(t, v, 3)
+	testutil.AssertEqual(t, listValues(&l), "[2]")
+
+	l.PopBack()
+	_, ok = l.PopFront()
+	testutil.AssertEqual(t, ok, false)
+	_, ok = l.PopBack()
+	testutil.AssertEqual(t, ok, false)
+	testutil.AssertEqual(t, l.Front() == nil && l.Back() == nil, true)
+}
+
+func TestLinkedListMiddle(t *testing.T) {
+	l := NewLinkedList[string]()
+	a := l.PushBack("a")
+	c := l.PushBack("c")
+	b := l.InsertBefore("b", c)
+	l.InsertBefore("start", a)
+	testutil.AssertEqual(t, listValues(l), "[start a b c]")
+	testutil.AssertEqual(t, b.Prev(), a)
+	testutil.AssertEqual(t, b.Next(), c)
+	testutil.AssertEqual(t, c.Next() == nil, true)
+
+	testutil.AssertEqual(t, l.Remove(b), "b")
+	testutil.AssertEqual(t, listValues(l), "[start a c]")
+	testutil.AssertEqual(t, a.Next(), c)
+	testutil.AssertEqual(t, b.Next() == nil && b.Prev() == nil, true)
+
+	// Removing twice, or inserting before a detached node, changes nothing
+	l.Remove(b)
+	testutil.AssertEqual(t, l.InsertBefore("x", b) == nil, true)
+	testutil.AssertEqual(t, l.Len(), 3)
+}
+
+func TestLinkedListForeignNodes(t *testing.T) {
+	l1, l2 := NewLinkedList[int](), NewLinkedList[int]()
+	node := l1.PushBack(1)
+	l2.PushBack(2)
+
+	l2.Remove(node)
+	l2.MoveToFront(node)
+	testutil.AssertEqual(t, l2.InsertBefore(9, node) == nil, true)
+	testutil.AssertEqual(t, listValues(l1), "[1]")
+	testutil.AssertEqual(t, listValues(l2), "[2]")
+}
+
+func TestLinkedListForEachAfterMutations(t *testing.T) {
+	l := NewLinkedList[int]()
+	nodes := make([]*ListNode[int], 6)
+	for i := range nodes {
+		nodes[i] = l.PushBack(i)
+	}
+	l.Remove(nodes[2])
+	l.MoveToFront(nodes[4])
+	l.InsertBefore(10, nodes[5])
+	l.PopBack()
+	nodes[0].Value = 100
+	testutil.AssertEqual(t, listValues(l), "[4 100 1 3 10]")
+
+	var firstTwo []int
+	l.ForEach(func(v int) bool {
+		firstTwo = append(firstTwo, v)
+		return len(firstTwo) < 2
+	})
+	testutil.AssertEqual(t, fmt.Sprint(firstTwo), "[4 100]")
+}
@@ -0,0 +1,100 @@
+// log_aggregate.go
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// AggregateLogs streams NDJSON records from r, groups them by the string
+// value of groupBy and sums the numeric sumField within each group. Records
+// are decoded one at a time, so input size is not limited by memory. Errors
+// name the line of the offending record.
+func AggregateLogs(r io.Reader, groupBy, sumField string) (map[string]float64, error) {
+	lines := &lineTracker{r: r}
+	dec := json.NewDecoder(lines)
+	totals := make(map[string]float64)
+
+	for {
+		var record map[string]any
+		err := dec.Decode(&record)
+		if errors.Is(err, io.EOF) {
+			return totals, nil
+		}
+		if err != nil {
+			offset := dec.InputOffset()
+			var syntaxErr *json.SyntaxError
+			var typeErr *json.UnmarshalTypeError
+			switch {
+			case errors.As(err, &syntaxErr):
+				offset = syntaxErr.Offset
+			case errors.As(err, &typeErr):
+				offset = typeErr.Offset
+			}
+			return totals, fmt.Errorf("line %d: %w", lines.lineAt(offset), err)
+		}
+
+		line := lines.lineAt(dec.InputOffset())
+		key, ok := record[groupBy].(string)
+		if !ok {
+			return totals, fmt.Errorf("line %d: field %q is not a string", line, groupBy)
+		}
+		value, ok := record[sumField].(float64)
+		if !ok {
+			return totals, fmt.Errorf("line %d: field %q is not a number", line, sumField)
+		}
+		totals[key] += value
+		lines.forget(dec.InputOffset())
+	}
+}
+
+// lineTracker records the offsets of newlines as the decoder reads ahead,
+// so a byte offset can be mapped back to a 1-based line number. Offsets
+// before the last fully processed record are folded into a count.
+type lineTracker struct {
+	r        io.Reader
+	read     int64   // bytes read so far
+	newlines []int64 // offsets of newlines not yet forgotten
+	before   int     // newlines already forgotten
+}
+
+func (t *lineTracker) Read(p []byte) (int, error) {
+	n, err := t.r.Read(p)
+	chunk := p[:n]
+	for base := t.read; ; {
+		i := bytes.IndexByte(chunk, '\n')
+		if i < 0 {
+			break
+		}
+		t.newlines = append(t.newlines, base+int64(i))
+		base += int64(i) + 1
+		chunk = chunk[i+1:]
+	}
+	t.read += int64(n)
+	return n, err
+}
+
+// lineAt returns the line holding the byte just before offset
+func (t *lineTracker) lineAt(offset int64) int {
+	line := t.before + 1
+	for _, nl := range t.newlines {
+		if nl >= offset-1 {
+			break
+		}
+		line++
+	}
+	return line
+}
+
+// forget drops newline offsets before offset
+func (t *lineTracker) forget(offset int64) {
+	i := 0
+	for i < len(t.newlines) && t.newlines[i] < offset {
+		i++
+	}
+	t.before += i
+	t.newlines = t.newlines[i:]
+}
@@ -0,0 +1,49 @@
+// log_aggregate_test.go
+package internal
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestAggregateLogsGroupsAndSums(t *testing.T) {
+	input := `{"service":"api","ms":12.5}
+{"service":"db","ms":3}
+{"service":"api","ms":7.5}
+
+{"service":"db","ms":1,"extra":true}
+`
+	totals, err := AggregateLogs(strings.NewReader(input), "service", "ms")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(totals), 2)
+	testutil.AssertEqual(t, totals["api"], 20.0)
+	testutil.AssertEqual(t, totals["db"], 4.0)
+}
+
+func TestAggregateLogsEmpty(t *testing.T) {
+	totals, err := AggregateLogs(strings.NewReader(""), "service", "ms")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(totals), 0)
+}
+
+func TestAggregateLogsMalformedLine(t *testing.T) {
+	input := `{"service":"api","ms":1}
+{"service":"api","ms":2}
+{"service":"api", ms:3}
+`
+	totals, err := AggregateLogs(strings.NewReader(input), "service", "ms")
+	testutil.AssertContains(t, err.Error(), "line 3:")
+	testutil.AssertEqual(t, totals["api"], 3.0) // records before the error are kept
+}
+
+func TestAggregateLogsFieldErrors(t *testing.T) {
+	_, err := AggregateLogs(strings.NewReader(`{"service":"api","ms":1}
+{"service":7,"ms":1}
+`), "service", "ms")
+	testutil.AssertEqual(t, err.Error(), `line 2: field "service" is not a string`)
+
+	_, err = AggregateLogs(strings.NewReader(`{"service":"api","ms":"slow"}`), "service", "ms")
+	testutil.AssertEqual(t, err.Error(), `line 1: field "ms" is not a number`)
+}
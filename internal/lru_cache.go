@@ -0,0 +1,83 @@
+// lru_cache.go
+package internal
+
+import "sync"
+
+// LRUCache is a goroutine-safe cache holding at most capacity entries.
+// Entries live in a LinkedList ordered from most to least recently used,
+// with a map from key to node, so Get, Put and eviction are all O(1).
+type LRUCache[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	order    *LinkedList[lruEntry[K, V]]
+	nodes    map[K]*ListNode[lruEntry[K, V]]
+}
+
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// NewLRUCache creates a cache holding up to capacity entries (at least one)
+func NewLRUCache[K comparable, V any](capacity int) *LRUCache[K, V] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &LRUCache[K, V]{
+		capacity: capacity,
+		order:    NewLinkedList[lruEntry[K, V]](),
+		nodes:    make(map[K]*ListNode[lruEntry[K, V]], capacity),
+	}
+}
+
+// Get returns the value for key and marks it as most recently used
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	node, ok := c.nodes[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.order.MoveToFront(node)
+	return node.Value.value, true
+}
+
+// Put stores value under key as the most recently used entry. If the cache
+// is full, the least recently used entry is evicted and returned.
+func (c *LRUCache[K, V]) Put(key K, value V) (evictedKey K, evicted bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if node, ok := c.nodes[key]; ok {
+		node.Value.value = value
+		c.order.MoveToFront(node)
+		return evictedKey, false
+	}
+
+	if c.order.Len() >= c.capacity {
+		oldest, _ := c.order.PopBack()
+		delete(c.nodes, oldest.key)
+		evictedKey, evicted = oldest.key, true
+	}
+	c.nodes[key] = c.order.PushFront(lruEntry[K, V]{key: key, value: value})
+	return evictedKey, evicted
+}
+
+// Len returns the number of cached entries
+func (c *LRUCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Keys returns the cached keys from most to least recently used
+func (c *LRUCache[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	keys := make([]K, 0, c.order.Len())
+	c.order.ForEach(func(entry lruEntry[K, V]) bool {
+		keys = append(keys, entry.key)
+		return true
+	})
+	return keys
+}
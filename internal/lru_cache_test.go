@@ -0,0 +1,40 @@
+// lru_cache_test.go
+package internal
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestLRUCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLRUCache[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a") // b is now the oldest
+
+	evicted, ok := c.Put("c", 3)
+	testutil.AssertEqual(t, ok, true)
+	testutil.AssertEqual(t, evicted, "b")
+	testutil.AssertEqual(t, fmt.Sprint(c.Keys()), "[c a]")
+
+	_, found := c.Get("b")
+	testutil.AssertEqual(t, found, false)
+	v, _ := c.Get("a")
+	testutil.AssertEqual(t, v, 1)
+}
+
+func TestLRUCacheUpdateMovesToFront(t *testing.T) {
+	c := NewLRUCache[int, string](0) // clamped to one entry
+	c.Put(1, "one")
+	_, evicted := c.Put(1, "uno")
+	testutil.AssertEqual(t, evicted, false)
+	v, _ := c.Get(1)
+	testutil.AssertEqual(t, v, "uno")
+	testutil.AssertEqual(t, c.Len(), 1)
+
+	key, evicted := c.Put(2, "two")
+	testutil.AssertEqual(t, evicted, true)
+	testutil.AssertEqual(t, key, 1)
+}
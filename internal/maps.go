@@ -3,7 +3,11 @@ package internal
 
 import (
 	"fmt"
+	"os"
 	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // RunMapExamples - main function to run all map examples
@@ -17,6 +21,9 @@ func RunMapExamples() {
 	nestedMapsExample()
 	mapWithStructsExample()
 	mapConcurrencyExample()
+	keyValueStoreExample()
+	bitSetExample()
+	lruCacheExample()
 }
 
 // basicMapExample - demonstrates basic map operations
@@ -236,6 +243,52 @@ func mapPerformanceExample() {
 
 	fmt.Printf("Struct key map: %v\n", pointMap)
 
+	// Composite struct keys group by several fields at once
+	type roleKey struct {
+		Position, Department string
+	}
+	staff := EmployeeDirectory()
+	byRole := GroupByKey(staff, func(e Employee) roleKey { return roleKey{e.Position, e.Department} })
+	roles := make([]roleKey, 0, len(byRole))
+	for role := range byRole {
+		roles = append(roles, role)
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		if roles[i].Department != roles[j].Department {
+			return roles[i].Department < roles[j].Department
+		}
+		return roles[i].Position < roles[j].Position
+	})
+	fmt.Println("Employees by {Position, Department}:")
+	for _, role := range roles {
+		var names []string
+		for _, e := range byRole[role] {
+			names = append(names, e.Name)
+		}
+		fmt.Printf("  %-9s %-9s %s\n", role.Department, role.Position, strings.Join(names, ", "))
+	}
+
+	byID, err := IndexByUnique(staff, func(e Employee) int { return e.ID })
+	if err == nil {
+		fmt.Printf("Unique index by ID: #4 is %s\n", byID[4].Name)
+	}
+	if _, err := IndexByUnique(staff, func(e Employee) string { return e.Position }); err != nil {
+		fmt.Printf("Unique index by position: %s\n", ErrorText(err.Error()))
+	}
+
+	// Counter maps: tally word frequencies without the manual bookkeeping
+	text := "the quick brown fox jumps over the lazy dog the fox barks and the dog runs"
+	words := CounterFromSlice(strings.Fields(text))
+	Increment(words, "fox", 2) // two more sightings reported later
+
+	fmt.Println("Top words:")
+	table := NewTable("Word", "Count")
+	table.Options = TableOptions{Align: []Alignment{AlignLeft, AlignRight}, NoBorder: true}
+	for _, wc := range TopCounts(words, 5) {
+		table.AddRow(wc.Key, strconv.Itoa(wc.Count))
+	}
+	table.Render(os.Stdout)
+
 	fmt.Println()
 }
 
@@ -270,15 +323,7 @@ func nestedMapsExample() {
 	}
 
 	fmt.Println("Company structure:")
-	for department, teams := range company {
-		fmt.Printf("  %s:\n", department)
-		for team, details := range teams {
-			fmt.Printf("    %s:\n", team)
-			for key, value := range details {
-				fmt.Printf("      %s: %v\n", key, value)
-			}
-		}
-	}
+	PrettyPrintValue(os.Stdout, company, PrintOptions{})
 
 	// Safe nested access
 	if engineering, exists := company["Engineering"]; exists {
@@ -289,15 +334,71 @@ func nestedMapsExample() {
 		}
 	}
 
+	// The same structure as a typed tree: no type assertions, and any depth
+	org := NewTree(orgUnit{Name: "Company"})
+	engineering := org.Root.AddChild(orgUnit{Name: "Engineering"})
+	engineering.AddChild(orgUnit{Name: "Backend", Lead: "Alice", Members: []string{"Bob", "Charlie", "David"}, Budget: 100000})
+	engineering.AddChild(orgUnit{Name: "Frontend", Lead: "Eve", Members: []string{"Frank", "Grace"}, Budget: 75000})
+	marketing := org.Root.AddChild(orgUnit{Name: "Marketing"})
+	marketing.AddChild(orgUnit{Name: "Digital", Lead: "Henry", Members: []string{"Ivy", "Jack"}, Budget: 50000})
+
+	fmt.Println("Org chart (pre-order):")
+	totalBudget := 0
+	org.PreOrder(func(unit orgUnit, depth int) bool {
+		totalBudget += unit.Budget
+		line := strings.Repeat("  ", depth) + unit.Name
+		if unit.Lead != "" {
+			line += fmt.Sprintf(" (lead %s, %d members)", unit.Lead, len(unit.Members))
+		}
+		fmt.Println("  " + line)
+		return true
+	})
+	fmt.Printf("Total team budget: $%d\n", totalBudget)
+
+	var order []string
+	org.PostOrder(func(unit orgUnit, depth int) bool {
+		order = append(order, unit.Name)
+		return true
+	})
+	fmt.Printf("Post-order (children first): %s\n", strings.Join(order, ", "))
+
+	// Level order finds the shallowest match first; returning false stops early
+	order = nil
+	org.LevelOrder(func(unit orgUnit, depth int) bool {
+		order = append(order, unit.Name)
+		return unit.Budget == 0 || unit.Budget >= 80000
+	})
+	fmt.Printf("Level-order until the first team under $80000: %s\n", strings.Join(order, ", "))
+
 	fmt.Println()
 }
 
+// orgUnit is one department or team in the org chart tree
+type orgUnit struct {
+	Name    string
+	Lead    string
+	Members []string
+	Budget  int
+}
+
 // Employee - struct for map examples
 type Employee struct {
-	ID       int
-	Name     string
-	Position string
-	Salary   float64
+	ID         int
+	Name       string
+	Position   string
+	Department string
+	Salary     float64
+}
+
+// EmployeeDirectory returns the sample staff used by the map examples
+func EmployeeDirectory() []Employee {
+	return []Employee{
+		{ID: 1, Name: "Alice", Position: "Engineer", Department: "Platform", Salary: 75000},
+		{ID: 2, Name: "Bob", Position: "Designer", Department: "Product", Salary: 65000},
+		{ID: 3, Name: "Charlie", Position: "Manager", Department: "Platform", Salary: 85000},
+		{ID: 4, Name: "Dana", Position: "Engineer", Department: "Product", Salary: 78000},
+		{ID: 5, Name: "Evan", Position: "Engineer", Department: "Platform", Salary: 72000},
+	}
 }
 
 // mapWithStructsExample - demonstrates maps with structs
@@ -305,10 +406,9 @@ func mapWithStructsExample() {
 	fmt.Println(Bold("7. Maps with Structs:"))
 
 	// Map with struct values
-	employees := map[int]Employee{
-		1: {ID: 1, Name: "Alice", Position: "Engineer", Salary: 75000},
-		2: {ID: 2, Name: "Bob", Position: "Designer", Salary: 65000},
-		3: {ID: 3, Name: "Charlie", Position: "Manager", Salary: 85000},
+	employees := make(map[int]Employee)
+	for _, emp := range EmployeeDirectory() {
+		employees[emp.ID] = emp
 	}
 
 	fmt.Println("Employees map:")
@@ -393,3 +493,112 @@ func mapConcurrencyExample() {
 
 	fmt.Println()
 }
+
+// keyValueStoreExample - a goroutine-safe map with TTL and JSON persistence
+func keyValueStoreExample() {
+	fmt.Println(Bold("9. Key-Value Store with TTL:"))
+
+	clock := NewManualClock(time.Now())
+	store := NewStore(clock)
+
+	store.Set("user:1", "Alice", 0) // never expires
+	store.Set("session:abc", map[string]interface{}{"user": 1, "role": "admin"}, time.Minute)
+	store.Set("otp:1", 482913, 10*time.Second)
+
+	if v, ok := store.Get("otp:1"); ok {
+		fmt.Printf("otp:1 = %v\n", v)
+	}
+
+	clock.Advance(30 * time.Second)
+	if _, ok := store.Get("otp:1"); !ok {
+		fmt.Println("otp:1 expired after 30s")
+	}
+
+	path := "store_example.json"
+	defer os.Remove(path)
+	if err := store.SaveToFile(path); err != nil {
+		fmt.Printf("Error saving store: %v\n", err)
+		return
+	}
+
+	restored := NewStore(clock)
+	if err := restored.LoadFromFile(path); err != nil {
+		fmt.Printf("Error loading store: %v\n", err)
+		return
+	}
+	fmt.Printf("Restored %d keys from %s\n", restored.Len(), path)
+	if v, ok := restored.Get("session:abc"); ok {
+		fmt.Printf("session:abc = %v\n", v)
+	}
+
+	fmt.Println()
+}
+
+// bitSetExample - a bitset as a compact alternative to map[int]bool
+func bitSetExample() {
+	fmt.Println(Bold("10. BitSet as a Presence Set:"))
+
+	// Which user IDs logged in on each day
+	monday := NewBitSet(128)
+	tuesday := NewBitSet(128)
+	for _, id := range []int{3, 17, 64, 65, 100} {
+		monday.Set(id)
+	}
+	for _, id := range []int{3, 65, 99, 127, 200} {
+		tuesday.Set(id) // 200 grows the set past its initial size
+	}
+
+	fmt.Printf("Monday:  %v (%d users)\n", monday, monday.Count())
+	fmt.Printf("Tuesday: %v (%d users)\n", tuesday, tuesday.Count())
+	fmt.Printf("Either day: %v\n", monday.Union(tuesday))
+	fmt.Printf("Both days:  %v\n", monday.Intersect(tuesday))
+	fmt.Printf("Only Monday: %v\n", monday.Difference(tuesday))
+	fmt.Printf("User 64 on Tuesday? %t\n", tuesday.Test(64))
+
+	tuesday.Clear(200)
+	fmt.Printf("Tuesday after clearing 200: %v\n", tuesday)
+	fmt.Printf("%d bits fit in %s, versus one map entry per user\n",
+		tuesday.Len(), FormatBytes(int64(tuesday.Len()/8)))
+
+	fmt.Println()
+}
+
+// lruCacheExample - a map plus a linked list gives O(1) LRU eviction
+func lruCacheExample() {
+	fmt.Println(Bold("11. LRU Cache with a Linked List:"))
+
+	// The list on its own: a type-safe deque with insertion in the middle
+	list := NewLinkedList[string]()
+	list.PushBack("b")
+	list.PushBack("d")
+	list.PushFront("a")
+	list.InsertBefore("c", list.Back())
+	list.PushBack("e")
+	first, _ := list.PopFront()
+	last, _ := list.PopBack()
+	var remaining []string
+	list.ForEach(func(v string) bool {
+		remaining = append(remaining, v)
+		return true
+	})
+	fmt.Printf("Popped %q and %q, remaining %v (len %d)\n", first, last, remaining, list.Len())
+
+	// The cache keeps the most recently used page at the front of its list
+	cache := NewLRUCache[string, int](3)
+	for _, page := range []string{"/home", "/about", "/blog"} {
+		cache.Put(page, len(page))
+	}
+	fmt.Printf("After loading 3 pages: %v\n", cache.Keys())
+
+	cache.Get("/home")
+	fmt.Printf("After reading /home:   %v\n", cache.Keys())
+
+	if evicted, ok := cache.Put("/contact", 8); ok {
+		fmt.Printf("Adding /contact evicted %s: %v\n", evicted, cache.Keys())
+	}
+	if _, ok := cache.Get("/about"); !ok {
+		fmt.Println("/about is no longer cached")
+	}
+
+	fmt.Println()
+}
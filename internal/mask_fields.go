@@ -0,0 +1,127 @@
+// mask_fields.go
+package internal
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// maskedValue replaces the value of every masked field
+const maskedValue = "***"
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// MaskFields converts the struct v (or a pointer to one) into a
+// map[string]interface{} keyed by JSON field names, with every field
+// tagged `mask:"true"` replaced by "***". A non-empty tag also masks
+// fields whose mask tag names it, so `mask:"pii"` fields are hidden by
+// MaskFields(v, "pii") but shown by MaskFields(v, ""). Nested structs,
+// pointers, slices and maps are converted recursively; fields tagged
+// `json:"-"` are dropped as encoding/json would. Marshaling the result
+// gives v's JSON with the sensitive values hidden but their keys kept.
+func MaskFields(v interface{}, tag string) (interface{}, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("MaskFields needs a struct or struct pointer, got %T", v)
+	}
+	return maskValue(rv, tag), nil
+}
+
+func maskValue(v reflect.Value, tag string) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	// Types that choose their own JSON form, such as time.Time, stay whole
+	if v.Type().Implements(jsonMarshalerType) || v.Type().Implements(textMarshalerType) {
+		return v.Interface()
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return maskValue(v.Elem(), tag)
+	case reflect.Struct:
+		out := make(map[string]interface{})
+		maskStruct(v, tag, out)
+		return out
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Interface() // []byte stays base64 in JSON
+		}
+		out := make([]interface{}, v.Len())
+		for i := range out {
+			out[i] = maskValue(v.Index(i), tag)
+		}
+		return out
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		out := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			out[fmt.Sprint(iter.Key().Interface())] = maskValue(iter.Value(), tag)
+		}
+		return out
+	}
+	return v.Interface()
+}
+
+// maskStruct adds v's exported fields to out, flattening untagged embedded
+// structs into the parent as encoding/json does
+func maskStruct(v reflect.Value, tag string, out map[string]interface{}) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+
+		fv := v.Field(i)
+		if field.Anonymous && name == "" {
+			// An embedded struct's exported fields are promoted even when
+			// the struct type itself is unexported, unless it is a pointer
+			embedded := fv
+			if embedded.Kind() == reflect.Ptr {
+				if embedded.IsNil() || !field.IsExported() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				maskStruct(embedded, tag, out)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if strings.Contains(","+opts+",", ",omitempty,") && fv.IsZero() {
+			continue
+		}
+
+		if mask := field.Tag.Get("mask"); mask == "true" || (tag != "" && mask == tag) {
+			out[name] = maskedValue
+			continue
+		}
+		out[name] = maskValue(fv, tag)
+	}
+}
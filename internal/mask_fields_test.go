@@ -0,0 +1,76 @@
+// mask_fields_test.go
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+type maskCard struct {
+	Number string `json:"number" mask:"true"`
+	Expiry string `json:"expiry"`
+}
+
+type maskAudit struct {
+	CreatedBy string `json:"created_by" mask:"pii"`
+}
+
+type maskAccount struct {
+	maskAudit
+	ID       int               `json:"id"`
+	Email    string            `json:"email" mask:"pii"`
+	Password string            `json:"-"`
+	Note     string            `json:"note,omitempty"`
+	Card     *maskCard         `json:"card"`
+	Backups  []maskCard        `json:"backups"`
+	Labels   map[string]string `json:"labels"`
+	Joined   time.Time         `json:"joined"`
+	internal string
+}
+
+func maskedJSON(t *testing.T, v interface{}, tag string) string {
+	t.Helper()
+	masked, err := MaskFields(v, tag)
+	testutil.AssertNoError(t, err)
+	data, err := json.Marshal(masked)
+	testutil.AssertNoError(t, err)
+	return string(data)
+}
+
+func TestMaskFieldsNested(t *testing.T) {
+	account := &maskAccount{
+		maskAudit: maskAudit{CreatedBy: "admin"},
+		ID:        7,
+		Email:     "a@example.com",
+		Password:  "hunter2",
+		Card:      &maskCard{Number: "4111", Expiry: "12/30"},
+		Backups:   []maskCard{{Number: "5500", Expiry: "01/29"}},
+		Labels:    map[string]string{"tier": "gold"},
+		Joined:    time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		internal:  "x",
+	}
+
+	testutil.AssertEqual(t, maskedJSON(t, account, ""),
+		`{"backups":[{"expiry":"01/29","number":"***"}],"card":{"expiry":"12/30","number":"***"},`+
+			`"created_by":"admin","email":"a@example.com","id":7,"joined":"2024-05-01T00:00:00Z","labels":{"tier":"gold"}}`)
+
+	// Naming a tag hides those fields too, including in embedded structs
+	testutil.AssertEqual(t, maskedJSON(t, *account, "pii"),
+		`{"backups":[{"expiry":"01/29","number":"***"}],"card":{"expiry":"12/30","number":"***"},`+
+			`"created_by":"***","email":"***","id":7,"joined":"2024-05-01T00:00:00Z","labels":{"tier":"gold"}}`)
+
+	testutil.AssertEqual(t, account.Card.Number, "4111") // the original is untouched
+}
+
+func TestMaskFieldsNilAndNonStruct(t *testing.T) {
+	testutil.AssertEqual(t, maskedJSON(t, maskAccount{}, ""),
+		`{"backups":null,"card":null,"created_by":"","email":"","id":0,"joined":"0001-01-01T00:00:00Z","labels":null}`)
+
+	_, err := MaskFields([]int{1}, "")
+	testutil.AssertContains(t, err.Error(), "got []int")
+	_, err = MaskFields((*maskAccount)(nil), "")
+	testutil.AssertContains(t, err.Error(), "got *internal.maskAccount")
+}
@@ -0,0 +1,144 @@
+// mem_file.go
+package internal
+
+import (
+	"errors"
+	"io"
+	"os"
+	"sync"
+)
+
+// MemFile is an in-memory stand-in for *os.File that follows the same
+// read, write and seek rules: reading at or past the end returns io.EOF,
+// seeking past the end is allowed, and writing there zero-fills the gap.
+type MemFile struct {
+	mu     sync.Mutex
+	data   []byte
+	pos    int64
+	closed bool
+}
+
+// NewMemFile creates a MemFile holding a copy of data, positioned at the start
+func NewMemFile(data []byte) *MemFile {
+	return &MemFile{data: append([]byte(nil), data...)}
+}
+
+// Read reads from the current position
+func (f *MemFile) Read(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return 0, os.ErrClosed
+	}
+	if f.pos >= int64(len(f.data)) {
+		return 0, io.EOF
+	}
+	n := copy(p, f.data[f.pos:])
+	f.pos += int64(n)
+	return n, nil
+}
+
+// Write writes at the current position, growing the file as needed
+func (f *MemFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return 0, os.ErrClosed
+	}
+	end := f.pos + int64(len(p))
+	if end > int64(len(f.data)) {
+		f.resize(end)
+	}
+	n := copy(f.data[f.pos:], p)
+	f.pos += int64(n)
+	return n, nil
+}
+
+// WriteString is Write for a string, mirroring (*os.File).WriteString
+func (f *MemFile) WriteString(s string) (int, error) {
+	return f.Write([]byte(s))
+}
+
+// Seek sets the position for the next Read or Write. Positions past the end
+// are valid; negative ones are not.
+func (f *MemFile) Seek(offset int64, whence int) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return 0, os.ErrClosed
+	}
+
+	var base int64
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		base = f.pos
+	case io.SeekEnd:
+		base = int64(len(f.data))
+	default:
+		return 0, errors.New("memfile: invalid whence")
+	}
+
+	pos := base + offset
+	if pos < 0 {
+		return 0, errors.New("memfile: negative position")
+	}
+	f.pos = pos
+	return pos, nil
+}
+
+// Truncate changes the size of the file without moving the position.
+// Growing the file fills the new space with zeros.
+func (f *MemFile) Truncate(size int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return os.ErrClosed
+	}
+	if size < 0 {
+		return errors.New("memfile: negative size")
+	}
+	f.resize(size)
+	return nil
+}
+
+// resize grows (zero-filled) or shrinks data to size; the caller holds f.mu
+func (f *MemFile) resize(size int64) {
+	if size <= int64(len(f.data)) {
+		f.data = f.data[:size]
+		return
+	}
+	if size <= int64(cap(f.data)) {
+		// Reused capacity may hold bytes from before an earlier shrink
+		old := len(f.data)
+		f.data = f.data[:size]
+		clear(f.data[old:])
+		return
+	}
+	grown := make([]byte, size, size*2)
+	copy(grown, f.data)
+	f.data = grown
+}
+
+// Bytes returns a copy of the current contents
+func (f *MemFile) Bytes() []byte {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]byte(nil), f.data...)
+}
+
+// Close marks the file closed; later operations return os.ErrClosed
+func (f *MemFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return os.ErrClosed
+	}
+	f.closed = true
+	return nil
+}
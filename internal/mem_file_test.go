@@ -0,0 +1,102 @@
+// mem_file_test.go
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// seekableFile is the part of *os.File that MemFile mirrors
+type seekableFile interface {
+	io.ReadWriteSeeker
+	io.Closer
+	Truncate(size int64) error
+}
+
+// fileScript runs the same sequence of operations the file examples use
+// and logs every result, so two implementations can be compared
+func fileScript(f seekableFile) string {
+	var log strings.Builder
+	step := func(name string, n int64, err error) {
+		fmt.Fprintf(&log, "%s=%d,%v;", name, n, err)
+	}
+	read := func(size int) {
+		buf := make([]byte, size)
+		n, err := f.Read(buf)
+		fmt.Fprintf(&log, "read=%q,%v;", buf[:n], err)
+	}
+
+	n, err := f.Write([]byte("hello world"))
+	step("write", int64(n), err)
+	pos, err := f.Seek(0, io.SeekStart)
+	step("seek-start", pos, err)
+	read(5)
+	pos, err = f.Seek(1, io.SeekCurrent)
+	step("seek-current", pos, err)
+	read(100)
+	read(1)
+	pos, err = f.Seek(-5, io.SeekEnd)
+	step("seek-end", pos, err)
+	n, err = f.Write([]byte("WORLD!"))
+	step("overwrite", int64(n), err)
+	pos, err = f.Seek(3, io.SeekEnd)
+	step("past-end", pos, err)
+	n, err = f.Write([]byte("x"))
+	step("gap-write", int64(n), err)
+	step("truncate", 0, f.Truncate(4))
+	pos, err = f.Seek(0, io.SeekCurrent)
+	step("pos-after-truncate", pos, err)
+	step("grow", 0, f.Truncate(6))
+	f.Seek(0, io.SeekStart)
+	read(100)
+	_, err = f.Seek(-1, io.SeekStart)
+	fmt.Fprintf(&log, "negative-seek-fails=%v;", err != nil)
+	return log.String()
+}
+
+func TestMemFileMatchesOSFile(t *testing.T) {
+	osFile, err := os.Create(filepath.Join(t.TempDir(), "f.txt"))
+	testutil.AssertNoError(t, err)
+	defer osFile.Close()
+
+	want := fileScript(osFile)
+	got := fileScript(NewMemFile(nil))
+	testutil.AssertEqual(t, got, want)
+}
+
+func TestMemFileContents(t *testing.T) {
+	f := NewMemFile([]byte("abc"))
+	f.Seek(5, io.SeekStart)
+	f.WriteString("z")
+	testutil.AssertEqual(t, bytes.Equal(f.Bytes(), []byte("abc\x00\x00z")), true)
+
+	// shrinking then regrowing must not resurrect old bytes
+	f.Truncate(1)
+	f.Truncate(4)
+	testutil.AssertEqual(t, bytes.Equal(f.Bytes(), []byte("a\x00\x00\x00")), true)
+	testutil.AssertEqual(t, f.Truncate(-1) != nil, true)
+
+	// Bytes returns a copy
+	snapshot := f.Bytes()
+	snapshot[0] = 'X'
+	testutil.AssertEqual(t, f.Bytes()[0], byte('a'))
+}
+
+func TestMemFileClose(t *testing.T) {
+	f := NewMemFile([]byte("data"))
+	testutil.AssertNoError(t, f.Close())
+	testutil.AssertErrorIs(t, f.Close(), os.ErrClosed)
+	_, err := f.Read(make([]byte, 1))
+	testutil.AssertErrorIs(t, err, os.ErrClosed)
+	_, err = f.Write([]byte("x"))
+	testutil.AssertErrorIs(t, err, os.ErrClosed)
+	_, err = f.Seek(0, io.SeekStart)
+	testutil.AssertErrorIs(t, err, os.ErrClosed)
+}
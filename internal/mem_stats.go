@@ -0,0 +1,68 @@
+// mem_stats.go
+package internal
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// MemStats is a point-in-time copy of the runtime memory counters we care about
+type MemStats struct {
+	Alloc       uint64 // bytes of live heap objects
+	TotalAlloc  uint64 // cumulative bytes allocated (never decreases)
+	Sys         uint64 // bytes obtained from the OS
+	Mallocs     uint64 // cumulative heap objects allocated
+	HeapObjects uint64 // live heap objects
+	NumGC       uint32 // completed GC cycles
+}
+
+// MemDelta is the difference between two MemStats snapshots
+type MemDelta struct {
+	AllocBytes      int64 // change in live heap (negative if a GC freed memory)
+	TotalAllocBytes int64 // bytes allocated between the snapshots
+	Mallocs         int64 // objects allocated between the snapshots
+	NumGC           int64 // GC cycles completed between the snapshots
+}
+
+// MemSnapshot reads the current runtime memory statistics
+func MemSnapshot() MemStats {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return MemStats{
+		Alloc:       m.Alloc,
+		TotalAlloc:  m.TotalAlloc,
+		Sys:         m.Sys,
+		Mallocs:     m.Mallocs,
+		HeapObjects: m.HeapObjects,
+		NumGC:       m.NumGC,
+	}
+}
+
+// Diff reports how memory usage changed from s to the newer snapshot
+func (s MemStats) Diff(newer MemStats) MemDelta {
+	return MemDelta{
+		AllocBytes:      int64(newer.Alloc) - int64(s.Alloc),
+		TotalAllocBytes: int64(newer.TotalAlloc - s.TotalAlloc),
+		Mallocs:         int64(newer.Mallocs - s.Mallocs),
+		NumGC:           int64(newer.NumGC) - int64(s.NumGC),
+	}
+}
+
+// String renders the delta in human-readable units
+func (d MemDelta) String() string {
+	liveSign := "+"
+	if d.AllocBytes < 0 {
+		liveSign = ""
+	}
+	return fmt.Sprintf("allocated %s in %d objects, live heap %s%s, %d GC cycles",
+		FormatBytes(d.TotalAllocBytes), d.Mallocs, liveSign, FormatBytes(d.AllocBytes), d.NumGC)
+}
+
+// MeasureAlloc forces a GC so the baseline is clean, runs fn and reports
+// how much memory it allocated
+func MeasureAlloc(fn func()) MemDelta {
+	runtime.GC()
+	before := MemSnapshot()
+	fn()
+	return before.Diff(MemSnapshot())
+}
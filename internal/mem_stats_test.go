@@ -0,0 +1,38 @@
+// mem_stats_test.go
+package internal
+
+import (
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+var memSink [][]byte
+
+func TestMeasureAllocReportsAllocation(t *testing.T) {
+	delta := MeasureAlloc(func() {
+		for i := 0; i < 100; i++ {
+			memSink = append(memSink, make([]byte, 1<<10))
+		}
+	})
+	memSink = nil
+
+	if delta.TotalAllocBytes < 100<<10 {
+		t.Errorf("TotalAllocBytes = %d, want at least %d", delta.TotalAllocBytes, 100<<10)
+	}
+	if delta.Mallocs < 100 {
+		t.Errorf("Mallocs = %d, want at least 100", delta.Mallocs)
+	}
+}
+
+func TestMemStatsDiff(t *testing.T) {
+	older := MemStats{Alloc: 4096, TotalAlloc: 10000, Mallocs: 10, NumGC: 2}
+	newer := MemStats{Alloc: 1024, TotalAlloc: 12048, Mallocs: 13, NumGC: 3}
+	delta := older.Diff(newer)
+
+	testutil.AssertEqual(t, delta, MemDelta{AllocBytes: -3072, TotalAllocBytes: 2048, Mallocs: 3, NumGC: 1})
+	text := delta.String()
+	testutil.AssertContains(t, text, "in 3 objects")
+	testutil.AssertContains(t, text, "1 GC cycles")
+	testutil.AssertContains(t, text, "live heap -")
+}
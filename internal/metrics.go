@@ -0,0 +1,73 @@
+// metrics.go
+package internal
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// Metrics is a goroutine-safe set of named counters and gauges. Each name
+// maps to its own atomic value, so updates to existing names never take a
+// lock; only the first use of a name pays for an insertion.
+type Metrics struct {
+	values sync.Map // name -> *atomic.Int64
+}
+
+// NewMetrics creates an empty collector
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+func (m *Metrics) value(name string) *atomic.Int64 {
+	if v, ok := m.values.Load(name); ok {
+		return v.(*atomic.Int64)
+	}
+	v, _ := m.values.LoadOrStore(name, new(atomic.Int64))
+	return v.(*atomic.Int64)
+}
+
+// Inc adds one to the counter name
+func (m *Metrics) Inc(name string) {
+	m.value(name).Add(1)
+}
+
+// Add adds delta (which may be negative) to name
+func (m *Metrics) Add(name string, delta int64) {
+	m.value(name).Add(delta)
+}
+
+// Set overwrites name with value, for gauges such as queue length
+func (m *Metrics) Set(name string, value int64) {
+	m.value(name).Store(value)
+}
+
+// Get returns the current value of name, or 0 if it was never touched
+func (m *Metrics) Get(name string) int64 {
+	if v, ok := m.values.Load(name); ok {
+		return v.(*atomic.Int64).Load()
+	}
+	return 0
+}
+
+// Snapshot copies every metric. Values are read one by one, so a snapshot
+// taken during updates is not a single atomic cut across names.
+func (m *Metrics) Snapshot() map[string]int64 {
+	snapshot := make(map[string]int64)
+	m.values.Range(func(key, value interface{}) bool {
+		snapshot[key.(string)] = value.(*atomic.Int64).Load()
+		return true
+	})
+	return snapshot
+}
+
+// Names returns the metric names in sorted order
+func (m *Metrics) Names() []string {
+	var names []string
+	m.values.Range(func(key, _ interface{}) bool {
+		names = append(names, key.(string))
+		return true
+	})
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,55 @@
+// metrics_test.go
+package internal
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestMetricsConcurrentIncrements(t *testing.T) {
+	m := NewMetrics()
+	const goroutines, perGoroutine = 32, 1000
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				m.Inc("processed")
+				if i%10 == 0 {
+					m.Add("errors", 1)
+				}
+			}
+			m.Set("last_worker", int64(g))
+		}(g)
+	}
+	wg.Wait()
+
+	testutil.AssertEqual(t, m.Get("processed"), int64(goroutines*perGoroutine))
+	testutil.AssertEqual(t, m.Get("errors"), int64(goroutines*perGoroutine/10))
+	if last := m.Get("last_worker"); last < 0 || last >= goroutines {
+		t.Errorf("last_worker = %d, want a worker index", last)
+	}
+}
+
+func TestMetricsSnapshotAndNames(t *testing.T) {
+	m := NewMetrics()
+	m.Add("queue", 5)
+	m.Add("queue", -2)
+	m.Set("workers", 4)
+	m.Inc("done")
+
+	snapshot := m.Snapshot()
+	testutil.AssertEqual(t, len(snapshot), 3)
+	testutil.AssertEqual(t, snapshot["queue"], int64(3))
+	testutil.AssertEqual(t, strings.Join(m.Names(), ","), "done,queue,workers")
+	testutil.AssertEqual(t, m.Get("missing"), int64(0))
+
+	// a snapshot is a copy
+	snapshot["queue"] = 99
+	testutil.AssertEqual(t, m.Get("queue"), int64(3))
+}
@@ -0,0 +1,57 @@
+// must.go
+package internal
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+)
+
+// mustPanic marks a panic raised by Must or Check so Try can tell it apart
+// from genuine bugs, which it lets propagate
+type mustPanic struct {
+	err error
+}
+
+// Must returns v, or panics if err is non-nil. It is meant for
+// initialization code where an error means the program cannot continue;
+// wrap the caller in Try to turn the panic back into an error.
+func Must[T any](v T, err error) T {
+	if err != nil {
+		panic(mustPanic{fmt.Errorf("%s: %w", callerLocation(2), err)})
+	}
+	return v
+}
+
+// Check panics if err is non-nil, annotating it with the caller's file and
+// line
+func Check(err error) {
+	if err != nil {
+		panic(mustPanic{fmt.Errorf("%s: %w", callerLocation(2), err)})
+	}
+}
+
+// Try runs fn and returns its error. A panic raised by Must or Check inside
+// fn is recovered and returned as the error instead; any other panic is
+// re-raised untouched.
+func Try(fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			mp, ok := r.(mustPanic)
+			if !ok {
+				panic(r)
+			}
+			err = mp.err
+		}
+	}()
+	return fn()
+}
+
+// callerLocation reports "file.go:line" for the function skip frames up
+func callerLocation(skip int) string {
+	_, file, line, ok := runtime.Caller(skip)
+	if !ok {
+		return "unknown"
+	}
+	return fmt.Sprintf("%s:%d", filepath.Base(file), line)
+}
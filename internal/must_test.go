@@ -0,0 +1,49 @@
+// must_test.go
+package internal
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestMustReturnsValue(t *testing.T) {
+	testutil.AssertEqual(t, Must(strconv.Atoi("42")), 42)
+	testutil.AssertEqual(t, Must("ok", nil), "ok")
+}
+
+func TestTryRecoversMust(t *testing.T) {
+	err := Try(func() error {
+		n := Must(strconv.Atoi("forty-two"))
+		t.Errorf("Must returned %d instead of panicking", n)
+		return nil
+	})
+	var numErr *strconv.NumError
+	testutil.AssertEqual(t, errors.As(err, &numErr), true)
+	testutil.AssertContains(t, err.Error(), "must_test.go:")
+}
+
+func TestTryRecoversCheck(t *testing.T) {
+	errClosed := errors.New("closed")
+	err := Try(func() error {
+		Check(nil)
+		Check(errClosed)
+		return nil
+	})
+	testutil.AssertErrorIs(t, err, errClosed)
+	testutil.AssertContains(t, err.Error(), "must_test.go:")
+}
+
+func TestTryPassesThroughErrorsAndOtherPanics(t *testing.T) {
+	errPlain := errors.New("plain")
+	testutil.AssertErrorIs(t, Try(func() error { return errPlain }), errPlain)
+	testutil.AssertNoError(t, Try(func() error { return nil }))
+
+	defer func() {
+		testutil.AssertEqual(t, recover(), interface{}("real bug"))
+	}()
+	Try(func() error { panic("real bug") })
+	t.Errorf("Try swallowed a panic it did not raise")
+}
@@ -159,7 +159,7 @@ func fileInfoDemo() {
 	}
 
 	fmt.Printf("File name: %s\n", Green(fileInfo.Name()))
-	fmt.Printf("File size: %d bytes\n", fileInfo.Size())
+	fmt.Printf("File size: %s\n", FormatBytes(fileInfo.Size()))
 	fmt.Printf("File mode: %s\n", Cyan(fileInfo.Mode().String()))
 	fmt.Printf("Is directory: %t\n", fileInfo.IsDir())
 	fmt.Printf("Modification time: %s\n", Yellow(fileInfo.ModTime().Format(time.RFC3339)))
@@ -200,7 +200,7 @@ func fileInfoExample() {
 	}
 
 	fmt.Printf("File name: %s\n", Bold(fileInfo.Name()))
-	fmt.Printf("File size: %s bytes\n", Yellow(fmt.Sprintf("%d", fileInfo.Size())))
+	fmt.Printf("File size: %s\n", Yellow(FormatBytes(fileInfo.Size())))
 	fmt.Printf("File mode: %s\n", Cyan(fileInfo.Mode().String()))
 	fmt.Printf("Modification time: %s\n", Green(fileInfo.ModTime().Format("2006-01-02 15:04:05")))
 	fmt.Printf("Is directory: %t\n", fileInfo.IsDir())
@@ -4,6 +4,7 @@ package internal
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -46,6 +47,8 @@ type Config struct {
 type Logger struct {
 	prefix string
 	debug  bool
+	out    io.Writer // defaults to os.Stdout
+	trace  string    // set by LoggerFromContext; printed after the prefix
 }
 
 // Exported functions
@@ -76,9 +79,29 @@ func (c *Config) GetEndpoint() string {
 	return ""
 }
 
+// SetOutput redirects the logger, e.g. through a RedactingWriter
+func (l *Logger) SetOutput(w io.Writer) {
+	l.out = w
+}
+
+func (l *Logger) output() io.Writer {
+	if l.out == nil {
+		return os.Stdout
+	}
+	return l.out
+}
+
+// tag renders the line prefix, including the trace ID when there is one
+func (l *Logger) tag() string {
+	if l.trace == "" {
+		return "[" + l.prefix + "]"
+	}
+	return "[" + l.prefix + "] [trace=" + l.trace + "]"
+}
+
 func (l *Logger) Debug(message string) {
 	if l.debug {
-		fmt.Printf("[%s] DEBUG: %s\n", l.prefix, message)
+		fmt.Fprintf(l.output(), "%s DEBUG: %s\n", l.tag(), message)
 	}
 }
 
@@ -101,7 +124,9 @@ func loadConfigFromFile(filename string) (*Config, error) {
 
 // Package initialization
 func init() {
-	fmt.Println(InfoText("Package system initialized"))
+	// Written to stderr so machine-readable output (e.g. completion scripts)
+	// on stdout stays clean
+	fmt.Fprintln(os.Stderr, InfoText("Package system initialized"))
 	mrand.Seed(time.Now().UnixNano())
 }
 
@@ -117,6 +142,7 @@ func RunPackageSystemExamples() {
 	importPathExample()
 	blankImportExample()
 	packageTestingExample()
+	redactingLoggerExample()
 }
 
 // Example 1: Basic package usage
@@ -268,6 +294,32 @@ project/
 `
 	fmt.Println("Example structure:")
 	fmt.Println(packageStructure)
+
+	// Layers only work if imports point one way: order the packages so each
+	// one builds after everything it imports
+	graph := NewDependencyGraph()
+	graph.AddEdge("cmd", "internal/api")
+	graph.AddEdge("internal/api", "internal/auth")
+	graph.AddEdge("internal/api", "internal/db")
+	graph.AddEdge("internal/auth", "internal/db")
+	graph.AddEdge("internal/auth", "pkg/logger")
+	graph.AddEdge("internal/db", "pkg/config")
+	graph.AddEdge("internal/db", "pkg/logger")
+	graph.AddNode("pkg/version") // imports nothing and is imported by nothing
+
+	order, err := graph.TopoSort()
+	if err != nil {
+		fmt.Printf("Error ordering packages: %v\n", err)
+		return
+	}
+	fmt.Printf("Build order: %s\n", strings.Join(order, " → "))
+	fmt.Printf("Packages importing internal/db: %v\n", graph.Dependents("internal/db"))
+
+	// A lower layer importing a higher one creates an import cycle
+	graph.AddEdge("internal/db", "internal/api")
+	if _, err := graph.TopoSort(); err != nil {
+		fmt.Printf("After db imports api: %s\n", ErrorText(err.Error()))
+	}
 	fmt.Println()
 }
 
@@ -372,6 +424,26 @@ auth/
 	fmt.Println()
 }
 
+// Example 11: Keeping secrets out of log output
+func redactingLoggerExample() {
+	fmt.Println(Header("11. Redacting Logger Output"))
+
+	dbPassword, apiKey := "s3cr3t-pa55", "demo-key-123"
+	redactor := NewRedactingWriter(os.Stdout, dbPassword, apiKey)
+
+	logger := NewLogger("CONFIG")
+	logger.SetOutput(redactor)
+	logger.Log("connecting with password=" + dbPassword)
+	logger.Log("calling API with key " + apiKey)
+
+	// A secret split across two writes is still caught
+	io.WriteString(redactor, "[CONFIG] partial writes: s3cr")
+	io.WriteString(redactor, "3t-pa55 done\n")
+	redactor.Flush()
+
+	fmt.Println()
+}
+
 // Helper functions
 func getCurrentDir() string {
 	if dir, err := os.Getwd(); err == nil {
@@ -384,6 +456,6 @@ func getCurrentDir() string {
 func init() {
 	// This will run after the previous init function
 	if strings.Contains(os.Args[0], "test") {
-		fmt.Println(InfoText("Test mode detected"))
+		fmt.Fprintln(os.Stderr, InfoText("Test mode detected"))
 	}
 }
@@ -0,0 +1,84 @@
+// parse_int.go
+package internal
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseIntDefault parses s as a base-10 int, returning def if s is empty or
+// not a valid number
+func ParseIntDefault(s string, def int) int {
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+// ParseInRange parses s as a base-10 int and fails unless min <= n <= max
+func ParseInRange(s string, min, max int) (int, error) {
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("parse int %q: invalid number", s)
+	}
+	if n < min || n > max {
+		return 0, fmt.Errorf("parse int %q: %d out of range [%d, %d]", s, n, min, max)
+	}
+	return n, nil
+}
+
+// humanIntSuffixes are the decimal multipliers ParseHumanInt accepts
+var humanIntSuffixes = map[string]int64{
+	"":  1,
+	"K": 1e3,
+	"M": 1e6,
+	"G": 1e9,
+	"B": 1e9, // as in "2B users"
+	"T": 1e12,
+}
+
+// ParseHumanInt parses counts written with a decimal suffix: "10k" is
+// 10000, "2M" is 2000000, "1.5k" is 1500. Suffixes are case-insensitive;
+// fractions are allowed only if the result is a whole number. For byte
+// sizes with binary units use ParseBytes.
+func ParseHumanInt(s string) (int64, error) {
+	text := strings.TrimSpace(s)
+	if text == "" {
+		return 0, fmt.Errorf("parse human int %q: empty value", s)
+	}
+
+	i := len(text)
+	for i > 0 && (text[i-1] < '0' || text[i-1] > '9') && text[i-1] != '.' {
+		i--
+	}
+	numPart, suffix := text[:i], strings.ToUpper(strings.TrimSpace(text[i:]))
+
+	multiplier, ok := humanIntSuffixes[suffix]
+	if !ok {
+		return 0, fmt.Errorf("parse human int %q: unknown suffix %q", s, text[i:])
+	}
+
+	// Whole numbers are multiplied exactly; fractions go through float64
+	if n, err := strconv.ParseInt(numPart, 10, 64); err == nil {
+		if n > math.MaxInt64/multiplier || n < math.MinInt64/multiplier {
+			return 0, fmt.Errorf("parse human int %q: value out of range", s)
+		}
+		return n * multiplier, nil
+	}
+
+	f, err := strconv.ParseFloat(numPart, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse human int %q: invalid number", s)
+	}
+	result := f * float64(multiplier)
+	if result >= math.MaxInt64 || result < math.MinInt64 {
+		return 0, fmt.Errorf("parse human int %q: value out of range", s)
+	}
+	if result != math.Trunc(result) {
+		return 0, fmt.Errorf("parse human int %q: not a whole number", s)
+	}
+	return int64(result), nil
+}
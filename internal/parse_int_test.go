@@ -0,0 +1,78 @@
+// parse_int_test.go
+package internal
+
+import (
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestParseIntDefault(t *testing.T) {
+	testutil.AssertEqual(t, ParseIntDefault("42", 7), 42)
+	testutil.AssertEqual(t, ParseIntDefault(" -3 ", 7), -3)
+	testutil.AssertEqual(t, ParseIntDefault("", 7), 7)
+	testutil.AssertEqual(t, ParseIntDefault("4.2", 7), 7)
+	testutil.AssertEqual(t, ParseIntDefault("99999999999999999999", 7), 7)
+}
+
+func TestParseInRange(t *testing.T) {
+	n, err := ParseInRange("8080", 1, 65535)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, n, 8080)
+
+	n, err = ParseInRange("1", 1, 1) // bounds are inclusive
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, n, 1)
+
+	_, err = ParseInRange("70000", 1, 65535)
+	testutil.AssertEqual(t, err.Error(), `parse int "70000": 70000 out of range [1, 65535]`)
+	_, err = ParseInRange("0", 1, 10)
+	testutil.AssertEqual(t, err.Error(), `parse int "0": 0 out of range [1, 10]`)
+	_, err = ParseInRange("ten", 1, 10)
+	testutil.AssertEqual(t, err.Error(), `parse int "ten": invalid number`)
+}
+
+func TestParseHumanInt(t *testing.T) {
+	cases := []struct {
+		in   string
+		want int64
+	}{
+		{"42", 42},
+		{"10k", 10000},
+		{"10K", 10000},
+		{"2M", 2000000},
+		{"1.5k", 1500},
+		{"3 G", 3e9},
+		{"2b", 2e9},
+		{"1T", 1e12},
+		{"-2k", -2000},
+		{"9223372036854775807", 9223372036854775807},
+	}
+	for _, c := range cases {
+		got, err := ParseHumanInt(c.in)
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, got, c.want)
+	}
+}
+
+func TestParseHumanIntErrors(t *testing.T) {
+	cases := []struct {
+		in, want string
+	}{
+		{"", `parse human int "": empty value`},
+		{"10x", `parse human int "10x": unknown suffix "x"`},
+		{"5KB", `parse human int "5KB": unknown suffix "KB"`},
+		{"k", `parse human int "k": invalid number`},
+		{"1.2345k", `parse human int "1.2345k": not a whole number`},
+		{"10000000T", `parse human int "10000000T": value out of range`},
+		{"9.5e9G", `parse human int "9.5e9G": value out of range`},
+	}
+	for _, c := range cases {
+		_, err := ParseHumanInt(c.in)
+		if err == nil {
+			t.Errorf("ParseHumanInt(%q) succeeded", c.in)
+			continue
+		}
+		testutil.AssertEqual(t, err.Error(), c.want)
+	}
+}
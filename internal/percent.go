@@ -0,0 +1,120 @@
+// percent.go
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// RoundMode selects how FormatPercent drops digits beyond the requested
+// precision
+type RoundMode int
+
+const (
+	RoundHalfUp   RoundMode = iota // ties go away from zero: 12.5 -> 13, -12.5 -> -13
+	RoundHalfEven                  // ties go to the even digit: 12.5 -> 12, 13.5 -> 14
+	RoundTruncate                  // extra digits are dropped: 12.9 -> 12, -12.9 -> -12
+)
+
+// ErrDivideByZero is returned by Ratio when whole is zero
+var ErrDivideByZero = errors.New("division by zero")
+
+// Ratio returns part/whole, refusing a zero whole instead of producing
+// Inf or NaN
+func Ratio(part, whole float64) (float64, error) {
+	if whole == 0 {
+		return 0, fmt.Errorf("ratio %g/%g: %w", part, whole, ErrDivideByZero)
+	}
+	return part / whole, nil
+}
+
+// FormatPercent renders ratio as a percentage with the given number of
+// decimals, e.g. FormatPercent(0.125, 0, RoundHalfEven) == "12%". The
+// rounding works on the shortest decimal form of ratio rather than on
+// ratio*100 in floating point, so a value written as 0.145 really is a tie
+// at one decimal. Ratios above 1 and below 0 are fine: 1.5 is "150%".
+func FormatPercent(ratio float64, decimals int, mode RoundMode) string {
+	if math.IsNaN(ratio) || math.IsInf(ratio, 0) {
+		return fmt.Sprintf("%v%%", ratio)
+	}
+	if decimals < 0 {
+		decimals = 0
+	}
+
+	// ratio = 0.d1d2d3... x 10^(exp+1); multiplying by 100 adds 2 to exp
+	mantissa := strconv.FormatFloat(math.Abs(ratio), 'e', -1, 64)
+	mark := strings.IndexByte(mantissa, 'e')
+	digits := strings.Replace(mantissa[:mark], ".", "", 1)
+	exp, _ := strconv.Atoi(mantissa[mark+1:])
+	exp += 2
+
+	// Keep the digits that make up round(|ratio| x 100 x 10^decimals) and
+	// decide from the remainder whether to bump the last one
+	keep := exp + 1 + decimals
+	var kept, rest string
+	switch {
+	case keep <= 0:
+		rest = strings.Repeat("0", -keep) + digits
+	case keep >= len(digits):
+		kept = digits + strings.Repeat("0", keep-len(digits))
+	default:
+		kept, rest = digits[:keep], digits[keep:]
+	}
+	if roundsUp(kept, rest, mode) {
+		kept = incrementDigits(kept)
+	}
+
+	// Place the decimal point, padding so there is at least one integer digit
+	if len(kept) <= decimals {
+		kept = strings.Repeat("0", decimals+1-len(kept)) + kept
+	}
+	whole := strings.TrimLeft(kept[:len(kept)-decimals], "0")
+	if whole == "" {
+		whole = "0"
+	}
+	text := whole
+	if decimals > 0 {
+		text += "." + kept[len(kept)-decimals:]
+	}
+
+	if ratio < 0 && strings.Trim(kept, "0") != "" {
+		text = "-" + text
+	}
+	return text + "%"
+}
+
+// roundsUp reports whether dropping rest should increment kept under mode
+func roundsUp(kept, rest string, mode RoundMode) bool {
+	if rest == "" || mode == RoundTruncate {
+		return false
+	}
+	switch {
+	case rest[0] > '5':
+		return true
+	case rest[0] < '5':
+		return false
+	case strings.Trim(rest[1:], "0") != "":
+		return true // more than half
+	case mode == RoundHalfUp:
+		return true
+	}
+	// An exact tie under RoundHalfEven: round up only from an odd digit
+	return kept != "" && (kept[len(kept)-1]-'0')%2 == 1
+}
+
+// incrementDigits adds one to a string of decimal digits, carrying as far
+// as needed ("199" -> "200", "99" -> "100", "" -> "1")
+func incrementDigits(digits string) string {
+	b := []byte(digits)
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] < '9' {
+			b[i]++
+			return string(b)
+		}
+		b[i] = '0'
+	}
+	return "1" + string(b)
+}
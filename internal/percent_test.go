@@ -0,0 +1,65 @@
+// percent_test.go
+package internal
+
+import (
+	"math"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestFormatPercentRoundingModes(t *testing.T) {
+	cases := []struct {
+		ratio    float64
+		decimals int
+		mode     RoundMode
+		want     string
+	}{
+		// Ties at the .5 boundary
+		{0.125, 0, RoundHalfUp, "13%"},
+		{0.125, 0, RoundHalfEven, "12%"},
+		{0.125, 0, RoundTruncate, "12%"},
+		{0.135, 0, RoundHalfUp, "14%"},
+		{0.135, 0, RoundHalfEven, "14%"},
+		{0.145, 1, RoundHalfUp, "14.5%"},
+		{0.1445, 1, RoundHalfUp, "14.5%"},
+		{0.1445, 1, RoundHalfEven, "14.4%"},
+		{0.1455, 1, RoundHalfEven, "14.6%"},
+		{0.12501, 0, RoundHalfEven, "13%"}, // just past the tie
+		{0.129, 0, RoundTruncate, "12%"},
+
+		// Negative ratios round symmetrically
+		{-0.125, 0, RoundHalfUp, "-13%"},
+		{-0.125, 0, RoundHalfEven, "-12%"},
+		{-0.129, 0, RoundTruncate, "-12%"},
+		{-0.001, 0, RoundHalfUp, "0%"}, // no negative zero
+
+		// Over 100%, carries and padding
+		{1.5, 0, RoundHalfUp, "150%"},
+		{12.3456, 2, RoundHalfUp, "1234.56%"},
+		{0.9999, 1, RoundHalfUp, "100.0%"},
+		{0.00004, 3, RoundHalfUp, "0.004%"},
+		{0, 2, RoundHalfEven, "0.00%"},
+		{0.5, -1, RoundHalfUp, "50%"},
+	}
+	for _, c := range cases {
+		if got := FormatPercent(c.ratio, c.decimals, c.mode); got != c.want {
+			t.Errorf("FormatPercent(%v, %d, %d) = %q, want %q", c.ratio, c.decimals, c.mode, got, c.want)
+		}
+	}
+}
+
+func TestFormatPercentNonFinite(t *testing.T) {
+	testutil.AssertEqual(t, FormatPercent(math.NaN(), 1, RoundHalfUp), "NaN%")
+	testutil.AssertEqual(t, FormatPercent(math.Inf(-1), 1, RoundHalfUp), "-Inf%")
+}
+
+func TestRatio(t *testing.T) {
+	r, err := Ratio(3, 4)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, r, 0.75)
+
+	_, err = Ratio(3, 0)
+	testutil.AssertErrorIs(t, err, ErrDivideByZero)
+	testutil.AssertEqual(t, err.Error(), "ratio 3/0: division by zero")
+}
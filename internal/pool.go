@@ -0,0 +1,62 @@
+// pool.go
+package internal
+
+import (
+	"bytes"
+	"sync"
+)
+
+// Pool is a type-safe wrapper over sync.Pool. Like sync.Pool, pooled
+// values may be dropped at any garbage collection, so a Pool is a cache
+// for reducing allocations, never a place to keep state.
+type Pool[T any] struct {
+	pool sync.Pool
+}
+
+// NewPool creates a Pool that calls newFn whenever it has nothing to hand
+// out
+func NewPool[T any](newFn func() T) *Pool[T] {
+	p := &Pool[T]{}
+	p.pool.New = func() interface{} { return newFn() }
+	return p
+}
+
+// Get returns a pooled value, or a fresh one from newFn
+func (p *Pool[T]) Get() T {
+	return p.pool.Get().(T)
+}
+
+// Put returns v to the pool for reuse
+func (p *Pool[T]) Put(v T) {
+	p.pool.Put(v)
+}
+
+// maxPooledBuffer caps the capacity of buffers kept by BufferPool, so one
+// huge write does not pin its memory for the life of the pool
+const maxPooledBuffer = 64 << 10
+
+// BufferPool hands out empty *bytes.Buffers and takes them back
+type BufferPool struct {
+	pool *Pool[*bytes.Buffer]
+}
+
+// NewBufferPool creates an empty BufferPool
+func NewBufferPool() *BufferPool {
+	return &BufferPool{pool: NewPool(func() *bytes.Buffer { return new(bytes.Buffer) })}
+}
+
+// Get returns an empty buffer, reusing a pooled one when available
+func (p *BufferPool) Get() *bytes.Buffer {
+	buf := p.pool.Get()
+	buf.Reset()
+	return buf
+}
+
+// Put returns buf to the pool. The caller must not use buf afterwards;
+// buffers that grew beyond 64 KiB are left for the garbage collector.
+func (p *BufferPool) Put(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBuffer {
+		return
+	}
+	p.pool.Put(buf)
+}
@@ -0,0 +1,67 @@
+// pool_test.go
+package internal
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+type pooledThing struct{ id int }
+
+func TestPoolCallsNewWhenEmpty(t *testing.T) {
+	created := 0
+	p := NewPool(func() *pooledThing {
+		created++
+		return &pooledThing{id: created}
+	})
+
+	a, b := p.Get(), p.Get()
+	testutil.AssertEqual(t, created, 2)
+	testutil.AssertEqual(t, a.id, 1)
+	testutil.AssertEqual(t, b.id, 2)
+}
+
+func TestPoolReusesPutValues(t *testing.T) {
+	created := 0
+	p := NewPool(func() *pooledThing {
+		created++
+		return &pooledThing{}
+	})
+
+	// sync.Pool may drop any Put (the race detector does so on purpose),
+	// so allow a few tries before concluding nothing is reused
+	reused := false
+	for i := 0; i < 50 && !reused; i++ {
+		v := p.Get()
+		p.Put(v)
+		reused = p.Get() == v
+	}
+	testutil.AssertEqual(t, reused, true)
+	if created >= 100 {
+		t.Errorf("newFn ran %d times; Put values were never reused", created)
+	}
+}
+
+func TestBufferPoolReturnsEmptyBuffers(t *testing.T) {
+	p := NewBufferPool()
+	for i := 0; i < 20; i++ {
+		buf := p.Get()
+		testutil.AssertEqual(t, buf.Len(), 0)
+		buf.WriteString("leftover data")
+		p.Put(buf)
+	}
+}
+
+func TestBufferPoolDropsHugeBuffers(t *testing.T) {
+	p := NewBufferPool()
+	huge := bytes.NewBufferString(strings.Repeat("x", maxPooledBuffer+1))
+	for i := 0; i < 20; i++ {
+		p.Put(huge)
+		if p.Get() == huge {
+			t.Fatalf("a buffer over %d bytes was pooled", maxPooledBuffer)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+// pretty_print.go
+package internal
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PrintOptions controls how PrettyPrintValue renders nested data
+type PrintOptions struct {
+	Indent    string // indentation unit per nesting level (defaults to two spaces)
+	ShowTypes bool   // annotate containers and scalars with their Go type
+}
+
+// PrettyPrintValue recursively renders maps, slices and structs with consistent
+// indentation and sorted map keys. Unlike JSON marshaling it works purely on
+// reflection, so struct type names are preserved and unexported fields are
+// rendered instead of silently dropped. A pointer, map or slice that refers
+// back to a value currently being printed is shown as <cycle>.
+func PrettyPrintValue(w io.Writer, v interface{}, opts PrintOptions) {
+	if opts.Indent == "" {
+		opts.Indent = "  "
+	}
+	p := &prettyPrinter{w: w, opts: opts, onPath: make(map[pointerKey]bool)}
+	p.value(reflect.ValueOf(v), 0)
+	fmt.Fprintln(w)
+}
+
+type prettyPrinter struct {
+	w      io.Writer
+	opts   PrintOptions
+	onPath map[pointerKey]bool
+}
+
+// enter marks a reference as being printed, reporting false and printing
+// <cycle> when it is already on the current path. Callers that get true
+// must call leave once the value is printed.
+func (p *prettyPrinter) enter(v reflect.Value) bool {
+	visit := pointerKeyOf(v)
+	if p.onPath[visit] {
+		fmt.Fprint(p.w, "<cycle>")
+		return false
+	}
+	p.onPath[visit] = true
+	return true
+}
+
+func (p *prettyPrinter) leave(v reflect.Value) {
+	delete(p.onPath, pointerKeyOf(v))
+}
+
+func (p *prettyPrinter) indent(depth int) string {
+	return strings.Repeat(p.opts.Indent, depth)
+}
+
+// typePrefix returns the type annotation placed before an opening brace
+func (p *prettyPrinter) typePrefix(t reflect.Type) string {
+	if !p.opts.ShowTypes {
+		return ""
+	}
+	return t.String() + " "
+}
+
+func (p *prettyPrinter) value(v reflect.Value, depth int) {
+	if !v.IsValid() {
+		fmt.Fprint(p.w, "<nil>")
+		return
+	}
+
+	// Prefer a type's own String method for opaque values such as time.Time
+	if v.Kind() == reflect.Struct && v.CanInterface() {
+		if s, ok := v.Interface().(fmt.Stringer); ok {
+			p.scalar(s.String(), v.Type())
+			return
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			fmt.Fprint(p.w, "<nil>")
+			return
+		}
+		p.value(v.Elem(), depth)
+	case reflect.Ptr:
+		if v.IsNil() {
+			fmt.Fprint(p.w, "<nil>")
+			return
+		}
+		if !p.enter(v) {
+			return
+		}
+		defer p.leave(v)
+		p.value(v.Elem(), depth)
+	case reflect.Map:
+		p.mapValue(v, depth)
+	case reflect.Slice, reflect.Array:
+		p.sliceValue(v, depth)
+	case reflect.Struct:
+		p.structValue(v, depth)
+	default:
+		p.scalar(formatScalar(v), v.Type())
+	}
+}
+
+func (p *prettyPrinter) scalar(text string, t reflect.Type) {
+	if p.opts.ShowTypes {
+		fmt.Fprintf(p.w, "%s (%s)", text, t)
+		return
+	}
+	fmt.Fprint(p.w, text)
+}
+
+func (p *prettyPrinter) mapValue(v reflect.Value, depth int) {
+	if v.Len() == 0 {
+		fmt.Fprint(p.w, p.typePrefix(v.Type())+"{}")
+		return
+	}
+	if !p.enter(v) {
+		return
+	}
+	defer p.leave(v)
+
+	fmt.Fprintln(p.w, p.typePrefix(v.Type())+"{")
+	for _, key := range sortedMapKeys(v) {
+		fmt.Fprintf(p.w, "%s%s: ", p.indent(depth+1), formatScalar(key))
+		p.value(v.MapIndex(key), depth+1)
+		fmt.Fprintln(p.w)
+	}
+	fmt.Fprint(p.w, p.indent(depth)+"}")
+}
+
+func (p *prettyPrinter) sliceValue(v reflect.Value, depth int) {
+	if v.Len() == 0 {
+		fmt.Fprint(p.w, p.typePrefix(v.Type())+"[]")
+		return
+	}
+	if v.Kind() == reflect.Slice {
+		if !p.enter(v) {
+			return
+		}
+		defer p.leave(v)
+	}
+
+	fmt.Fprintln(p.w, p.typePrefix(v.Type())+"[")
+	for i := 0; i < v.Len(); i++ {
+		fmt.Fprint(p.w, p.indent(depth+1))
+		p.value(v.Index(i), depth+1)
+		fmt.Fprintln(p.w)
+	}
+	fmt.Fprint(p.w, p.indent(depth)+"]")
+}
+
+func (p *prettyPrinter) structValue(v reflect.Value, depth int) {
+	t := v.Type()
+	if t.NumField() == 0 {
+		fmt.Fprint(p.w, p.typePrefix(t)+"{}")
+		return
+	}
+
+	fmt.Fprintln(p.w, p.typePrefix(t)+"{")
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fmt.Fprintf(p.w, "%s%s: ", p.indent(depth+1), field.Name)
+		// Unexported fields can still be walked via reflection; only
+		// Interface() is off limits, which formatScalar never calls.
+		p.value(v.Field(i), depth+1)
+		fmt.Fprintln(p.w)
+	}
+	fmt.Fprint(p.w, p.indent(depth)+"}")
+}
+
+// formatScalar renders basic kinds without calling Interface(), so it is safe
+// for values reached through unexported struct fields
+func formatScalar(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return strconv.Quote(v.String())
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case reflect.Complex64, reflect.Complex128:
+		return strconv.FormatComplex(v.Complex(), 'g', -1, 128)
+	case reflect.Interface, reflect.Ptr:
+		if v.IsNil() {
+			return "<nil>"
+		}
+		return formatScalar(v.Elem())
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if v.IsNil() {
+			return "<nil>"
+		}
+		return fmt.Sprintf("<%s>", v.Type())
+	default:
+		if v.CanInterface() {
+			return fmt.Sprintf("%v", v.Interface())
+		}
+		return fmt.Sprintf("<%s>", v.Type())
+	}
+}
+
+// sortedMapKeys orders map keys numerically when they are numbers and by
+// their rendered text otherwise, giving stable output across runs
+func sortedMapKeys(v reflect.Value) []reflect.Value {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		switch a.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return a.Int() < b.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return a.Uint() < b.Uint()
+		case reflect.Float32, reflect.Float64:
+			return a.Float() < b.Float()
+		}
+		return formatScalar(a) < formatScalar(b)
+	})
+	return keys
+}
@@ -0,0 +1,99 @@
+// pretty_print_test.go
+package internal
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func prettyString(v interface{}, opts PrintOptions) string {
+	var sb strings.Builder
+	PrettyPrintValue(&sb, v, opts)
+	return sb.String()
+}
+
+func TestPrettyPrintNestedMap(t *testing.T) {
+	data := map[string]interface{}{
+		"zeta":  []interface{}{1, "two", nil},
+		"alpha": map[string]interface{}{"b": true, "a": 1.5},
+		"empty": map[string]interface{}{},
+		"ports": map[int]string{443: "https", 80: "http"},
+	}
+	want := `{
+  "alpha": {
+    "a": 1.5
+    "b": true
+  }
+  "empty": {}
+  "ports": {
+    80: "http"
+    443: "https"
+  }
+  "zeta": [
+    1
+    "two"
+    <nil>
+  ]
+}
+`
+	// sorted keys make the output identical on every run
+	for i := 0; i < 5; i++ {
+		testutil.AssertEqual(t, prettyString(data, PrintOptions{}), want)
+	}
+}
+
+type prettyAccount struct {
+	Name    string
+	balance int
+	Opened  time.Time
+	Owner   *prettyAccount
+}
+
+func TestPrettyPrintStructWithTypes(t *testing.T) {
+	account := prettyAccount{
+		Name:    "ops",
+		balance: 42,
+		Opened:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	got := prettyString(account, PrintOptions{Indent: "\t", ShowTypes: true})
+	want := "internal.prettyAccount {\n" +
+		"\tName: \"ops\" (string)\n" +
+		"\tbalance: 42 (int)\n" +
+		"\tOpened: 2024-01-02 03:04:05 +0000 UTC (time.Time)\n" +
+		"\tOwner: <nil>\n" +
+		"}\n"
+	testutil.AssertEqual(t, got, want)
+}
+
+func TestPrettyPrintScalarsAndNil(t *testing.T) {
+	testutil.AssertEqual(t, prettyString(nil, PrintOptions{}), "<nil>\n")
+	testutil.AssertEqual(t, prettyString([]int{}, PrintOptions{ShowTypes: true}), "[]int []\n")
+	testutil.AssertEqual(t, prettyString(uint8(7), PrintOptions{}), "7\n")
+}
+
+type prettyNode struct {
+	Name string
+	Next *prettyNode
+}
+
+func TestPrettyPrintCycles(t *testing.T) {
+	n := &prettyNode{Name: "loop"}
+	n.Next = n
+	testutil.AssertEqual(t, prettyString(n, PrintOptions{}), "{\n  Name: \"loop\"\n  Next: <cycle>\n}\n")
+
+	m := map[string]interface{}{"id": 1}
+	m["self"] = m
+	testutil.AssertEqual(t, prettyString(m, PrintOptions{}), "{\n  \"id\": 1\n  \"self\": <cycle>\n}\n")
+
+	s := []interface{}{"x", nil}
+	s[1] = s
+	testutil.AssertEqual(t, prettyString(s, PrintOptions{}), "[\n  \"x\"\n  <cycle>\n]\n")
+
+	// A value shared by two fields is not a cycle and prints both times
+	leaf := &prettyNode{Name: "leaf"}
+	pair := []*prettyNode{leaf, leaf}
+	testutil.AssertEqual(t, strings.Count(prettyString(pair, PrintOptions{}), `"leaf"`), 2)
+}
@@ -0,0 +1,65 @@
+// priority_queue.go
+package internal
+
+import "container/heap"
+
+// PriorityQueue is a binary heap ordered by a caller-supplied less function:
+// Pop always returns the element for which less ranks highest (the
+// "smallest"). It is not safe for concurrent use.
+type PriorityQueue[T any] struct {
+	h *pqHeap[T]
+}
+
+// NewPriorityQueue creates an empty queue ordered by less
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{h: &pqHeap[T]{less: less}}
+}
+
+// Push adds an item
+func (pq *PriorityQueue[T]) Push(item T) {
+	heap.Push(pq.h, item)
+}
+
+// Pop removes and returns the top item; ok is false if the queue is empty
+func (pq *PriorityQueue[T]) Pop() (item T, ok bool) {
+	if pq.h.Len() == 0 {
+		return item, false
+	}
+	return heap.Pop(pq.h).(T), true
+}
+
+// Peek returns the top item without removing it
+func (pq *PriorityQueue[T]) Peek() (item T, ok bool) {
+	if pq.h.Len() == 0 {
+		return item, false
+	}
+	return pq.h.items[0], true
+}
+
+// Len returns the number of queued items
+func (pq *PriorityQueue[T]) Len() int {
+	return pq.h.Len()
+}
+
+// pqHeap adapts a slice to container/heap.Interface
+type pqHeap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func (h *pqHeap[T]) Len() int           { return len(h.items) }
+func (h *pqHeap[T]) Less(i, j int) bool { return h.less(h.items[i], h.items[j]) }
+func (h *pqHeap[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *pqHeap[T]) Push(x interface{}) {
+	h.items = append(h.items, x.(T))
+}
+
+func (h *pqHeap[T]) Pop() interface{} {
+	n := len(h.items)
+	item := h.items[n-1]
+	var zero T
+	h.items[n-1] = zero // drop the reference so it can be collected
+	h.items = h.items[:n-1]
+	return item
+}
@@ -0,0 +1,51 @@
+// priority_queue_test.go
+package internal
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+type pqTask struct {
+	Name     string
+	Priority int
+}
+
+func TestPriorityQueuePopOrder(t *testing.T) {
+	pq := NewPriorityQueue(func(a, b pqTask) bool { return a.Priority > b.Priority })
+	rng := rand.New(rand.NewSource(7))
+	for _, p := range rng.Perm(50) {
+		pq.Push(pqTask{Priority: p})
+	}
+	testutil.AssertEqual(t, pq.Len(), 50)
+
+	for want := 49; want >= 0; want-- {
+		task, ok := pq.Pop()
+		testutil.AssertEqual(t, ok, true)
+		testutil.AssertEqual(t, task.Priority, want)
+	}
+	_, ok := pq.Pop()
+	testutil.AssertEqual(t, ok, false)
+}
+
+func TestPriorityQueuePeek(t *testing.T) {
+	pq := NewPriorityQueue(func(a, b int) bool { return a < b })
+	_, ok := pq.Peek()
+	testutil.AssertEqual(t, ok, false)
+
+	for _, v := range []int{5, 1, 3} {
+		pq.Push(v)
+	}
+	for i := 0; i < 3; i++ {
+		top, ok := pq.Peek()
+		testutil.AssertEqual(t, ok, true)
+		testutil.AssertEqual(t, top, 1)
+	}
+	testutil.AssertEqual(t, pq.Len(), 3)
+
+	pq.Pop()
+	top, _ := pq.Peek()
+	testutil.AssertEqual(t, top, 3)
+}
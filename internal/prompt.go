@@ -0,0 +1,86 @@
+// prompt.go
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Prompt writes question to w and returns the next line read from r,
+// trimmed of surrounding whitespace. It reads one byte at a time (or uses
+// r's ReadByte) so it never consumes input past the end of the line,
+// leaving the rest of r for the next prompt. Input that ends without any
+// text returns io.EOF.
+func Prompt(r io.Reader, w io.Writer, question string) (string, error) {
+	if _, err := fmt.Fprint(w, question); err != nil {
+		return "", err
+	}
+	line, err := readLine(r)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// Confirm asks a yes/no question, showing the default as [Y/n] or [y/N].
+// It accepts y, yes, n and no in any case, returns def for an empty
+// answer and asks again after anything else. End of input is an error,
+// never a silent default.
+func Confirm(r io.Reader, w io.Writer, question string, def bool) (bool, error) {
+	hint := "[y/N]"
+	if def {
+		hint = "[Y/n]"
+	}
+
+	for {
+		answer, err := Prompt(r, w, fmt.Sprintf("%s %s ", question, hint))
+		if err != nil {
+			return false, err
+		}
+		switch strings.ToLower(answer) {
+		case "":
+			return def, nil
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+		if _, err := fmt.Fprintln(w, "Please answer y or n."); err != nil {
+			return false, err
+		}
+	}
+}
+
+// readLine reads up to and excluding the next newline, dropping a trailing
+// carriage return. A final line without a newline is returned as is.
+func readLine(r io.Reader) (string, error) {
+	readByte := func() (byte, error) {
+		if br, ok := r.(io.ByteReader); ok {
+			return br.ReadByte()
+		}
+		var b [1]byte
+		_, err := io.ReadFull(r, b[:])
+		return b[0], err
+	}
+
+	var line strings.Builder
+	for {
+		b, err := readByte()
+		if errors.Is(err, io.EOF) {
+			if line.Len() == 0 {
+				return "", io.EOF
+			}
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if b == '\n' {
+			break
+		}
+		line.WriteByte(b)
+	}
+	return strings.TrimSuffix(line.String(), "\r"), nil
+}
@@ -0,0 +1,84 @@
+// prompt_test.go
+package internal
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestPromptReadsOneLine(t *testing.T) {
+	in := strings.NewReader("  Ada Lovelace \r\nsecond\nlast")
+	var out strings.Builder
+
+	name, err := Prompt(in, &out, "Name? ")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, name, "Ada Lovelace")
+	testutil.AssertEqual(t, out.String(), "Name? ")
+
+	// The rest of the input is left for later prompts
+	next, _ := Prompt(in, &out, "")
+	testutil.AssertEqual(t, next, "second")
+	last, err := Prompt(in, &out, "")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, last, "last")
+
+	_, err = Prompt(in, &out, "")
+	testutil.AssertErrorIs(t, err, io.EOF)
+}
+
+func TestPromptWithoutByteReader(t *testing.T) {
+	// OneByteReader hides ReadByte, exercising the fallback path
+	in := iotest.OneByteReader(strings.NewReader("yes\nno\n"))
+	first, err := Prompt(in, io.Discard, "")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, first, "yes")
+	second, _ := Prompt(in, io.Discard, "")
+	testutil.AssertEqual(t, second, "no")
+}
+
+func TestConfirm(t *testing.T) {
+	cases := []struct {
+		input string
+		def   bool
+		want  bool
+	}{
+		{"y\n", false, true},
+		{"YES\n", false, true},
+		{"n\n", true, false},
+		{"No\n", true, false},
+		{"\n", true, true},
+		{"\n", false, false},
+		{"   \n", true, true},
+	}
+	for _, c := range cases {
+		got, err := Confirm(strings.NewReader(c.input), io.Discard, "Continue?", c.def)
+		testutil.AssertNoError(t, err)
+		if got != c.want {
+			t.Errorf("Confirm(%q, def=%v) = %v, want %v", c.input, c.def, got, c.want)
+		}
+	}
+}
+
+func TestConfirmRepromptsAndShowsDefault(t *testing.T) {
+	var out strings.Builder
+	got, err := Confirm(strings.NewReader("maybe\ny\n"), &out, "Delete?", false)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, got, true)
+	testutil.AssertEqual(t, out.String(), "Delete? [y/N] Please answer y or n.\nDelete? [y/N] ")
+
+	out.Reset()
+	Confirm(strings.NewReader("\n"), &out, "Keep?", true)
+	testutil.AssertEqual(t, out.String(), "Keep? [Y/n] ")
+}
+
+func TestConfirmEOFIsAnError(t *testing.T) {
+	_, err := Confirm(strings.NewReader(""), io.Discard, "Continue?", true)
+	testutil.AssertErrorIs(t, err, io.EOF)
+
+	_, err = Confirm(strings.NewReader("what\n"), io.Discard, "Continue?", true)
+	testutil.AssertErrorIs(t, err, io.EOF)
+}
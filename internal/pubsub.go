@@ -0,0 +1,173 @@
+// pubsub.go
+package internal
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// DeliveryPolicy decides what Publish does when a subscriber's buffer is full
+type DeliveryPolicy int
+
+const (
+	DropWhenFull  DeliveryPolicy = iota // skip that subscriber and count a drop
+	BlockWhenFull                       // wait until the subscriber catches up
+)
+
+// Message is one published event
+type Message struct {
+	Topic   string
+	Payload interface{}
+}
+
+// PubSub delivers messages on dot-separated topics such as
+// "orders.created" to every subscriber whose pattern matches. A pattern is
+// either an exact topic or ends in "*", so "orders.*" receives
+// "orders.created" and "orders.eu.refunded", and "*" receives everything.
+type PubSub struct {
+	mu         sync.RWMutex
+	subs       []*subscription
+	bufferSize int
+	policy     DeliveryPolicy
+	dropped    atomic.Int64
+	closed     bool
+}
+
+type subscription struct {
+	pattern string
+	ch      chan Message
+	done    chan struct{}  // closed on unsubscribe, releasing blocked publishers
+	sending sync.WaitGroup // publishes in flight; ch is closed once they finish
+}
+
+// stop ends delivery to sub: it wakes any publisher blocked on its buffer,
+// waits for in-flight sends to give up and only then closes ch, so no
+// publisher can send on a closed channel. It must be called after sub was
+// removed from ps.subs and without holding ps.mu.
+func (sub *subscription) stop() {
+	close(sub.done)
+	sub.sending.Wait()
+	close(sub.ch)
+}
+
+// NewPubSub creates a broker whose subscriber channels hold bufferSize
+// messages. With BlockWhenFull a slow subscriber stalls every publisher, so
+// subscribers must keep reading until Close.
+func NewPubSub(bufferSize int, policy DeliveryPolicy) *PubSub {
+	return &PubSub{bufferSize: max(bufferSize, 0), policy: policy}
+}
+
+// Subscribe returns a channel receiving messages whose topic matches
+// pattern. The channel is closed by Unsubscribe or Close.
+func (ps *PubSub) Subscribe(pattern string) <-chan Message {
+	ch := make(chan Message, ps.bufferSize)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if ps.closed {
+		close(ch)
+		return ch
+	}
+	ps.subs = append(ps.subs, &subscription{pattern: pattern, ch: ch, done: make(chan struct{})})
+	return ch
+}
+
+// Unsubscribe stops delivery to ch and closes it
+func (ps *PubSub) Unsubscribe(ch <-chan Message) {
+	ps.mu.Lock()
+	var removed *subscription
+	for i, sub := range ps.subs {
+		if sub.ch == ch {
+			removed = sub
+			ps.subs = append(ps.subs[:i], ps.subs[i+1:]...)
+			break
+		}
+	}
+	ps.mu.Unlock()
+
+	if removed != nil {
+		removed.stop()
+	}
+}
+
+// Publish sends payload to every subscriber matching topic and returns how
+// many received it; with DropWhenFull the rest are counted in Dropped. The
+// matching subscribers are collected under the lock and sent to after it
+// is released, so a publisher blocked on a full buffer never holds up
+// Subscribe, Unsubscribe or Close; unsubscribing releases it.
+func (ps *PubSub) Publish(topic string, payload interface{}) int {
+	msg := Message{Topic: topic, Payload: payload}
+
+	ps.mu.RLock()
+	var targets []*subscription
+	for _, sub := range ps.subs {
+		if topicMatches(sub.pattern, topic) {
+			sub.sending.Add(1)
+			targets = append(targets, sub)
+		}
+	}
+	ps.mu.RUnlock()
+
+	delivered := 0
+	for _, sub := range targets {
+		if ps.deliver(sub, msg) {
+			delivered++
+		}
+		sub.sending.Done()
+	}
+	return delivered
+}
+
+// deliver sends msg to sub according to the policy, reporting whether it
+// was accepted
+func (ps *PubSub) deliver(sub *subscription, msg Message) bool {
+	if ps.policy == BlockWhenFull {
+		select {
+		case sub.ch <- msg:
+			return true
+		case <-sub.done:
+			return false
+		}
+	}
+	select {
+	case sub.ch <- msg:
+		return true
+	default:
+		ps.dropped.Add(1)
+		return false
+	}
+}
+
+// Dropped returns how many deliveries were skipped because a buffer was full
+func (ps *PubSub) Dropped() int64 {
+	return ps.dropped.Load()
+}
+
+// Close closes every subscriber channel; later subscriptions are closed
+// immediately and later publishes reach no one
+func (ps *PubSub) Close() {
+	ps.mu.Lock()
+	subs := ps.subs
+	ps.subs = nil
+	ps.closed = true
+	ps.mu.Unlock()
+
+	for _, sub := range subs {
+		sub.stop()
+	}
+}
+
+// topicMatches reports whether topic satisfies pattern. A trailing "*"
+// matches one or more further segments: "orders.*" matches
+// "orders.created" but not "orders" itself.
+func topicMatches(pattern, topic string) bool {
+	prefix, wildcard := strings.CutSuffix(pattern, "*")
+	if !wildcard {
+		return pattern == topic
+	}
+	if prefix == "" {
+		return true
+	}
+	return strings.HasPrefix(topic, prefix) && len(topic) > len(prefix)
+}
@@ -0,0 +1,104 @@
+// pubsub_test.go
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestTopicMatches(t *testing.T) {
+	tests := []struct {
+		pattern, topic string
+		want           bool
+	}{
+		{"orders.created", "orders.created", true},
+		{"orders.created", "orders.refunded", false},
+		{"orders.*", "orders.eu.refunded", true},
+		{"orders.*", "orders", false},
+		{"*", "anything", true},
+	}
+	for _, tt := range tests {
+		testutil.AssertEqual(t, topicMatches(tt.pattern, tt.topic), tt.want)
+	}
+}
+
+func TestPubSubDropWhenFull(t *testing.T) {
+	bus := NewPubSub(1, DropWhenFull)
+	orders := bus.Subscribe("orders.*")
+	created := bus.Subscribe("orders.created")
+
+	testutil.AssertEqual(t, bus.Publish("orders.created", 1), 2)
+	testutil.AssertEqual(t, bus.Publish("orders.refunded", 2), 0) // orders is full
+	testutil.AssertEqual(t, bus.Dropped(), int64(1))
+
+	msg := <-orders
+	testutil.AssertEqual(t, msg.Topic, "orders.created")
+	testutil.AssertEqual(t, (<-created).Payload, any(1))
+
+	bus.Close()
+	_, open := <-orders
+	testutil.AssertEqual(t, open, false)
+	_, open = <-bus.Subscribe("late")
+	testutil.AssertEqual(t, open, false)
+	testutil.AssertEqual(t, bus.Publish("orders.created", 3), 0)
+}
+
+// publishBlocked starts a publisher that will block on a full subscriber
+// and returns a channel reporting its delivered count
+func publishBlocked(bus *PubSub, topic string) <-chan int {
+	result := make(chan int, 1)
+	go func() { result <- bus.Publish(topic, "stuck") }()
+	return result
+}
+
+func awaitResult(t *testing.T, result <-chan int, what string) int {
+	t.Helper()
+	select {
+	case n := <-result:
+		return n
+	case <-time.After(2 * time.Second):
+		t.Fatalf("%s deadlocked", what)
+		return 0
+	}
+}
+
+func TestPubSubBlockedPublisherDoesNotBlockUnsubscribe(t *testing.T) {
+	bus := NewPubSub(0, BlockWhenFull)
+	slow := bus.Subscribe("orders.*")
+	published := publishBlocked(bus, "orders.created")
+
+	unsubscribed := make(chan int, 1)
+	go func() { bus.Unsubscribe(slow); unsubscribed <- 0 }()
+	awaitResult(t, unsubscribed, "Unsubscribe")
+	testutil.AssertEqual(t, awaitResult(t, published, "Publish"), 0)
+
+	_, open := <-slow
+	testutil.AssertEqual(t, open, false)
+}
+
+func TestPubSubBlockedPublisherDoesNotBlockClose(t *testing.T) {
+	bus := NewPubSub(0, BlockWhenFull)
+	bus.Subscribe("orders.*") // never read
+	fast := bus.Subscribe("*")
+	published := publishBlocked(bus, "orders.created")
+
+	closed := make(chan int, 1)
+	go func() { bus.Close(); closed <- 0 }()
+	awaitResult(t, closed, "Close")
+	awaitResult(t, published, "Publish")
+
+	for range fast {
+		// drain whatever was delivered before Close
+	}
+}
+
+func TestPubSubBlockWhenFullDelivers(t *testing.T) {
+	bus := NewPubSub(0, BlockWhenFull)
+	sub := bus.Subscribe("jobs")
+	published := publishBlocked(bus, "jobs")
+	testutil.AssertEqual(t, (<-sub).Payload, any("stuck"))
+	testutil.AssertEqual(t, awaitResult(t, published, "Publish"), 1)
+	bus.Close()
+}
@@ -0,0 +1,106 @@
+// query_encoder.go
+package internal
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EncodeQuery turns a struct into a URL query string using `url:"name"`
+// tags. Untagged exported fields use their Go name, `url:"-"` skips a field
+// and `,omitempty` drops zero values. Slice and array fields become repeated
+// parameters. Keys are sorted, so the output is deterministic.
+func EncodeQuery(v interface{}) (string, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return "", nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return "", fmt.Errorf("encode query: expected a struct, got %s", rv.Kind())
+	}
+
+	values := url.Values{}
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(field.Tag.Get("url"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		omitEmpty := opts == "omitempty"
+
+		fv := rv.Field(i)
+		if omitEmpty && fv.IsZero() {
+			continue
+		}
+
+		if fv.Kind() == reflect.Slice || fv.Kind() == reflect.Array {
+			if omitEmpty && fv.Len() == 0 {
+				continue
+			}
+			for j := 0; j < fv.Len(); j++ {
+				text, err := queryValue(fv.Index(j))
+				if err != nil {
+					return "", fmt.Errorf("encode query field %s[%d]: %w", field.Name, j, err)
+				}
+				values.Add(name, text)
+			}
+			continue
+		}
+
+		text, err := queryValue(fv)
+		if err != nil {
+			return "", fmt.Errorf("encode query field %s: %w", field.Name, err)
+		}
+		values.Add(name, text)
+	}
+
+	return values.Encode(), nil
+}
+
+// queryValue formats a single scalar for a query parameter
+func queryValue(v reflect.Value) (string, error) {
+	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", nil
+		}
+		return queryValue(v.Elem())
+	}
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32), nil
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	}
+
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		return s.String(), nil
+	}
+	return "", fmt.Errorf("unsupported kind %s", v.Kind())
+}
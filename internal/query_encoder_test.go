@@ -0,0 +1,82 @@
+// query_encoder_test.go
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+type searchQuery struct {
+	Query   string   `url:"q"`
+	Page    int      `url:"page,omitempty"`
+	Tags    []string `url:"tag"`
+	Exact   bool     `url:"exact,omitempty"`
+	Limit   *int     `url:"limit,omitempty"`
+	Secret  string   `url:"-"`
+	Verbose bool
+	hidden  string
+}
+
+func TestEncodeQuerySortedAndEscaped(t *testing.T) {
+	limit := 5
+	got, err := EncodeQuery(searchQuery{
+		Query:   "go & rust=fun",
+		Page:    2,
+		Tags:    []string{"a b", "c/d"},
+		Limit:   &limit,
+		Secret:  "s3cret",
+		Verbose: true,
+		hidden:  "x",
+	})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, got, "Verbose=true&limit=5&page=2&q=go+%26+rust%3Dfun&tag=a+b&tag=c%2Fd")
+
+	// Repeated calls produce identical output
+	for i := 0; i < 10; i++ {
+		again, _ := EncodeQuery(searchQuery{
+			Query: "go & rust=fun", Page: 2, Tags: []string{"a b", "c/d"}, Limit: &limit, Verbose: true,
+		})
+		testutil.AssertEqual(t, again, got)
+	}
+}
+
+func TestEncodeQueryOmitEmpty(t *testing.T) {
+	got, err := EncodeQuery(&searchQuery{Query: ""})
+	testutil.AssertNoError(t, err)
+	// q has no omitempty, so it is kept even when empty; the empty slice adds nothing
+	testutil.AssertEqual(t, got, "Verbose=false&q=")
+}
+
+func TestEncodeQueryScalarKinds(t *testing.T) {
+	type params struct {
+		When  time.Time `url:"when"`
+		Ratio float64   `url:"ratio"`
+		Count uint8     `url:"n"`
+		Sizes [2]int    `url:"size"`
+	}
+	got, err := EncodeQuery(params{
+		When:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Ratio: 0.25,
+		Count: 7,
+		Sizes: [2]int{1, 2},
+	})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, got, "n=7&ratio=0.25&size=1&size=2&when=2024-01-02T03%3A04%3A05Z")
+}
+
+func TestEncodeQueryErrors(t *testing.T) {
+	_, err := EncodeQuery(42)
+	testutil.AssertEqual(t, err.Error(), "encode query: expected a struct, got int")
+
+	_, err = EncodeQuery(struct {
+		M map[string]int `url:"m"`
+	}{M: map[string]int{}})
+	testutil.AssertEqual(t, err.Error(), "encode query field M: unsupported kind map")
+
+	var nilQuery *searchQuery
+	got, err := EncodeQuery(nilQuery)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, got, "")
+}
@@ -0,0 +1,105 @@
+// rate_counter.go
+package internal
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// RateCounter counts events over a trailing window using a ring of
+// fixed-width time buckets: recording is O(1) and memory is fixed however
+// many events arrive, unlike SlidingWindow which keeps every timestamp.
+// Queries are accurate to one bucket width. It is safe for concurrent use.
+type RateCounter struct {
+	mu         sync.Mutex
+	resolution time.Duration
+	buckets    []rateBucket
+	clock      Clock
+	start      time.Time // epochs count resolution-wide slots from here
+}
+
+type rateBucket struct {
+	epoch int64 // index of the resolution-wide slot this bucket counts
+	count int
+}
+
+// NewRateCounter creates a counter that can answer queries for windows up
+// to window, in steps of resolution. A nil clock uses the system clock.
+func NewRateCounter(window, resolution time.Duration, clock Clock) *RateCounter {
+	if resolution <= 0 {
+		resolution = time.Second
+	}
+	n := int((window + resolution - 1) / resolution)
+	if n < 1 {
+		n = 1
+	}
+	rc := &RateCounter{
+		resolution: resolution,
+		buckets:    make([]rateBucket, n),
+		clock:      clockOrSystem(clock),
+	}
+	rc.start = rc.clock.Now()
+	for i := range rc.buckets {
+		rc.buckets[i].epoch = math.MinInt64 // never counted
+	}
+	return rc
+}
+
+// Record counts one event now
+func (rc *RateCounter) Record() {
+	rc.Add(1)
+}
+
+// Add counts n events now
+func (rc *RateCounter) Add(n int) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	epoch := rc.epoch()
+	size := int64(len(rc.buckets))
+	bucket := &rc.buckets[((epoch%size)+size)%size] // epoch is negative if the clock steps back
+	if bucket.epoch != epoch {
+		// The slot last counted a period that has left the ring
+		bucket.epoch = epoch
+		bucket.count = 0
+	}
+	bucket.count += n
+}
+
+// Count returns the events recorded in the trailing d, rounded up to whole
+// buckets and capped at the counter's window
+func (rc *RateCounter) Count(d time.Duration) int {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	span := int64((d + rc.resolution - 1) / rc.resolution)
+	if span > int64(len(rc.buckets)) {
+		span = int64(len(rc.buckets))
+	}
+	oldest := rc.epoch() - span + 1
+
+	total := 0
+	for _, bucket := range rc.buckets {
+		if bucket.epoch >= oldest {
+			total += bucket.count
+		}
+	}
+	return total
+}
+
+// Rate returns the events per second over the trailing d
+func (rc *RateCounter) Rate(d time.Duration) float64 {
+	if d <= 0 {
+		return 0
+	}
+	return float64(rc.Count(d)) / d.Seconds()
+}
+
+// epoch numbers the current slot relative to the counter's creation, so
+// a zero or pre-1970 clock cannot overflow UnixNano
+func (rc *RateCounter) epoch() int64 {
+	elapsed := rc.clock.Now().Sub(rc.start)
+	if elapsed < 0 {
+		return -int64((-elapsed + rc.resolution - 1) / rc.resolution)
+	}
+	return int64(elapsed / rc.resolution)
+}
@@ -0,0 +1,67 @@
+// rate_counter_test.go
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestRateCounterWindow(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	rc := NewRateCounter(10*time.Second, time.Second, clock)
+
+	rc.Add(5)
+	clock.Advance(3 * time.Second)
+	rc.Record()
+	rc.Record()
+
+	testutil.AssertEqual(t, rc.Count(time.Second), 2)
+	testutil.AssertEqual(t, rc.Count(4*time.Second), 7)
+	testutil.AssertEqual(t, rc.Rate(10*time.Second), 0.7)
+
+	// after the window passes the old bucket is reused, not added to
+	clock.Advance(8 * time.Second)
+	rc.Record()
+	testutil.AssertEqual(t, rc.Count(time.Minute), 1+2)
+	clock.Advance(10 * time.Second)
+	testutil.AssertEqual(t, rc.Count(time.Minute), 0)
+}
+
+func TestRateCounterZeroAndPre1970Clock(t *testing.T) {
+	starts := []time.Time{
+		{},
+		time.Date(1950, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for _, start := range starts {
+		clock := NewManualClock(start)
+		rc := NewRateCounter(5*time.Second, time.Second, clock)
+		for i := 0; i < 7; i++ {
+			rc.Record() // used to index the ring with a negative epoch
+			clock.Advance(time.Second)
+		}
+		testutil.AssertEqual(t, rc.Count(5*time.Second), 4)
+	}
+}
+
+func TestRateCounterClockStepsBack(t *testing.T) {
+	clock := &steppingClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	rc := NewRateCounter(5*time.Second, time.Second, clock)
+	clock.now = clock.now.Add(-3 * time.Second)
+	rc.Record()
+	testutil.AssertEqual(t, rc.Count(time.Second), 1)
+	testutil.AssertEqual(t, rc.Rate(0), 0.0)
+}
+
+// steppingClock is a Clock whose time the test sets directly, including
+// backwards
+type steppingClock struct {
+	now time.Time
+}
+
+func (c *steppingClock) Now() time.Time { return c.now }
+
+func (c *steppingClock) After(d time.Duration) <-chan time.Time {
+	return make(chan time.Time)
+}
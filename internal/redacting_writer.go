@@ -0,0 +1,109 @@
+// redacting_writer.go
+package internal
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// redactedMask replaces every secret written through a RedactingWriter
+const redactedMask = "****"
+
+// RedactingWriter masks secret substrings before passing data downstream.
+// Because a secret may be split across Write calls, it holds back up to
+// len(longest secret)-1 trailing bytes until more data arrives; call Flush
+// (or Close) to emit them once writing is done.
+type RedactingWriter struct {
+	mu      sync.Mutex
+	w       io.Writer
+	secrets [][]byte
+	maxLen  int
+	pending []byte
+}
+
+// NewRedactingWriter wraps w, masking each non-empty secret
+func NewRedactingWriter(w io.Writer, secrets ...string) *RedactingWriter {
+	rw := &RedactingWriter{w: w}
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		rw.secrets = append(rw.secrets, []byte(secret))
+		if len(secret) > rw.maxLen {
+			rw.maxLen = len(secret)
+		}
+	}
+	return rw
+}
+
+// Write redacts and forwards everything in p except a possible partial
+// secret at the end. It reports len(p) on success.
+func (rw *RedactingWriter) Write(p []byte) (int, error) {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+
+	rw.pending = append(rw.pending, p...)
+	cut := len(rw.pending)
+	if rw.maxLen > 1 {
+		cut -= rw.maxLen - 1
+	}
+	if err := rw.emit(cut); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Flush redacts and forwards any held-back bytes
+func (rw *RedactingWriter) Flush() error {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	return rw.emit(len(rw.pending))
+}
+
+// Close flushes the writer, then closes the downstream writer if it is an
+// io.Closer
+func (rw *RedactingWriter) Close() error {
+	if err := rw.Flush(); err != nil {
+		return err
+	}
+	if closer, ok := rw.w.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// emit writes pending data up to cut, masking secrets. Any secret that starts
+// before cut is complete in pending (cut leaves room for the longest one), so
+// it is masked even if it extends past cut. The caller holds rw.mu.
+func (rw *RedactingWriter) emit(cut int) error {
+	var out bytes.Buffer
+	i := 0
+	for i < cut {
+		if n := rw.matchAt(i); n > 0 {
+			out.WriteString(redactedMask)
+			i += n
+			continue
+		}
+		out.WriteByte(rw.pending[i])
+		i++
+	}
+
+	rw.pending = append(rw.pending[:0], rw.pending[i:]...)
+	if out.Len() == 0 {
+		return nil
+	}
+	_, err := rw.w.Write(out.Bytes())
+	return err
+}
+
+// matchAt returns the length of the longest secret at pending[i:], or 0
+func (rw *RedactingWriter) matchAt(i int) int {
+	best := 0
+	for _, secret := range rw.secrets {
+		if len(secret) > best && bytes.HasPrefix(rw.pending[i:], secret) {
+			best = len(secret)
+		}
+	}
+	return best
+}
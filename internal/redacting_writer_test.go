@@ -0,0 +1,62 @@
+// redacting_writer_test.go
+package internal
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestRedactingWriterSecretAcrossWrites(t *testing.T) {
+	var out bytes.Buffer
+	rw := NewRedactingWriter(&out, "hunter2", "s3cr3t-token")
+
+	for _, chunk := range []string{"login user=bob pass=hun", "ter2 ok; token=s3cr", "3t-token done"} {
+		n, err := rw.Write([]byte(chunk))
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, n, len(chunk))
+	}
+	testutil.AssertNoError(t, rw.Flush())
+
+	testutil.AssertEqual(t, out.String(), "login user=bob pass=**** ok; token=**** done")
+}
+
+func TestRedactingWriterByteAtATime(t *testing.T) {
+	var out bytes.Buffer
+	rw := NewRedactingWriter(&out, "pass", "password")
+	input := "password: pass, passwor"
+	for i := 0; i < len(input); i++ {
+		rw.Write([]byte{input[i]})
+	}
+	rw.Flush()
+	// the longest secret wins, and a partial match is left alone
+	testutil.AssertEqual(t, out.String(), "****: ****, ****wor")
+}
+
+type closeRecorder struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestRedactingWriterCloseAndNoSecrets(t *testing.T) {
+	var rec closeRecorder
+	rw := NewRedactingWriter(&rec, "key")
+	io.WriteString(rw, "apikey k")
+	testutil.AssertEqual(t, rec.String(), "api****") // " k" may be the start of a secret
+	testutil.AssertNoError(t, rw.Close())
+	testutil.AssertEqual(t, rec.String(), "api**** k")
+	testutil.AssertEqual(t, rec.closed, true)
+
+	var plain strings.Builder
+	passthrough := NewRedactingWriter(&plain, "")
+	io.WriteString(passthrough, "nothing to hide")
+	testutil.AssertEqual(t, plain.String(), "nothing to hide")
+}
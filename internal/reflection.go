@@ -2,10 +2,14 @@
 package internal
 
 import (
+	"flag"
 	"fmt"
+	"io"
+	"os"
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // User represents a sample user struct for reflection examples
@@ -31,16 +35,29 @@ type Validator interface {
 
 // RunReflectionExamples - main function to run all reflection examples
 func RunReflectionExamples() {
-	basicReflectionExample()
-	typeAndValueExample()
-	structFieldReflectionExample()
-	methodReflectionExample()
-	sliceReflectionExample()
-	interfaceReflectionExample()
-	tagReflectionExample()
-	dynamicFunctionCallExample()
-	jsonMarshallingExample()
-	validationFrameworkExample()
+	for _, example := range reflectionExamples {
+		example.Run()
+	}
+}
+
+// reflectionExamples lists the reflection sub-examples in run order, by CLI name
+var reflectionExamples = []NamedExample{
+	{"basic", basicReflectionExample},
+	{"type-and-value", typeAndValueExample},
+	{"struct-fields", structFieldReflectionExample},
+	{"methods", methodReflectionExample},
+	{"slices", sliceReflectionExample},
+	{"interfaces", interfaceReflectionExample},
+	{"tags", tagReflectionExample},
+	{"dynamic-calls", dynamicFunctionCallExample},
+	{"json-marshalling", jsonMarshallingExample},
+	{"validation", validationFrameworkExample},
+	{"deep-equal-approx", deepEqualApproxExample},
+	{"deep-copy", deepCopyExample},
+	{"flag-binding", flagBindingExample},
+	{"interface-check", interfaceCheckExample},
+	{"walk-fields", walkFieldsExample},
+	{"reset-zero", resetZeroExample},
 }
 
 // basicReflectionExample demonstrates basic reflection concepts
@@ -132,6 +149,26 @@ func structFieldReflectionExample() {
 	}
 
 	fmt.Printf("Modified user: %+v\n", user)
+
+	// The same information as a table, including tags
+	fmt.Println(Bold("StructTable view:"))
+	StructTable(os.Stdout, &user)
+
+	// Nested structs are expanded one level with dotted names
+	type shippingAddress struct {
+		City    string `json:"city"`
+		Country string `json:"country"`
+	}
+	type shipment struct {
+		Recipient AccountUser     `json:"recipient"`
+		Address   shippingAddress `json:"address"`
+		Weight    float64         `json:"weight_kg"`
+	}
+	StructTable(os.Stdout, shipment{
+		Recipient: user,
+		Address:   shippingAddress{City: "Lisbon", Country: "PT"},
+		Weight:    2.5,
+	})
 	fmt.Println()
 }
 
@@ -504,3 +541,258 @@ func isZeroValue(v reflect.Value) bool {
 		return false
 	}
 }
+
+// deepEqualApproxExample compares float-heavy data with a tolerance
+func deepEqualApproxExample() {
+	fmt.Println(Subtitle("11. Approximate Deep Equality Example"))
+
+	// Prices computed two different ways pick up rounding noise
+	base, tax := 0.1, 0.2
+	expected := []Product{
+		{Name: "Laptop", Price: 0.3, Category: "Electronics"},
+		{Name: "Mouse", Price: 25.5, Category: "Electronics"},
+	}
+	computed := []Product{
+		{Name: "Laptop", Price: base + tax, Category: "Electronics"},
+		{Name: "Mouse", Price: 25.5, Category: "Electronics"},
+	}
+
+	fmt.Printf("reflect.DeepEqual: %v\n", reflect.DeepEqual(expected, computed))
+	equal, _ := DeepEqualApprox(expected, computed, 1e-9)
+	fmt.Printf("DeepEqualApprox (tol 1e-9): %v\n", equal)
+
+	computed[1].Price = 25.75
+	equal, path := DeepEqualApprox(expected, computed, 1e-9)
+	fmt.Printf("After a real price change: equal=%v, first difference at %s\n", equal, Yellow(path))
+	fmt.Println()
+}
+
+// catalogSnapshot is a nested value used to compare the two clone strategies
+type catalogSnapshot struct {
+	Owner    AccountUser
+	Products []Product
+	Stock    map[string][]int
+	revision int // unexported: DeepCopy keeps it, GobClone refuses it
+}
+
+// deepCopyExample compares reflection and gob based deep copies
+func deepCopyExample() {
+	fmt.Println(Subtitle("12. Deep Copy Example"))
+
+	original := catalogSnapshot{
+		Owner:    AccountUser{ID: 1, Name: "Alice", Email: "alice@example.com", Age: 30},
+		Products: []Product{{Name: "Laptop", Price: 999.99, Category: "Electronics"}},
+		Stock:    map[string][]int{"Laptop": {4, 2}},
+		revision: 7,
+	}
+
+	clone := DeepCopy(original)
+	clone.Products[0].Price = 1.0
+	clone.Stock["Laptop"][0] = 0
+	fmt.Printf("After editing the DeepCopy: original price %.2f, stock %v, revision %d\n",
+		original.Products[0].Price, original.Stock["Laptop"], clone.revision)
+
+	if _, err := GobClone(original); err != nil {
+		fmt.Printf("GobClone refused: %s\n", Yellow(err.Error()))
+	}
+
+	// gob works on types made only of exported fields
+	type exportedSnapshot struct {
+		Owner    AccountUser
+		Products []Product
+		Stock    map[string][]int
+	}
+	plain := exportedSnapshot{original.Owner, original.Products, original.Stock}
+	gobCopy, err := GobClone(plain)
+	if err != nil {
+		fmt.Printf("Error cloning with gob: %v\n", err)
+		return
+	}
+	gobCopy.Stock["Laptop"][1] = 99
+	fmt.Printf("After editing the GobClone: original stock %v\n", plain.Stock["Laptop"])
+	fmt.Println("Compare their speed with: go test -bench 'DeepCopy|GobClone' ./internal")
+	fmt.Println()
+}
+
+// serverFlags is a config defined once and exposed as command-line flags
+type serverFlags struct {
+	Host    string        `flag:"host" usage:"address to listen on"`
+	Port    int           `flag:"port" usage:"port to listen on"`
+	Debug   bool          `flag:"debug" usage:"enable debug logging"`
+	Ratio   float64       `flag:"sample-ratio" usage:"fraction of requests to trace"`
+	Timeout time.Duration `flag:"timeout" usage:"per-request timeout"`
+	Secret  string        `flag:"-"`
+}
+
+// flagBindingExample populates a struct from flags registered by reflection
+func flagBindingExample() {
+	fmt.Println(Subtitle("13. Flag Binding Example"))
+
+	config := serverFlags{Host: "localhost", Port: 8080, Ratio: 0.1, Timeout: 5 * time.Second}
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	BindFlags(fs, &config)
+
+	fmt.Println(Bold("Generated flags:"))
+	fs.SetOutput(os.Stdout)
+	fs.PrintDefaults()
+
+	args := []string{"--port=9090", "--debug", "--timeout", "750ms"}
+	if err := fs.Parse(args); err != nil {
+		fmt.Printf("Error parsing flags: %v\n", err)
+		return
+	}
+	fmt.Printf("After parsing %v:\n", args)
+	fmt.Printf("  %+v\n", config)
+	fmt.Println()
+}
+
+// byteCounter counts written bytes; Write has a pointer receiver
+type byteCounter struct {
+	n int
+}
+
+func (c *byteCounter) Write(p []byte) (int, error) {
+	c.n += len(p)
+	return len(p), nil
+}
+
+// verboseLabel has a String method, but not the one fmt.Stringer wants
+type verboseLabel string
+
+func (l verboseLabel) String(verbose bool) string {
+	if verbose {
+		return "label: " + string(l)
+	}
+	return string(l)
+}
+
+// interfaceCheckExample explains why a type does or does not satisfy an interface
+func interfaceCheckExample() {
+	fmt.Println(Subtitle("14. Interface Satisfaction Example"))
+
+	checks := []struct {
+		name  string
+		value interface{}
+		iface interface{}
+		label string
+	}{
+		{"byteCounter", byteCounter{}, (*io.Writer)(nil), "io.Writer"},
+		{"byteCounter", byteCounter{}, (*io.ReadWriter)(nil), "io.ReadWriter"},
+		{"verboseLabel", verboseLabel("x"), (*fmt.Stringer)(nil), "fmt.Stringer"},
+		{"AccountUser", AccountUser{}, (*error)(nil), "error"},
+	}
+	for _, check := range checks {
+		if Implements(check.value, check.iface) {
+			fmt.Printf("%s implements %s\n", check.name, check.label)
+			continue
+		}
+		fmt.Printf("%s does not implement %s, missing:\n", check.name, check.label)
+		for _, method := range MissingMethods(check.value, check.iface) {
+			fmt.Printf("  - %s\n", method)
+		}
+	}
+
+	// Implements accepts pointer receivers; the type itself may still fall short
+	writerType := reflect.TypeOf((*io.Writer)(nil)).Elem()
+	fmt.Printf("byteCounter value satisfies io.Writer on its own: %v\n", reflect.TypeOf(byteCounter{}).Implements(writerType))
+	fmt.Printf("*byteCounter satisfies io.Writer: %v\n", reflect.TypeOf(&byteCounter{}).Implements(writerType))
+	fmt.Println()
+}
+
+// treeNode links back to its parent, making the structure cyclic
+type treeNode struct {
+	Name     string
+	Parent   *treeNode
+	Children []*treeNode
+}
+
+// walkFieldsExample visits every field of nested and cyclic structures
+func walkFieldsExample() {
+	fmt.Println(Subtitle("15. Field Walker Example"))
+
+	config, err := SampleConfig()
+	if err != nil {
+		fmt.Printf("Error loading config: %v\n", err)
+		return
+	}
+	fmt.Println(Bold("JSONConfig fields:"))
+	WalkFields(config, func(path string, field reflect.StructField, value reflect.Value) error {
+		switch value.Kind() {
+		case reflect.Struct, reflect.Slice, reflect.Map:
+			fmt.Printf("  %-22s %s\n", path, field.Type)
+		default:
+			fmt.Printf("  %-22s %s = %v\n", path, field.Type, value.Interface())
+		}
+		return nil
+	})
+
+	root := &treeNode{Name: "root"}
+	child := &treeNode{Name: "child", Parent: root}
+	root.Children = []*treeNode{child}
+	var paths []string
+	WalkFields(root, func(path string, field reflect.StructField, value reflect.Value) error {
+		if field.Name == "Name" {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	fmt.Printf("Names in a cyclic tree (the Parent back-link is not followed): %v\n", paths)
+
+	// ErrSkipField prunes a subtree without stopping the walk
+	count := 0
+	WalkFields(config, func(path string, field reflect.StructField, value reflect.Value) error {
+		count++
+		if field.Name == "Servers" {
+			return ErrSkipField
+		}
+		return nil
+	})
+	fmt.Printf("Fields visited when skipping Servers: %d\n", count)
+	fmt.Println()
+}
+
+// requestScratch is pooled per request; hits is internal bookkeeping
+type requestScratch struct {
+	User    JSONUser
+	Tags    []string
+	Retries int
+	hits    int
+}
+
+// resetZeroExample clears pooled objects before handing them out again
+func resetZeroExample() {
+	fmt.Println(Subtitle("16. Zero-Value Reset Example"))
+
+	user := JSONUser{
+		ID:        7,
+		Name:      "Grace",
+		Email:     "grace@example.com",
+		Password:  "s3cret",
+		IsActive:  true,
+		CreatedAt: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+		Profile:   &Profile{Bio: "Compiler engineer"},
+	}
+	profile := user.Profile
+	fmt.Printf("Before: zero=%v name=%q created=%s profile set=%v\n",
+		IsZeroStruct(user), user.Name, user.CreatedAt.Format(time.DateOnly), user.Profile != nil)
+	if err := ResetZero(&user); err != nil {
+		fmt.Printf("Error resetting user: %v\n", err)
+		return
+	}
+	fmt.Printf("After:  zero=%v name=%q created zero=%v profile set=%v\n",
+		IsZeroStruct(user), user.Name, user.CreatedAt.IsZero(), user.Profile != nil)
+	fmt.Printf("Shared profile left intact: %q\n", profile.Bio)
+
+	// A pool that resets objects on Put cannot leak one request's data into the next
+	scratchPool := NewPool(func() *requestScratch { return &requestScratch{} })
+	first := scratchPool.Get()
+	first.User.Name, first.Tags, first.Retries, first.hits = "Alan", []string{"beta"}, 2, 5
+	ResetZero(first)
+	fmt.Printf("Reset scratch: zero=%v, unexported hits kept=%d\n", IsZeroStruct(first), first.hits)
+	scratchPool.Put(first)
+
+	if err := ResetZero(user); err != nil {
+		fmt.Printf("Passing a value: %s\n", ErrorText(err.Error()))
+	}
+	fmt.Println()
+}
@@ -0,0 +1,159 @@
+// renderer.go
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// Renderer presents a value on w, letting examples return plain data while
+// the caller picks the output format
+type Renderer interface {
+	Render(w io.Writer, v interface{}) error
+}
+
+// RenderFormats lists the names NewRenderer accepts
+var RenderFormats = []string{"text", "table", "json"}
+
+// NewRenderer returns the renderer for format, one of RenderFormats
+func NewRenderer(format string) (Renderer, error) {
+	switch strings.ToLower(format) {
+	case "text":
+		return TextRenderer{}, nil
+	case "table":
+		return TableRenderer{}, nil
+	case "json":
+		return JSONRenderer{Indent: 2}, nil
+	}
+	return nil, fmt.Errorf("unknown format %q (want one of: %s)", format, strings.Join(RenderFormats, ", "))
+}
+
+// JSONRenderer writes v as JSON; Indent is the number of spaces per level,
+// 0 (or less) for compact output
+type JSONRenderer struct {
+	Indent int
+}
+
+func (r JSONRenderer) Render(w io.Writer, v interface{}) error {
+	var data []byte
+	var err error
+	if r.Indent > 0 {
+		data, err = json.MarshalIndent(v, "", strings.Repeat(" ", r.Indent))
+	} else {
+		data, err = json.Marshal(v)
+	}
+	if err != nil {
+		return fmt.Errorf("render json: %w", err)
+	}
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+// TextRenderer writes v with PrettyPrintValue
+type TextRenderer struct {
+	Options PrintOptions
+}
+
+func (r TextRenderer) Render(w io.Writer, v interface{}) error {
+	PrettyPrintValue(w, v, r.Options)
+	return nil
+}
+
+// TableRenderer draws an aligned table: a slice of structs becomes one row
+// per element with a column per exported field, a single struct becomes
+// field/value rows (nested structs expanded one level) and a map becomes
+// key/value rows sorted by key
+type TableRenderer struct {
+	Options TableOptions
+}
+
+func (r TableRenderer) Render(w io.Writer, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	var table *Table
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		elemType := rv.Type().Elem()
+		if elemType.Kind() == reflect.Pointer {
+			elemType = elemType.Elem()
+		}
+		if elemType.Kind() != reflect.Struct {
+			return fmt.Errorf("render table: need a slice of structs, got %T", v)
+		}
+		table = sliceTable(rv, elemType)
+
+	case reflect.Struct:
+		table = NewTable("Field", "Value")
+		addStructRows(table, rv, "", 1, func(name string, field reflect.StructField, value reflect.Value) []string {
+			return []string{name, fmt.Sprintf("%v", value)}
+		})
+
+	case reflect.Map:
+		table = NewTable("Key", "Value")
+		for _, key := range sortedMapKeys(rv) {
+			table.AddRow(fmt.Sprintf("%v", key), fmt.Sprintf("%v", rv.MapIndex(key)))
+		}
+
+	default:
+		return fmt.Errorf("render table: need a slice of structs, a struct or a map, got %T", v)
+	}
+
+	table.Options = r.Options
+	table.Render(w)
+	return nil
+}
+
+// sliceTable builds one row per element of rv, using the exported fields
+// of elemType as columns
+func sliceTable(rv reflect.Value, elemType reflect.Type) *Table {
+	var fields []int
+	var header []string
+	for i := 0; i < elemType.NumField(); i++ {
+		if field := elemType.Field(i); field.IsExported() {
+			fields = append(fields, i)
+			header = append(header, field.Name)
+		}
+	}
+
+	table := NewTable(header...)
+	for i := 0; i < rv.Len(); i++ {
+		elem := rv.Index(i)
+		if elem.Kind() == reflect.Pointer {
+			if elem.IsNil() {
+				table.AddRow()
+				continue
+			}
+			elem = elem.Elem()
+		}
+		row := make([]string, len(fields))
+		for col, field := range fields {
+			row[col] = fmt.Sprintf("%v", elem.Field(field))
+		}
+		table.AddRow(row...)
+	}
+	return table
+}
+
+// Dataset is a named piece of example data for the CLI to render
+type Dataset struct {
+	Name string
+	Data interface{}
+}
+
+// ExampleDatasets returns the data-returning examples in display order
+func ExampleDatasets() ([]Dataset, error) {
+	config, err := SampleConfig()
+	if err != nil {
+		return nil, err
+	}
+	return []Dataset{
+		{"employees", EmployeeDirectory()},
+		{"config", config},
+	}, nil
+}
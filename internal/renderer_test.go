@@ -0,0 +1,81 @@
+// renderer_test.go
+package internal
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func renderWith(t *testing.T, r Renderer, v interface{}) string {
+	t.Helper()
+	var b strings.Builder
+	testutil.AssertNoError(t, r.Render(&b, v))
+	return b.String()
+}
+
+func TestRenderersShareDataset(t *testing.T) {
+	employees := EmployeeDirectory()
+
+	for _, format := range RenderFormats {
+		r, err := NewRenderer(format)
+		testutil.AssertNoError(t, err)
+		if out := renderWith(t, r, employees); strings.TrimSpace(out) == "" {
+			t.Errorf("%s renderer produced no output", format)
+		}
+	}
+
+	out := renderWith(t, JSONRenderer{Indent: 2}, employees)
+	testutil.AssertEqual(t, json.Valid([]byte(out)), true)
+	var decoded []Employee
+	testutil.AssertNoError(t, json.Unmarshal([]byte(out), &decoded))
+	testutil.AssertEqual(t, len(decoded), len(employees))
+	testutil.AssertEqual(t, decoded[2].Name, "Charlie")
+
+	compact := renderWith(t, JSONRenderer{}, employees)
+	testutil.AssertEqual(t, strings.Count(compact, "\n"), 1)
+	testutil.AssertEqual(t, renderWith(t, JSONRenderer{Indent: -1}, employees), compact)
+
+	table := renderWith(t, TableRenderer{Options: TableOptions{MaxWidth: -1}}, employees)
+	testutil.AssertContains(t, table, "| ID | Name ")
+	testutil.AssertContains(t, table, "| Charlie ")
+	testutil.AssertEqual(t, strings.Count(table, "\n"), 4+len(employees))
+}
+
+func TestTableRendererStructAndMap(t *testing.T) {
+	config, err := SampleConfig()
+	testutil.AssertNoError(t, err)
+	out := renderWith(t, TableRenderer{Options: TableOptions{MaxWidth: -1}}, &config)
+	testutil.AssertContains(t, out, "| Field ")
+	testutil.AssertContains(t, out, "| Database.Host     | db.example.com ")
+
+	out = renderWith(t, TableRenderer{Options: TableOptions{MaxWidth: -1, NoBorder: true}}, map[string]int{"b": 2, "a": 1})
+	testutil.AssertEqual(t, out, "Key  Value\n---  -----\na    1\nb    2\n")
+}
+
+func TestTableRendererRejectsUnsupported(t *testing.T) {
+	var b strings.Builder
+	err := TableRenderer{}.Render(&b, []int{1, 2})
+	testutil.AssertEqual(t, err.Error(), "render table: need a slice of structs, got []int")
+	err = TableRenderer{}.Render(&b, "text")
+	testutil.AssertEqual(t, err.Error(), "render table: need a slice of structs, a struct or a map, got string")
+}
+
+func TestNewRendererUnknownFormat(t *testing.T) {
+	_, err := NewRenderer("yaml")
+	testutil.AssertEqual(t, err.Error(), `unknown format "yaml" (want one of: text, table, json)`)
+
+	r, err := NewRenderer("JSON")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, r, Renderer(JSONRenderer{Indent: 2}))
+}
+
+func TestExampleDatasets(t *testing.T) {
+	datasets, err := ExampleDatasets()
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(datasets), 2)
+	testutil.AssertEqual(t, datasets[0].Name, "employees")
+	testutil.AssertEqual(t, datasets[1].Name, "config")
+}
@@ -0,0 +1,74 @@
+// reset_zero.go
+package internal
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ResetZero sets every exported field of the struct ptr points to back to
+// its zero value, so a pooled object can be reused without leaking the
+// previous user's data. Exported fields are zeroed whole, nested structs
+// included, and pointers become nil rather than having their targets
+// cleared, since those may be shared. Unexported fields are left as they
+// are, except that exported fields promoted from an unexported embedded
+// struct are reset too.
+func ResetZero(ptr interface{}) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("ResetZero needs a non-nil pointer to a struct, got %T", ptr)
+	}
+	resetStruct(rv.Elem())
+	return nil
+}
+
+func resetStruct(v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := v.Field(i)
+		switch {
+		case field.IsExported():
+			fv.SetZero()
+		case field.Anonymous && field.Type.Kind() == reflect.Struct:
+			resetStruct(fv)
+		}
+	}
+}
+
+// IsZeroStruct reports whether every field ResetZero would reset is zero,
+// so IsZeroStruct always holds right after ResetZero. v may be a struct or
+// a pointer to one; a nil pointer counts as zero and anything else that is
+// not a struct reports false.
+func IsZeroStruct(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return true
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return false
+	}
+	return structIsZero(rv)
+}
+
+func structIsZero(v reflect.Value) bool {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := v.Field(i)
+		switch {
+		case field.IsExported():
+			if !fv.IsZero() {
+				return false
+			}
+		case field.Anonymous && field.Type.Kind() == reflect.Struct:
+			if !structIsZero(fv) {
+				return false
+			}
+		}
+	}
+	return true
+}
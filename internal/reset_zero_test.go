@@ -0,0 +1,70 @@
+// reset_zero_test.go
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestResetZeroJSONUser(t *testing.T) {
+	profile := &Profile{Bio: "gopher", Interests: []string{"go"}}
+	user := &JSONUser{
+		ID: 1, Name: "Ada", Email: "ada@example.com", Password: "secret", Age: 36,
+		IsActive: true, CreatedAt: time.Now(), Profile: profile,
+	}
+	testutil.AssertEqual(t, IsZeroStruct(user), false)
+
+	testutil.AssertNoError(t, ResetZero(user))
+	testutil.AssertEqual(t, IsZeroStruct(user), true)
+	testutil.AssertEqual(t, user.Profile == nil, true)
+	testutil.AssertEqual(t, user.Name, "")
+	testutil.AssertEqual(t, user.CreatedAt.IsZero(), true)
+	testutil.AssertEqual(t, profile.Bio, "gopher") // the shared target is not cleared
+}
+
+type resetBase struct {
+	Version int
+	secret  string
+}
+
+type resetRecord struct {
+	resetBase
+	Inner struct{ Count int }
+	Tags  []string
+	cache map[string]int
+}
+
+func TestResetZeroNestedAndUnexported(t *testing.T) {
+	r := resetRecord{
+		resetBase: resetBase{Version: 3, secret: "kept"},
+		Tags:      []string{"a"},
+		cache:     map[string]int{"x": 1},
+	}
+	r.Inner.Count = 5
+
+	testutil.AssertNoError(t, ResetZero(&r))
+	testutil.AssertEqual(t, r.Version, 0)
+	testutil.AssertEqual(t, r.Inner.Count, 0)
+	testutil.AssertEqual(t, r.Tags == nil, true)
+	testutil.AssertEqual(t, r.secret, "kept")
+	testutil.AssertEqual(t, len(r.cache), 1)
+	// Unexported fields are ignored by IsZeroStruct as well
+	testutil.AssertEqual(t, IsZeroStruct(r), true)
+
+	r.Inner.Count = 1
+	testutil.AssertEqual(t, IsZeroStruct(&r), false)
+}
+
+func TestResetZeroRejectsNonStructPointers(t *testing.T) {
+	var user JSONUser
+	testutil.AssertContains(t, ResetZero(user).Error(), "got internal.JSONUser")
+	testutil.AssertContains(t, ResetZero((*JSONUser)(nil)).Error(), "non-nil pointer")
+	n := 5
+	testutil.AssertContains(t, ResetZero(&n).Error(), "got *int")
+
+	testutil.AssertEqual(t, IsZeroStruct((*JSONUser)(nil)), true)
+	testutil.AssertEqual(t, IsZeroStruct(0), false)
+	testutil.AssertEqual(t, IsZeroStruct(JSONUser{}), true)
+}
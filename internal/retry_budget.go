@@ -0,0 +1,99 @@
+// retry_budget.go
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// defaultAttemptTimeout is each attempt's budget when ctx has no deadline
+const defaultAttemptTimeout = 5 * time.Second
+
+// retryPauseBase and retryPauseMax bound the jittered, doubling pause
+// Retry takes between attempts. The base is kept short because every pause
+// eats into the deadline the attempts share.
+const (
+	retryPauseBase = 10 * time.Millisecond
+	retryPauseMax  = time.Second
+)
+
+// DeadlineSplit selects how SplitDeadline shares the remaining time
+type DeadlineSplit int
+
+const (
+	SplitEven     DeadlineSplit = iota // every attempt gets the same share (default)
+	SplitDoubling                      // each attempt gets twice the previous one's share
+)
+
+// SplitDeadline divides the time left before ctx's deadline into one
+// timeout per attempt, so a retry loop that gives attempt i at most
+// budgets[i] cannot overshoot the overall deadline. The shares sum to the
+// remaining time, apart from nanosecond rounding. Without a deadline every
+// attempt gets defaultAttemptTimeout (5s).
+func SplitDeadline(ctx context.Context, attempts int, split ...DeadlineSplit) []time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	budgets := make([]time.Duration, attempts)
+
+	remaining, ok := RemainingTime(ctx)
+	if !ok {
+		for i := range budgets {
+			budgets[i] = defaultAttemptTimeout
+		}
+		return budgets
+	}
+
+	weights := make([]float64, attempts)
+	var total float64
+	for i := range weights {
+		weights[i] = 1
+		if len(split) > 0 && split[0] == SplitDoubling && i > 0 {
+			weights[i] = 2 * weights[i-1]
+		}
+		total += weights[i]
+	}
+	for i, w := range weights {
+		budgets[i] = time.Duration(float64(remaining) * w / total)
+	}
+	return budgets
+}
+
+// Retry calls fn up to attempts times until it succeeds, giving each call
+// its own context.WithTimeout. Before every attempt the time still left is
+// re-split across the attempts that remain, so time saved by a quick
+// failure goes to the later attempts. Between attempts Retry pauses for
+// the next delay of a jittered Backoff. It stops early once ctx is done.
+func Retry(ctx context.Context, attempts int, fn func(ctx context.Context) error, split ...DeadlineSplit) error {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	backoff := NewBackoff(retryPauseBase, retryPauseMax, 2, true)
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(backoff.Next())
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return fmt.Errorf("attempt %d: %w", attempt, lastErr)
+			}
+		}
+
+		budget := SplitDeadline(ctx, attempts-attempt, split...)[0]
+		attemptCtx, cancel := context.WithTimeout(ctx, budget)
+		lastErr = fn(attemptCtx)
+		cancel()
+
+		if lastErr == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return fmt.Errorf("attempt %d: %w", attempt+1, lastErr)
+		}
+	}
+	return fmt.Errorf("giving up after %d attempts: %w", attempts, lastErr)
+}
@@ -0,0 +1,125 @@
+// retry_budget_test.go
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestRetryPausesBetweenAttempts(t *testing.T) {
+	errTransient := errors.New("transient")
+	var starts []time.Time
+	err := Retry(context.Background(), 3, func(ctx context.Context) error {
+		starts = append(starts, time.Now())
+		if len(starts) < 3 {
+			return errTransient
+		}
+		return nil
+	})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(starts), 3)
+	// jitter keeps each pause within [d/2, d) of the doubling delay
+	if gap := starts[1].Sub(starts[0]); gap < retryPauseBase/2 {
+		t.Errorf("first pause %v, want at least %v", gap, retryPauseBase/2)
+	}
+	if gap := starts[2].Sub(starts[1]); gap < retryPauseBase {
+		t.Errorf("second pause %v, want at least %v", gap, retryPauseBase)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	errTransient := errors.New("transient")
+	calls := 0
+	err := Retry(context.Background(), 2, func(ctx context.Context) error {
+		calls++
+		return errTransient
+	})
+	testutil.AssertErrorIs(t, err, errTransient)
+	testutil.AssertContains(t, err.Error(), "giving up after 2 attempts")
+	testutil.AssertEqual(t, calls, 2)
+}
+
+func TestRetryStopsWhenContextEnds(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	errTransient := errors.New("transient")
+	calls := 0
+	err := Retry(ctx, 5, func(ctx context.Context) error {
+		calls++
+		cancel()
+		return errTransient
+	})
+	testutil.AssertErrorIs(t, err, errTransient)
+	testutil.AssertContains(t, err.Error(), "attempt 1")
+	testutil.AssertEqual(t, calls, 1)
+}
+
+// sumDurations adds up budgets
+func sumDurations(budgets []time.Duration) time.Duration {
+	var total time.Duration
+	for _, b := range budgets {
+		total += b
+	}
+	return total
+}
+
+func TestSplitDeadlineEven(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 900*time.Millisecond)
+	defer cancel()
+
+	budgets := SplitDeadline(ctx, 3)
+	testutil.AssertEqual(t, len(budgets), 3)
+	testutil.AssertEqual(t, budgets[0], budgets[2])
+	if total := sumDurations(budgets); total > 900*time.Millisecond || total < 850*time.Millisecond {
+		t.Errorf("budgets sum to %v, want just under 900ms", total)
+	}
+}
+
+func TestSplitDeadlineDoubling(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 700*time.Millisecond)
+	defer cancel()
+
+	budgets := SplitDeadline(ctx, 3, SplitDoubling)
+	// Shares of 1/7, 2/7 and 4/7 of the remaining time
+	for i, factor := range []time.Duration{1, 2, 4} {
+		if diff := budgets[i] - factor*budgets[0]; diff < -time.Microsecond || diff > time.Microsecond {
+			t.Errorf("budget %d = %v, want %d x %v", i, budgets[i], factor, budgets[0])
+		}
+	}
+	if total := sumDurations(budgets); total > 700*time.Millisecond || total < 650*time.Millisecond {
+		t.Errorf("budgets sum to %v, want just under 700ms", total)
+	}
+}
+
+func TestSplitDeadlineWithoutDeadline(t *testing.T) {
+	budgets := SplitDeadline(context.Background(), 4)
+	testutil.AssertEqual(t, len(budgets), 4)
+	for _, b := range budgets {
+		testutil.AssertEqual(t, b, defaultAttemptTimeout)
+	}
+	testutil.AssertEqual(t, len(SplitDeadline(context.Background(), 0)), 1)
+}
+
+func TestRetryAttemptsStayWithinDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	overall, _ := ctx.Deadline()
+
+	var deadlines []time.Time
+	err := Retry(ctx, 3, func(attemptCtx context.Context) error {
+		deadline, ok := attemptCtx.Deadline()
+		testutil.AssertEqual(t, ok, true)
+		deadlines = append(deadlines, deadline)
+		<-attemptCtx.Done() // use the whole budget
+		return attemptCtx.Err()
+	})
+	testutil.AssertErrorIs(t, err, context.DeadlineExceeded)
+	for i, d := range deadlines {
+		if d.After(overall) {
+			t.Errorf("attempt %d deadline %v is past the overall deadline %v", i+1, d, overall)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+// rotating_writer.go
+package internal
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rotationTimeFormat sorts lexically in time order and is safe in file
+// names on every platform
+const rotationTimeFormat = "20060102T150405.000000000"
+
+// RotatingFileWriter appends to a file and, once a write would push it
+// past MaxBytes, renames it to path.<timestamp> and starts a fresh one.
+// Only the MaxBackups newest rotated files are kept. A single write larger
+// than MaxBytes still goes out whole, into a file of its own. It is safe
+// for concurrent use.
+type RotatingFileWriter struct {
+	mu         sync.Mutex
+	path       string
+	maxBytes   int64
+	maxBackups int
+	clock      Clock
+	file       *os.File
+	size       int64
+}
+
+// NewRotatingFileWriter opens (or creates) path for appending. A nil clock
+// uses system time for the rotation suffixes.
+func NewRotatingFileWriter(path string, maxBytes int64, maxBackups int, clock Clock) (*RotatingFileWriter, error) {
+	if maxBytes <= 0 {
+		return nil, fmt.Errorf("max bytes must be positive, got %d", maxBytes)
+	}
+	w := &RotatingFileWriter{path: path, maxBytes: maxBytes, maxBackups: maxBackups, clock: clockOrSystem(clock)}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Write appends p, rotating first if p would not fit in the current file
+func (w *RotatingFileWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return 0, os.ErrClosed
+	}
+	if w.size > 0 && w.size+int64(len(p)) > w.maxBytes {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+// Close closes the current file
+func (w *RotatingFileWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
+
+// Backups lists the rotated files, oldest first
+func (w *RotatingFileWriter) Backups() ([]string, error) {
+	matches, err := filepath.Glob(w.path + ".*")
+	if err != nil {
+		return nil, err
+	}
+	var backups []string
+	for _, match := range matches {
+		suffix := strings.TrimPrefix(match, w.path+".")
+		if _, err := time.Parse(rotationTimeFormat, suffix); err == nil {
+			backups = append(backups, match)
+		}
+	}
+	sort.Strings(backups)
+	return backups, nil
+}
+
+func (w *RotatingFileWriter) open() error {
+	file, err := os.OpenFile(w.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("open %s: %w", w.path, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("stat %s: %w", w.path, err)
+	}
+	w.file, w.size = file, info.Size()
+	return nil
+}
+
+// rotate renames the current file aside, prunes old backups and reopens
+// path. The caller holds w.mu.
+func (w *RotatingFileWriter) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return fmt.Errorf("rotate %s: %w", w.path, err)
+	}
+	w.file = nil
+
+	// Two rotations within one clock tick must not overwrite each other
+	stamp := w.clock.Now().UTC()
+	backup := w.path + "." + stamp.Format(rotationTimeFormat)
+	for {
+		if _, err := os.Stat(backup); err != nil {
+			break
+		}
+		stamp = stamp.Add(time.Nanosecond)
+		backup = w.path + "." + stamp.Format(rotationTimeFormat)
+	}
+	if err := os.Rename(w.path, backup); err != nil {
+		return fmt.Errorf("rotate %s: %w", w.path, err)
+	}
+	if err := w.prune(); err != nil {
+		return err
+	}
+	return w.open()
+}
+
+// prune deletes all but the maxBackups newest backups
+func (w *RotatingFileWriter) prune() error {
+	backups, err := w.Backups()
+	if err != nil {
+		return err
+	}
+	for len(backups) > max(w.maxBackups, 0) {
+		if err := os.Remove(backups[0]); err != nil {
+			return fmt.Errorf("prune %s: %w", backups[0], err)
+		}
+		backups = backups[1:]
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// rotating_writer_test.go
+package internal
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func readString(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	testutil.AssertNoError(t, err)
+	return string(data)
+}
+
+func TestRotatingFileWriterRotatesAndPrunes(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	path := filepath.Join(t.TempDir(), "app.log")
+	w, err := NewRotatingFileWriter(path, 20, 2, clock)
+	testutil.AssertNoError(t, err)
+
+	// Each line is 10 bytes, so every file holds two lines
+	for i := 0; i < 9; i++ {
+		_, err := fmt.Fprintf(w, "line %04d\n", i)
+		testutil.AssertNoError(t, err)
+		clock.Advance(time.Second)
+	}
+	testutil.AssertNoError(t, w.Close())
+
+	backups, err := w.Backups()
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len(backups), 2) // four rotations, only two kept
+	// A backup is stamped when the next write forces the rotation
+	testutil.AssertEqual(t, filepath.Base(backups[0]), "app.log.20240101T000006.000000000")
+	testutil.AssertEqual(t, readString(t, backups[0]), "line 0004\nline 0005\n")
+	testutil.AssertEqual(t, readString(t, backups[1]), "line 0006\nline 0007\n")
+	testutil.AssertEqual(t, readString(t, path), "line 0008\n") // newest writes
+}
+
+func TestRotatingFileWriterSameTickAndOversizedWrites(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) // never advanced
+	path := filepath.Join(t.TempDir(), "app.log")
+	w, err := NewRotatingFileWriter(path, 5, 10, clock)
+	testutil.AssertNoError(t, err)
+	defer w.Close()
+
+	w.Write([]byte("0123456789")) // larger than maxBytes, written whole
+	w.Write([]byte("abc"))
+	w.Write([]byte("def"))
+
+	backups, _ := w.Backups()
+	testutil.AssertEqual(t, len(backups), 2) // distinct names despite the frozen clock
+	testutil.AssertEqual(t, readString(t, backups[0]), "0123456789")
+	testutil.AssertEqual(t, readString(t, backups[1]), "abc")
+	testutil.AssertEqual(t, readString(t, path), "def")
+}
+
+func TestRotatingFileWriterAppendsToExisting(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	testutil.AssertNoError(t, os.WriteFile(path, []byte("old-data\n"), 0o644))
+
+	w, err := NewRotatingFileWriter(path, 12, 1, nil)
+	testutil.AssertNoError(t, err)
+	w.Write([]byte("new\n")) // the existing size counts towards the limit
+	testutil.AssertNoError(t, w.Close())
+
+	backups, _ := w.Backups()
+	testutil.AssertEqual(t, len(backups), 1)
+	testutil.AssertEqual(t, readString(t, backups[0]), "old-data\n")
+	testutil.AssertEqual(t, readString(t, path), "new\n")
+}
+
+func TestRotatingFileWriterErrors(t *testing.T) {
+	_, err := NewRotatingFileWriter(filepath.Join(t.TempDir(), "x.log"), 0, 1, nil)
+	testutil.AssertEqual(t, err.Error(), "max bytes must be positive, got 0")
+
+	w, _ := NewRotatingFileWriter(filepath.Join(t.TempDir(), "x.log"), 10, 1, nil)
+	w.Close()
+	testutil.AssertNoError(t, w.Close())
+	_, err = w.Write([]byte("late"))
+	testutil.AssertErrorIs(t, err, os.ErrClosed)
+}
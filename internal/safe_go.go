@@ -0,0 +1,59 @@
+// safe_go.go
+package internal
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+)
+
+// PanicHandler receives a panic recovered by Go or GoCtx together with the
+// stack of the goroutine that panicked
+type PanicHandler func(recovered interface{}, stack []byte)
+
+var (
+	panicHandlerMu sync.RWMutex
+	panicHandler   PanicHandler
+)
+
+// SetPanicHandler registers h to be called after every recovered panic,
+// for example to count failures or report them; nil removes the handler
+func SetPanicHandler(h PanicHandler) {
+	panicHandlerMu.Lock()
+	panicHandler = h
+	panicHandlerMu.Unlock()
+}
+
+// Go runs fn in a new goroutine. A panic in fn is recovered, logged with
+// its stack through the default logger and passed to the registered
+// PanicHandler, instead of crashing the whole program. Deferred calls in fn
+// still run, so a deferred wg.Done is not lost.
+func Go(fn func()) {
+	go runRecovered(defaultLogger, fn)
+}
+
+// GoCtx is Go logging through the context's Logger, so recovered panics
+// carry the request's trace ID
+func GoCtx(ctx context.Context, fn func()) {
+	go runRecovered(LoggerFromContext(ctx), fn)
+}
+
+func runRecovered(logger *Logger, fn func()) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		stack := debug.Stack()
+		logger.Log(fmt.Sprintf("recovered goroutine panic: %v\n%s", r, stack))
+
+		panicHandlerMu.RLock()
+		h := panicHandler
+		panicHandlerMu.RUnlock()
+		if h != nil {
+			h(r, stack)
+		}
+	}()
+	fn()
+}
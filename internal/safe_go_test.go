@@ -0,0 +1,83 @@
+// safe_go_test.go
+package internal
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+type recoveredPanic struct {
+	value interface{}
+	stack string
+}
+
+// capturePanics registers a handler for the rest of the test and returns
+// the channel it reports to
+func capturePanics(t *testing.T) <-chan recoveredPanic {
+	t.Helper()
+	panics := make(chan recoveredPanic, 1)
+	SetPanicHandler(func(r interface{}, stack []byte) { panics <- recoveredPanic{r, string(stack)} })
+	t.Cleanup(func() { SetPanicHandler(nil) })
+	return panics
+}
+
+func awaitPanic(t *testing.T, panics <-chan recoveredPanic) recoveredPanic {
+	t.Helper()
+	select {
+	case p := <-panics:
+		return p
+	case <-time.After(2 * time.Second):
+		t.Fatalf("panic handler was not called")
+		return recoveredPanic{}
+	}
+}
+
+func TestGoRecoversPanic(t *testing.T) {
+	var logged strings.Builder
+	defaultLogger.SetOutput(&logged)
+	t.Cleanup(func() { defaultLogger.SetOutput(nil) })
+	panics := capturePanics(t)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	Go(func() {
+		defer wg.Done()
+		panic("worker exploded")
+	})
+	wg.Wait() // deferred calls still run
+
+	p := awaitPanic(t, panics)
+	testutil.AssertEqual(t, p.value, interface{}("worker exploded"))
+	testutil.AssertContains(t, p.stack, "safe_go_test.go")
+	testutil.AssertContains(t, logged.String(), "[APP] recovered goroutine panic: worker exploded")
+}
+
+func TestGoCtxLogsWithTraceID(t *testing.T) {
+	var logged strings.Builder
+	logger := NewLogger("JOBS")
+	logger.SetOutput(&logged)
+	ctx := WithTrace(WithLogger(context.Background(), logger), "job-9")
+	panics := capturePanics(t)
+
+	GoCtx(ctx, func() { panic(42) })
+	p := awaitPanic(t, panics)
+	testutil.AssertEqual(t, p.value, interface{}(42))
+	testutil.AssertContains(t, logged.String(), "[JOBS] [trace=job-9] recovered goroutine panic: 42")
+}
+
+func TestGoWithoutPanic(t *testing.T) {
+	panics := capturePanics(t)
+	done := make(chan struct{})
+	Go(func() { close(done) })
+	<-done
+	select {
+	case p := <-panics:
+		t.Fatalf("handler called without a panic: %v", p.value)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
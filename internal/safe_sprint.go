@@ -0,0 +1,125 @@
+// safe_sprint.go
+package internal
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// SafeSprint renders any value, including cyclic structures, as Go-like
+// text: &CircularA{B: &CircularB{A: <cycle>}}. A pointer, map or slice that
+// refers back to a value currently being printed is shown as <cycle>;
+// shared but acyclic references are printed in full each time.
+func SafeSprint(v interface{}) string {
+	s := &safePrinter{onPath: make(map[pointerKey]bool)}
+	s.value(reflect.ValueOf(v))
+	return s.b.String()
+}
+
+type safePrinter struct {
+	b      strings.Builder
+	onPath map[pointerKey]bool
+}
+
+// enter marks a reference as being printed; it reports false when the
+// reference is already on the current path, i.e. a cycle
+func (s *safePrinter) enter(v reflect.Value) (pointerKey, bool) {
+	visit := pointerKeyOf(v)
+	if s.onPath[visit] {
+		return visit, false
+	}
+	s.onPath[visit] = true
+	return visit, true
+}
+
+func (s *safePrinter) value(v reflect.Value) {
+	if !v.IsValid() {
+		s.b.WriteString("<nil>")
+		return
+	}
+
+	if v.Kind() == reflect.Struct && v.CanInterface() {
+		if str, ok := v.Interface().(fmt.Stringer); ok {
+			s.b.WriteString(str.String())
+			return
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			s.b.WriteString("<nil>")
+			return
+		}
+		s.value(v.Elem())
+
+	case reflect.Ptr:
+		if v.IsNil() {
+			s.b.WriteString("<nil>")
+			return
+		}
+		visit, ok := s.enter(v)
+		if !ok {
+			s.b.WriteString("<cycle>")
+			return
+		}
+		defer delete(s.onPath, visit)
+		s.b.WriteString("&")
+		s.value(v.Elem())
+
+	case reflect.Struct:
+		s.b.WriteString(v.Type().Name() + "{")
+		for i := 0; i < v.NumField(); i++ {
+			if i > 0 {
+				s.b.WriteString(", ")
+			}
+			s.b.WriteString(v.Type().Field(i).Name + ": ")
+			s.value(v.Field(i))
+		}
+		s.b.WriteString("}")
+
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.Len() > 0 {
+			visit, ok := s.enter(v)
+			if !ok {
+				s.b.WriteString("<cycle>")
+				return
+			}
+			defer delete(s.onPath, visit)
+		}
+		s.b.WriteString("[")
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				s.b.WriteString(", ")
+			}
+			s.value(v.Index(i))
+		}
+		s.b.WriteString("]")
+
+	case reflect.Map:
+		if v.IsNil() {
+			s.b.WriteString("map[]")
+			return
+		}
+		visit, ok := s.enter(v)
+		if !ok {
+			s.b.WriteString("<cycle>")
+			return
+		}
+		defer delete(s.onPath, visit)
+		s.b.WriteString("map[")
+		for i, key := range sortedMapKeys(v) {
+			if i > 0 {
+				s.b.WriteString(", ")
+			}
+			s.value(key)
+			s.b.WriteString(": ")
+			s.value(v.MapIndex(key))
+		}
+		s.b.WriteString("]")
+
+	default:
+		s.b.WriteString(formatScalar(v))
+	}
+}
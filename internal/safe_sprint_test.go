@@ -0,0 +1,44 @@
+// safe_sprint_test.go
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestSafeSprintCircularPair(t *testing.T) {
+	a := &CircularA{}
+	b := &CircularB{A: a}
+	a.B = b
+
+	done := make(chan string, 1)
+	go func() { done <- SafeSprint(a) }()
+	select {
+	case got := <-done:
+		testutil.AssertEqual(t, got, "&CircularA{B: &CircularB{A: <cycle>}}")
+	case <-time.After(2 * time.Second):
+		t.Fatal("SafeSprint did not return on a cyclic value")
+	}
+}
+
+func TestSafeSprintSelfReferencingContainers(t *testing.T) {
+	m := map[string]interface{}{"name": "loop"}
+	m["self"] = m
+	testutil.AssertEqual(t, SafeSprint(m), `map["name": "loop", "self": <cycle>]`)
+
+	s := make([]interface{}, 2)
+	s[0] = 1
+	s[1] = s
+	testutil.AssertEqual(t, SafeSprint(s), "[1, <cycle>]")
+}
+
+func TestSafeSprintSharedIsNotACycle(t *testing.T) {
+	shared := &Product{Name: "pen", Price: 1.5}
+	pair := []*Product{shared, shared}
+	want := `[&Product{Name: "pen", Price: 1.5, Category: ""}, &Product{Name: "pen", Price: 1.5, Category: ""}]`
+	testutil.AssertEqual(t, SafeSprint(pair), want)
+	testutil.AssertEqual(t, SafeSprint(nil), "<nil>")
+	testutil.AssertEqual(t, SafeSprint((*Product)(nil)), "<nil>")
+}
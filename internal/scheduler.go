@@ -0,0 +1,124 @@
+// scheduler.go
+package internal
+
+import (
+	"sync"
+	"time"
+)
+
+// Scheduler runs functions at a later time. Pending tasks live in a
+// priority queue ordered by due time (ties run in scheduling order) and a
+// single dispatcher goroutine runs them one after another, so a slow task
+// delays the ones behind it. It is safe for concurrent use.
+type Scheduler struct {
+	mu      sync.Mutex
+	queue   *PriorityQueue[scheduledFunc]
+	seq     int
+	clock   Clock
+	wake    chan struct{} // nudges the dispatcher when an earlier task arrives
+	stop    chan struct{}
+	stopped bool
+}
+
+type scheduledFunc struct {
+	at  time.Time
+	seq int
+	fn  func()
+}
+
+// NewScheduler starts a scheduler driven by clock (nil means the system
+// clock). Call Stop to release its goroutine.
+func NewScheduler(clock Clock) *Scheduler {
+	s := &Scheduler{
+		queue: NewPriorityQueue(func(a, b scheduledFunc) bool {
+			if a.at.Equal(b.at) {
+				return a.seq < b.seq
+			}
+			return a.at.Before(b.at)
+		}),
+		clock: clockOrSystem(clock),
+		wake:  make(chan struct{}, 1),
+		stop:  make(chan struct{}),
+	}
+	go s.dispatch()
+	return s
+}
+
+// Schedule runs fn once delay has elapsed
+func (s *Scheduler) Schedule(delay time.Duration, fn func()) {
+	s.ScheduleAt(s.clock.Now().Add(delay), fn)
+}
+
+// ScheduleAt runs fn at t; a time in the past runs it as soon as possible.
+// Tasks scheduled after Stop are ignored.
+func (s *Scheduler) ScheduleAt(t time.Time, fn func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped {
+		return
+	}
+	s.seq++
+	s.queue.Push(scheduledFunc{at: t, seq: s.seq, fn: fn})
+
+	select {
+	case s.wake <- struct{}{}:
+	default: // a wake-up is already pending
+	}
+}
+
+// Pending reports how many tasks have not run yet
+func (s *Scheduler) Pending() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.queue.Len()
+}
+
+// Stop discards every pending task and ends the dispatcher goroutine. A
+// task that is already running finishes; none start afterwards.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped {
+		return
+	}
+	s.stopped = true
+	for s.queue.Len() > 0 {
+		s.queue.Pop()
+	}
+	close(s.stop)
+}
+
+func (s *Scheduler) dispatch() {
+	for {
+		s.mu.Lock()
+		if s.stopped {
+			s.mu.Unlock()
+			return
+		}
+		next, ok := s.queue.Peek()
+		var wait time.Duration
+		if ok {
+			wait = next.at.Sub(s.clock.Now())
+			if wait <= 0 {
+				s.queue.Pop()
+			}
+		}
+		s.mu.Unlock()
+
+		if ok && wait <= 0 {
+			next.fn()
+			continue
+		}
+
+		var timer <-chan time.Time
+		if ok {
+			timer = s.clock.After(wait)
+		}
+		select {
+		case <-timer:
+		case <-s.wake:
+		case <-s.stop:
+			return
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// scheduler_test.go
+package internal
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// advanceUntil steps clock forward until done reports true. Small steps
+// with a pause between them let the dispatcher re-arm its timer, so no
+// task is skipped past while it is between waits.
+func advanceUntil(t *testing.T, clock *ManualClock, step time.Duration, done func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !done() {
+		if time.Now().After(deadline) {
+			t.Fatalf("scheduled tasks did not run")
+		}
+		clock.Advance(step)
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSchedulerRunsInDelayOrder(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewManualClock(start)
+	s := NewScheduler(clock)
+	defer s.Stop()
+
+	var mu sync.Mutex
+	var order []time.Duration
+	var early bool
+	for _, delay := range []time.Duration{30 * time.Millisecond, 10 * time.Millisecond, 20 * time.Millisecond, 10 * time.Millisecond} {
+		s.Schedule(delay, func() {
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, delay)
+			early = early || clock.Now().Sub(start) < delay
+		})
+	}
+	testutil.AssertEqual(t, s.Pending(), 4)
+
+	advanceUntil(t, clock, 5*time.Millisecond, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(order) == 4
+	})
+	testutil.AssertEqual(t, len(order), 4)
+	testutil.AssertEqual(t, order[0], 10*time.Millisecond)
+	testutil.AssertEqual(t, order[1], 10*time.Millisecond)
+	testutil.AssertEqual(t, order[2], 20*time.Millisecond)
+	testutil.AssertEqual(t, order[3], 30*time.Millisecond)
+	testutil.AssertEqual(t, early, false)
+	testutil.AssertEqual(t, s.Pending(), 0)
+}
+
+func TestSchedulerScheduleAtPastRunsImmediately(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	s := NewScheduler(clock)
+	defer s.Stop()
+
+	ran := make(chan struct{})
+	s.ScheduleAt(clock.Now().Add(-time.Hour), func() { close(ran) })
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatalf("a task due in the past did not run without advancing the clock")
+	}
+}
+
+func TestSchedulerStopDiscardsPending(t *testing.T) {
+	before := runtime.NumGoroutine()
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	s := NewScheduler(clock)
+
+	ran := make(chan struct{}, 2)
+	s.Schedule(time.Hour, func() { ran <- struct{}{} })
+	waitForWaiters(t, clock, 1)
+	s.Stop()
+	s.Stop() // stopping twice is harmless
+	s.Schedule(0, func() { ran <- struct{}{} })
+	testutil.AssertEqual(t, s.Pending(), 0)
+
+	clock.Advance(2 * time.Hour)
+	select {
+	case <-ran:
+		t.Fatalf("a task ran after Stop")
+	case <-time.After(20 * time.Millisecond):
+	}
+	if n := settleGoroutines(before); n > before {
+		t.Errorf("dispatcher goroutine leaked: %d before, %d after", before, n)
+	}
+}
@@ -0,0 +1,30 @@
+// shuffle.go
+package internal
+
+import "math/rand"
+
+// Shuffle permutes s in place with the Fisher-Yates algorithm. All
+// randomness comes from r, so the same seed gives the same permutation.
+func Shuffle[T any](s []T, r *rand.Rand) {
+	for i := len(s) - 1; i > 0; i-- {
+		j := r.Intn(i + 1)
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// Sample returns min(n, len(s)) elements of s chosen uniformly without
+// replacement, using reservoir sampling so s is read once and left
+// unchanged. The result is in reservoir order, not input order.
+func Sample[T any](s []T, n int, r *rand.Rand) []T {
+	n = min(max(n, 0), len(s))
+	reservoir := make([]T, n)
+	copy(reservoir, s[:n])
+
+	for i := n; i < len(s); i++ {
+		// Element i replaces a reservoir slot with probability n/(i+1)
+		if j := r.Intn(i + 1); j < n {
+			reservoir[j] = s[i]
+		}
+	}
+	return reservoir
+}
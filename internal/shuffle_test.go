@@ -0,0 +1,72 @@
+// shuffle_test.go
+package internal
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func seq(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
+
+func TestShuffleReproducible(t *testing.T) {
+	a, b := seq(20), seq(20)
+	Shuffle(a, rand.New(rand.NewSource(42)))
+	Shuffle(b, rand.New(rand.NewSource(42)))
+	testutil.AssertEqual(t, fmt.Sprint(a), fmt.Sprint(b))
+	testutil.AssertEqual(t, fmt.Sprint(a) == fmt.Sprint(seq(20)), false)
+
+	// Still a permutation of the input
+	sort.Ints(a)
+	testutil.AssertEqual(t, fmt.Sprint(a), fmt.Sprint(seq(20)))
+
+	c := seq(20)
+	Shuffle(c, rand.New(rand.NewSource(7)))
+	testutil.AssertEqual(t, fmt.Sprint(c) == fmt.Sprint(b), false)
+}
+
+func TestShuffleTinySlices(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var empty []string
+	Shuffle(empty, r)
+	one := []string{"x"}
+	Shuffle(one, r)
+	testutil.AssertEqual(t, one[0], "x")
+}
+
+func TestSampleReproducible(t *testing.T) {
+	input := seq(100)
+	a := Sample(input, 10, rand.New(rand.NewSource(3)))
+	b := Sample(input, 10, rand.New(rand.NewSource(3)))
+	testutil.AssertEqual(t, fmt.Sprint(a), fmt.Sprint(b))
+	testutil.AssertEqual(t, fmt.Sprint(input), fmt.Sprint(seq(100))) // input untouched
+}
+
+func TestSampleDistinctFromInput(t *testing.T) {
+	r := rand.New(rand.NewSource(99))
+	input := seq(50)
+	for _, n := range []int{0, 1, 10, 50, 80, -3} {
+		got := Sample(input, n, r)
+		testutil.AssertEqual(t, len(got), min(max(n, 0), len(input)))
+
+		seen := map[int]bool{}
+		for _, v := range got {
+			if v < 0 || v >= len(input) {
+				t.Errorf("Sample returned %d, which is not in the input", v)
+			}
+			if seen[v] {
+				t.Errorf("Sample(n=%d) returned %d twice", n, v)
+			}
+			seen[v] = true
+		}
+	}
+}
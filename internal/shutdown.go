@@ -0,0 +1,97 @@
+// shutdown.go
+package internal
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ShutdownGroup collects cleanup functions and runs them in priority order
+// when the program stops, e.g. stop accepting requests, then drain
+// workers, then close the database. It is safe for concurrent use.
+type ShutdownGroup struct {
+	mu    sync.Mutex
+	hooks []shutdownHook
+	once  sync.Once
+	err   error
+}
+
+type shutdownHook struct {
+	name     string
+	priority int
+	fn       func(ctx context.Context) error
+}
+
+// NewShutdownGroup creates an empty group
+func NewShutdownGroup() *ShutdownGroup {
+	return &ShutdownGroup{}
+}
+
+// Register adds a cleanup. Higher priorities run first; cleanups with the
+// same priority run in registration order.
+func (g *ShutdownGroup) Register(name string, priority int, fn func(ctx context.Context) error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.hooks = append(g.hooks, shutdownHook{name: name, priority: priority, fn: fn})
+}
+
+// Shutdown runs every cleanup once, one after another. The time left
+// before ctx's deadline is re-split across the remaining cleanups before
+// each one starts (as Retry does for attempts, so a quick cleanup leaves
+// more for the rest), and a cleanup still running when its share is up is
+// logged and abandoned rather than holding up the others. Failures and
+// overruns are returned together as a *MultiError. Later calls return the
+// first call's result.
+func (g *ShutdownGroup) Shutdown(ctx context.Context) error {
+	g.once.Do(func() {
+		g.mu.Lock()
+		hooks := append([]shutdownHook(nil), g.hooks...)
+		g.mu.Unlock()
+		sort.SliceStable(hooks, func(i, j int) bool { return hooks[i].priority > hooks[j].priority })
+
+		logger := LoggerFromContext(ctx)
+		var problems MultiError
+		for i, hook := range hooks {
+			budget := SplitDeadline(ctx, len(hooks)-i)[0]
+			overran, err := runShutdownHook(ctx, hook, budget)
+			if overran {
+				logger.Log(fmt.Sprintf("shutdown: %s exceeded its %s budget", hook.name, HumanizeDuration(budget)))
+			}
+			if err != nil {
+				problems.Errors = append(problems.Errors, fmt.Errorf("%s: %w", hook.name, err))
+			}
+		}
+		if len(problems.Errors) > 0 {
+			g.err = &problems
+		}
+	})
+	return g.err
+}
+
+// runShutdownHook runs one cleanup with its own timeout, returning early
+// with overran set if the cleanup does not finish in time. A panic is
+// reported as an error.
+func runShutdownHook(ctx context.Context, hook shutdownHook, budget time.Duration) (overran bool, err error) {
+	hookCtx, cancel := context.WithTimeout(ctx, budget)
+	defer cancel()
+
+	done := make(chan error, 1) // buffered so an abandoned cleanup can still finish
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("panic: %v", r)
+			}
+		}()
+		done <- hook.fn(hookCtx)
+	}()
+
+	select {
+	case err := <-done:
+		return false, err
+	case <-hookCtx.Done():
+		return true, hookCtx.Err()
+	}
+}
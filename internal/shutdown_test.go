@@ -0,0 +1,77 @@
+// shutdown_test.go
+package internal
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestShutdownGroupRunsInPriorityOrder(t *testing.T) {
+	g := NewShutdownGroup()
+	var order []string
+	record := func(name string) func(context.Context) error {
+		return func(context.Context) error {
+			order = append(order, name)
+			return nil
+		}
+	}
+	g.Register("database", 1, record("database"))
+	g.Register("listener", 3, record("listener"))
+	g.Register("workers", 2, record("workers"))
+	g.Register("metrics", 3, record("metrics"))
+
+	testutil.AssertNoError(t, g.Shutdown(context.Background()))
+	testutil.AssertEqual(t, strings.Join(order, " "), "listener metrics workers database")
+
+	// A second call does not run anything again
+	testutil.AssertNoError(t, g.Shutdown(context.Background()))
+	testutil.AssertEqual(t, len(order), 4)
+}
+
+func TestShutdownGroupSlowCleanupDoesNotBlockOthers(t *testing.T) {
+	var out strings.Builder
+	logger := NewLogger("SHUTDOWN")
+	logger.SetOutput(&out)
+
+	release := make(chan struct{})
+	defer close(release)
+	errFlush := errors.New("flush failed")
+	ran := make(chan string, 3)
+
+	g := NewShutdownGroup()
+	g.Register("slow", 3, func(context.Context) error {
+		<-release // ignores its context
+		return nil
+	})
+	g.Register("cache", 2, func(context.Context) error {
+		ran <- "cache"
+		return errFlush
+	})
+	g.Register("crash", 1, func(context.Context) error {
+		ran <- "crash"
+		panic("bad state")
+	})
+
+	ctx, cancel := context.WithTimeout(WithLogger(context.Background(), logger), 300*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := g.Shutdown(ctx)
+	testutil.AssertEqual(t, time.Since(start) < 300*time.Millisecond, true)
+
+	testutil.AssertEqual(t, <-ran, "cache")
+	testutil.AssertEqual(t, <-ran, "crash")
+	var multi *MultiError
+	testutil.AssertEqual(t, errors.As(err, &multi), true)
+	testutil.AssertEqual(t, len(multi.Errors), 3)
+	testutil.AssertErrorIs(t, multi.Errors[0], context.DeadlineExceeded)
+	testutil.AssertContains(t, multi.Errors[0].Error(), "slow: ")
+	testutil.AssertErrorIs(t, multi.Errors[1], errFlush)
+	testutil.AssertContains(t, multi.Errors[2].Error(), "crash: panic: bad state")
+	testutil.AssertContains(t, out.String(), "shutdown: slow exceeded its")
+	testutil.AssertEqual(t, strings.Count(out.String(), "\n"), 1)
+}
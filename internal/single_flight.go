@@ -0,0 +1,57 @@
+// single_flight.go
+package internal
+
+import (
+	"fmt"
+	"sync"
+)
+
+// flightCall is one in-progress execution shared by every caller of a key
+type flightCall[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// SingleFlight returns a caller that collapses concurrent calls with the
+// same key into one: the first caller runs fn, and everyone who asks for
+// the key before it finishes waits and receives the same value and error.
+// Once fn returns the key is forgotten, so a later call runs fn again;
+// this deduplicates work in flight rather than caching results. If fn
+// panics, the panic propagates to the caller that ran it and the waiters
+// get an error.
+func SingleFlight[T any]() func(key string, fn func() (T, error)) (T, error) {
+	var mu sync.Mutex
+	calls := make(map[string]*flightCall[T])
+
+	return func(key string, fn func() (T, error)) (T, error) {
+		mu.Lock()
+		if call, ok := calls[key]; ok {
+			mu.Unlock()
+			<-call.done
+			return call.value, call.err
+		}
+		call := &flightCall[T]{done: make(chan struct{})}
+		calls[key] = call
+		mu.Unlock()
+
+		defer func() {
+			if r := recover(); r != nil {
+				call.err = fmt.Errorf("single-flight call for %q panicked: %v", key, r)
+				finishFlight(&mu, calls, key, call)
+				panic(r)
+			}
+			finishFlight(&mu, calls, key, call)
+		}()
+		call.value, call.err = fn()
+		return call.value, call.err
+	}
+}
+
+// finishFlight forgets key and releases everyone waiting on call
+func finishFlight[T any](mu *sync.Mutex, calls map[string]*flightCall[T], key string, call *flightCall[T]) {
+	mu.Lock()
+	delete(calls, key)
+	mu.Unlock()
+	close(call.done)
+}
@@ -0,0 +1,117 @@
+// single_flight_test.go
+package internal
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// waitForFlightWaiters blocks until n goroutines are parked on a channel
+// inside a SingleFlight call, counting both the caller running fn (when fn
+// blocks) and the callers waiting for it
+func waitForFlightWaiters(t *testing.T, n int) {
+	t.Helper()
+	buf := make([]byte, 1<<20)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		waiting := 0
+		for _, g := range strings.Split(string(buf[:runtime.Stack(buf, true)]), "\n\n") {
+			if strings.Contains(g, "[chan receive") && strings.Contains(g, "SingleFlight[") {
+				waiting++
+			}
+		}
+		if waiting >= n {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("only %d of %d callers waiting", waiting, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSingleFlightRunsOncePerKey(t *testing.T) {
+	call := SingleFlight[string]()
+	const callers = 50
+	var runs atomic.Int32
+	release := make(chan struct{})
+	fetch := func() (string, error) {
+		runs.Add(1)
+		<-release
+		return "user-42", nil
+	}
+
+	results := make([]string, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			v, err := call("user:42", fetch)
+			testutil.AssertNoError(t, err)
+			results[i] = v
+		}(i)
+	}
+	waitForFlightWaiters(t, callers)
+	close(release)
+	wg.Wait()
+
+	testutil.AssertEqual(t, runs.Load(), int32(1))
+	for _, v := range results {
+		testutil.AssertEqual(t, v, "user-42")
+	}
+
+	// The flight is over, so the next call runs fn again
+	release = make(chan struct{})
+	close(release)
+	call("user:42", fetch)
+	testutil.AssertEqual(t, runs.Load(), int32(2))
+}
+
+func TestSingleFlightSharesErrorsAndSeparatesKeys(t *testing.T) {
+	call := SingleFlight[int]()
+	errNotFound := errors.New("not found")
+	_, err := call("a", func() (int, error) { return 0, errNotFound })
+	testutil.AssertErrorIs(t, err, errNotFound)
+
+	v, err := call("b", func() (int, error) {
+		inner, err := call("c", func() (int, error) { return 2, nil }) // a different key does not wait
+		return inner * 10, err
+	})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, v, 20)
+}
+
+func TestSingleFlightPanicReleasesWaiters(t *testing.T) {
+	call := SingleFlight[int]()
+	release := make(chan struct{})
+	leaderPanic := make(chan interface{}, 1)
+	go func() {
+		defer func() { leaderPanic <- recover() }()
+		call("k", func() (int, error) {
+			<-release
+			panic("boom")
+		})
+	}()
+	waitForFlightWaiters(t, 1)
+
+	waiterErr := make(chan error, 1)
+	go func() {
+		_, err := call("k", func() (int, error) { return 1, nil })
+		waiterErr <- err
+	}()
+	waitForFlightWaiters(t, 2)
+	close(release)
+
+	testutil.AssertEqual(t, <-leaderPanic, interface{}("boom"))
+	err := <-waiterErr
+	testutil.AssertEqual(t, err != nil, true)
+	testutil.AssertEqual(t, err.Error(), `single-flight call for "k" panicked: boom`)
+}
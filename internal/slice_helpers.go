@@ -0,0 +1,90 @@
+// slice_helpers.go
+package internal
+
+import (
+	"cmp"
+	"sort"
+)
+
+// SliceEqual reports whether a and b have the same length and equal
+// elements in the same order. A nil slice equals an empty one.
+func SliceEqual[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// SortSlice sorts s in place in ascending order. cmp.Ordered is the
+// standard library's home for what golang.org/x/exp/constraints.Ordered
+// used to provide.
+func SortSlice[T cmp.Ordered](s []T) {
+	sort.Slice(s, func(i, j int) bool { return cmp.Less(s[i], s[j]) })
+}
+
+// IsSorted reports whether s is in ascending order
+func IsSorted[T cmp.Ordered](s []T) bool {
+	return IsSortedFunc(s, cmp.Less[T])
+}
+
+// SortSliceFunc sorts s in place by less. The sort is stable: elements
+// that compare equal keep their original order, so sorting by one key
+// after another yields a multi-key order.
+func SortSliceFunc[T any](s []T, less func(a, b T) bool) {
+	sort.SliceStable(s, func(i, j int) bool { return less(s[i], s[j]) })
+}
+
+// IsSortedFunc reports whether s is ordered by less
+func IsSortedFunc[T any](s []T, less func(a, b T) bool) bool {
+	for i := 1; i < len(s); i++ {
+		if less(s[i], s[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// RemoveValue removes the first element equal to v, keeping the order of
+// the rest. Like append, it reuses s's backing array; a slice without v is
+// returned unchanged.
+func RemoveValue[T comparable](s []T, v T) []T {
+	for i := range s {
+		if s[i] == v {
+			copy(s[i:], s[i+1:])
+			return clearTail(s, len(s)-1)
+		}
+	}
+	return s
+}
+
+// RemoveAll removes every element equal to v, keeping the order of the
+// rest
+func RemoveAll[T comparable](s []T, v T) []T {
+	return RemoveFunc(s, func(item T) bool { return item == v })
+}
+
+// RemoveFunc removes every element for which pred returns true, keeping
+// the order of the rest, in a single pass
+func RemoveFunc[T any](s []T, pred func(T) bool) []T {
+	kept := 0
+	for _, item := range s {
+		if !pred(item) {
+			s[kept] = item
+			kept++
+		}
+	}
+	return clearTail(s, kept)
+}
+
+// clearTail shortens s to n elements, zeroing the dropped ones so that
+// pointers they hold do not keep their targets alive through the shared
+// backing array
+func clearTail[T any](s []T, n int) []T {
+	clear(s[n:])
+	return s[:n]
+}
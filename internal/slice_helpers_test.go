@@ -0,0 +1,74 @@
+// slice_helpers_test.go
+package internal
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestSliceEqual(t *testing.T) {
+	testutil.AssertEqual(t, SliceEqual([]int{1, 2, 3}, []int{1, 2, 3}), true)
+	testutil.AssertEqual(t, SliceEqual([]int{1, 2, 3}, []int{1, 2}), false)
+	testutil.AssertEqual(t, SliceEqual([]int{1, 2, 3}, []int{3, 2, 1}), false)
+	testutil.AssertEqual(t, SliceEqual(nil, []string{}), true)
+}
+
+func TestSortSliceAndIsSorted(t *testing.T) {
+	words := []string{"pear", "apple", "fig"}
+	testutil.AssertEqual(t, IsSorted(words), false)
+	SortSlice(words)
+	testutil.AssertEqual(t, fmt.Sprint(words), "[apple fig pear]")
+	testutil.AssertEqual(t, IsSorted(words), true)
+
+	testutil.AssertEqual(t, IsSorted([]float64{}), true)
+	testutil.AssertEqual(t, IsSorted([]int{1, 1, 2}), true) // equal neighbours count as sorted
+}
+
+func TestSortSliceFuncIsStable(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	people := []person{{"dan", 30}, {"ann", 25}, {"bob", 30}, {"cat", 25}, {"eve", 30}}
+	byAge := func(a, b person) bool { return a.age < b.age }
+	testutil.AssertEqual(t, IsSortedFunc(people, byAge), false)
+
+	SortSliceFunc(people, byAge)
+	testutil.AssertEqual(t, fmt.Sprint(people), "[{ann 25} {cat 25} {dan 30} {bob 30} {eve 30}]")
+	testutil.AssertEqual(t, IsSortedFunc(people, byAge), true)
+}
+
+func TestRemoveValue(t *testing.T) {
+	s := []int{1, 2, 3, 2}
+	s = RemoveValue(s, 2)
+	testutil.AssertEqual(t, fmt.Sprint(s), "[1 3 2]") // only the first match goes
+
+	unchanged := RemoveValue(s, 9)
+	testutil.AssertEqual(t, fmt.Sprint(unchanged), "[1 3 2]")
+	testutil.AssertEqual(t, len(RemoveValue([]string(nil), "x")), 0)
+}
+
+func TestRemoveAllAndRemoveFunc(t *testing.T) {
+	testutil.AssertEqual(t, fmt.Sprint(RemoveAll([]string{"a", "b", "a", "c", "a"}, "a")), "[b c]")
+
+	evens := RemoveFunc([]int{1, 2, 3, 4, 5, 6}, func(n int) bool { return n%2 != 0 })
+	testutil.AssertEqual(t, fmt.Sprint(evens), "[2 4 6]")
+	testutil.AssertEqual(t, len(RemoveFunc([]int{1, 3}, func(int) bool { return true })), 0)
+}
+
+func TestRemoveZeroesDroppedTail(t *testing.T) {
+	a, b, c := new(int), new(int), new(int)
+	backing := []*int{a, b, c}
+
+	s := RemoveValue(backing, b)
+	testutil.AssertEqual(t, len(s), 2)
+	testutil.AssertEqual(t, s[0] == a && s[1] == c, true)
+	testutil.AssertEqual(t, backing[2] == nil, true) // no stale pointer past the end
+
+	backing = []*int{a, b, c}
+	s = RemoveFunc(backing, func(p *int) bool { return p != b })
+	testutil.AssertEqual(t, len(s), 1)
+	testutil.AssertEqual(t, backing[1] == nil && backing[2] == nil, true)
+}
@@ -0,0 +1,24 @@
+// sniff.go
+package internal
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// sniffLen is how many bytes http.DetectContentType considers
+const sniffLen = 512
+
+// SniffContentType detects the MIME type of r from its first 512 bytes and
+// returns it together with a reader that yields the complete stream,
+// including the sniffed bytes. Always read from the returned reader, not r.
+func SniffContentType(r io.Reader) (string, io.Reader, error) {
+	br := bufio.NewReaderSize(r, sniffLen)
+	head, err := br.Peek(sniffLen)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", br, err
+	}
+	return http.DetectContentType(head), br, nil
+}
@@ -0,0 +1,63 @@
+// sniff_test.go
+package internal
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// sniffAndReplay sniffs data and reads the returned reader back in full
+func sniffAndReplay(t *testing.T, data []byte) (string, []byte) {
+	t.Helper()
+	contentType, r, err := SniffContentType(iotest.OneByteReader(bytes.NewReader(data)))
+	testutil.AssertNoError(t, err)
+	replayed, err := io.ReadAll(r)
+	testutil.AssertNoError(t, err)
+	return contentType, replayed
+}
+
+func TestSniffContentTypePNG(t *testing.T) {
+	png := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 1000)...)
+	contentType, replayed := sniffAndReplay(t, png)
+	testutil.AssertEqual(t, contentType, "image/png")
+	testutil.AssertEqual(t, bytes.Equal(replayed, png), true)
+}
+
+func TestSniffContentTypeText(t *testing.T) {
+	text := []byte(strings.Repeat("héllo, wörld\n", 100))
+	contentType, replayed := sniffAndReplay(t, text)
+	testutil.AssertEqual(t, contentType, "text/plain; charset=utf-8")
+	testutil.AssertEqual(t, string(replayed), string(text))
+}
+
+func TestSniffContentTypeGzip(t *testing.T) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write([]byte("compressed payload"))
+	zw.Close()
+
+	contentType, replayed := sniffAndReplay(t, buf.Bytes())
+	testutil.AssertEqual(t, contentType, "application/x-gzip")
+	testutil.AssertEqual(t, bytes.Equal(replayed, buf.Bytes()), true)
+}
+
+func TestSniffContentTypeShortAndEmpty(t *testing.T) {
+	contentType, replayed := sniffAndReplay(t, []byte("hi"))
+	testutil.AssertEqual(t, contentType, "text/plain; charset=utf-8")
+	testutil.AssertEqual(t, string(replayed), "hi")
+
+	contentType, replayed = sniffAndReplay(t, nil)
+	testutil.AssertEqual(t, contentType, "text/plain; charset=utf-8")
+	testutil.AssertEqual(t, len(replayed), 0)
+}
+
+func TestSniffContentTypeReadError(t *testing.T) {
+	_, _, err := SniffContentType(iotest.ErrReader(io.ErrClosedPipe))
+	testutil.AssertErrorIs(t, err, io.ErrClosedPipe)
+}
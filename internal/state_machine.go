@@ -0,0 +1,61 @@
+// state_machine.go
+package internal
+
+import "fmt"
+
+// StateMachine is a finite state machine driven by events. Transitions
+// must be declared up front; firing an undeclared event is an error and
+// leaves the state unchanged. It is not safe for concurrent use.
+type StateMachine[S comparable, E comparable] struct {
+	current     S
+	transitions map[S]map[E]S
+	hooks       []func(from, to S, e E)
+}
+
+// NewStateMachine creates a machine starting in initial
+func NewStateMachine[S comparable, E comparable](initial S) *StateMachine[S, E] {
+	return &StateMachine[S, E]{
+		current:     initial,
+		transitions: make(map[S]map[E]S),
+	}
+}
+
+// AddTransition declares that event on moves the machine from from to to
+func (m *StateMachine[S, E]) AddTransition(from S, on E, to S) *StateMachine[S, E] {
+	if m.transitions[from] == nil {
+		m.transitions[from] = make(map[E]S)
+	}
+	m.transitions[from][on] = to
+	return m
+}
+
+// OnTransition registers a hook called after every successful transition
+func (m *StateMachine[S, E]) OnTransition(hook func(from, to S, e E)) {
+	m.hooks = append(m.hooks, hook)
+}
+
+// Fire applies event e to the current state
+func (m *StateMachine[S, E]) Fire(e E) error {
+	to, ok := m.transitions[m.current][e]
+	if !ok {
+		return fmt.Errorf("invalid event %v in state %v", e, m.current)
+	}
+
+	from := m.current
+	m.current = to
+	for _, hook := range m.hooks {
+		hook(from, to, e)
+	}
+	return nil
+}
+
+// Current returns the current state
+func (m *StateMachine[S, E]) Current() S {
+	return m.current
+}
+
+// Can reports whether e is a valid event in the current state
+func (m *StateMachine[S, E]) Can(e E) bool {
+	_, ok := m.transitions[m.current][e]
+	return ok
+}
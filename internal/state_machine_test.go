@@ -0,0 +1,65 @@
+// state_machine_test.go
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func newEngineMachine() *StateMachine[EngineState, EngineEvent] {
+	return NewStateMachine[EngineState, EngineEvent](EngineStopped).
+		AddTransition(EngineStopped, EngineStart, EngineRunning).
+		AddTransition(EngineRunning, EngineStop, EngineStopped)
+}
+
+func TestStateMachineTransitions(t *testing.T) {
+	m := newEngineMachine()
+	testutil.AssertEqual(t, m.Current(), EngineStopped)
+	testutil.AssertEqual(t, m.Can(EngineStart), true)
+	testutil.AssertEqual(t, m.Can(EngineStop), false)
+
+	testutil.AssertNoError(t, m.Fire(EngineStart))
+	testutil.AssertEqual(t, m.Current(), EngineRunning)
+	testutil.AssertNoError(t, m.Fire(EngineStop))
+	testutil.AssertEqual(t, m.Current(), EngineStopped)
+}
+
+func TestStateMachineInvalidEvent(t *testing.T) {
+	m := newEngineMachine()
+	err := m.Fire(EngineStop)
+	testutil.AssertEqual(t, err.Error(), "invalid event stop in state stopped")
+	testutil.AssertEqual(t, m.Current(), EngineStopped)
+}
+
+func TestStateMachineHooks(t *testing.T) {
+	m := newEngineMachine()
+	var log []string
+	m.OnTransition(func(from, to EngineState, e EngineEvent) {
+		log = append(log, fmt.Sprintf("%s:%s->%s", e, from, to))
+	})
+	m.OnTransition(func(from, to EngineState, e EngineEvent) {
+		log = append(log, "second")
+	})
+
+	m.Fire(EngineStart)
+	m.Fire(EngineStart) // invalid: no hook call
+	m.Fire(EngineStop)
+	testutil.AssertEqual(t, strings.Join(log, " "), "start:stopped->running second stop:running->stopped second")
+}
+
+func TestAutoEngineUsesStateMachine(t *testing.T) {
+	var engine AutoEngine // the zero value builds its machine lazily
+	var changes []EngineState
+	engine.OnStateChange(func(from, to EngineState, e EngineEvent) { changes = append(changes, to) })
+
+	testutil.AssertEqual(t, engine.Running(), false)
+	testutil.AssertNoError(t, engine.Start())
+	testutil.AssertEqual(t, engine.Running(), true)
+	testutil.AssertEqual(t, engine.Start().Error(), "engine is already running")
+	testutil.AssertNoError(t, engine.Stop())
+	testutil.AssertEqual(t, len(changes), 2)
+	testutil.AssertContains(t, engine.Status(), "stopped")
+}
@@ -0,0 +1,136 @@
+// stats.go
+package internal
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// Stats accumulates count, mean, variance, min and max of a stream of
+// values in a single pass using Welford's algorithm, so nothing but a few
+// running totals is stored. The zero value is ready to use; it is not safe
+// for concurrent use.
+type Stats struct {
+	count    int
+	mean     float64
+	m2       float64 // sum of squared differences from the running mean
+	min, max float64
+}
+
+// Add records one value
+func (s *Stats) Add(x float64) {
+	s.count++
+	if s.count == 1 {
+		s.min, s.max = x, x
+	} else {
+		s.min = math.Min(s.min, x)
+		s.max = math.Max(s.max, x)
+	}
+
+	delta := x - s.mean
+	s.mean += delta / float64(s.count)
+	s.m2 += delta * (x - s.mean)
+}
+
+// Count returns the number of values added
+func (s *Stats) Count() int { return s.count }
+
+// Mean returns the arithmetic mean, or 0 with no values
+func (s *Stats) Mean() float64 { return s.mean }
+
+// Variance returns the population variance, or 0 with fewer than two values
+func (s *Stats) Variance() float64 {
+	if s.count < 2 {
+		return 0
+	}
+	return s.m2 / float64(s.count)
+}
+
+// StdDev returns the population standard deviation
+func (s *Stats) StdDev() float64 { return math.Sqrt(s.Variance()) }
+
+// Min returns the smallest value, or 0 with no values
+func (s *Stats) Min() float64 { return s.min }
+
+// Max returns the largest value, or 0 with no values
+func (s *Stats) Max() float64 { return s.max }
+
+// String summarizes the accumulated values on one line
+func (s *Stats) String() string {
+	return fmt.Sprintf("n=%d mean=%.2f stddev=%.2f min=%.2f max=%.2f",
+		s.count, s.Mean(), s.StdDev(), s.Min(), s.Max())
+}
+
+// EMA is an exponential moving average: each new value moves the average a
+// fraction alpha of the way towards it, so higher alphas react faster and
+// lower ones smooth more. The first value seeds the average.
+type EMA struct {
+	alpha  float64
+	value  float64
+	seeded bool
+}
+
+// NewEMA creates an average with the given smoothing factor, which must be
+// in (0, 1]
+func NewEMA(alpha float64) *EMA {
+	if alpha <= 0 || alpha > 1 {
+		panic(fmt.Sprintf("ema: alpha %v outside (0, 1]", alpha))
+	}
+	return &EMA{alpha: alpha}
+}
+
+// Add folds x into the average and returns the updated value
+func (e *EMA) Add(x float64) float64 {
+	if !e.seeded {
+		e.value, e.seeded = x, true
+	} else {
+		e.value += e.alpha * (x - e.value)
+	}
+	return e.value
+}
+
+// Value returns the current average, or 0 before any value is added
+func (e *EMA) Value() float64 { return e.value }
+
+// MovingAverage emits, for every value read from in, the mean of the last
+// window values. Until window values have arrived it averages the ones
+// seen so far. A ring buffer and a running sum make each step O(1). The
+// output closes when in closes or ctx is cancelled.
+func MovingAverage(ctx context.Context, in <-chan float64, window int) <-chan float64 {
+	if window < 1 {
+		window = 1
+	}
+	out := make(chan float64)
+
+	go func() {
+		defer close(out)
+		ring := make([]float64, window)
+		var sum float64
+		next, filled := 0, 0
+
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				sum += v - ring[next] // ring[next] is 0 until the window fills
+				ring[next] = v
+				next = (next + 1) % window
+				if filled < window {
+					filled++
+				}
+				select {
+				case out <- sum / float64(filled):
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
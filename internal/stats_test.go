@@ -0,0 +1,112 @@
+// stats_test.go
+package internal
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func assertClose(t testing.TB, got, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 1e-9 {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestStatsMatchesNaive(t *testing.T) {
+	data := []float64{12.5, 3, 47.25, 8, 8, 19.75, 1e6, 0.5}
+
+	var sum float64
+	for _, x := range data {
+		sum += x
+	}
+	mean := sum / float64(len(data))
+	var squares float64
+	for _, x := range data {
+		squares += (x - mean) * (x - mean)
+	}
+	variance := squares / float64(len(data))
+
+	var s Stats
+	for _, x := range data {
+		s.Add(x)
+	}
+	testutil.AssertEqual(t, s.Count(), len(data))
+	assertClose(t, s.Mean(), mean)
+	assertClose(t, s.Variance()/variance, 1)
+	assertClose(t, s.StdDev()/math.Sqrt(variance), 1)
+	testutil.AssertEqual(t, s.Min(), 0.5)
+	testutil.AssertEqual(t, s.Max(), 1e6)
+}
+
+func TestStatsEmptyAndSingle(t *testing.T) {
+	var s Stats
+	testutil.AssertEqual(t, s.Count(), 0)
+	testutil.AssertEqual(t, s.Mean(), 0.0)
+	testutil.AssertEqual(t, s.StdDev(), 0.0)
+	testutil.AssertEqual(t, s.String(), "n=0 mean=0.00 stddev=0.00 min=0.00 max=0.00")
+
+	s.Add(-4)
+	testutil.AssertEqual(t, s.Count(), 1)
+	testutil.AssertEqual(t, s.Mean(), -4.0)
+	testutil.AssertEqual(t, s.Variance(), 0.0)
+	testutil.AssertEqual(t, s.Min(), -4.0)
+	testutil.AssertEqual(t, s.Max(), -4.0)
+}
+
+func TestEMA(t *testing.T) {
+	e := NewEMA(0.5)
+	testutil.AssertEqual(t, e.Value(), 0.0)
+	testutil.AssertEqual(t, e.Add(10), 10.0) // the first value seeds the average
+	testutil.AssertEqual(t, e.Add(20), 15.0)
+	testutil.AssertEqual(t, e.Add(20), 17.5)
+	testutil.AssertEqual(t, e.Value(), 17.5)
+
+	follow := NewEMA(1)
+	follow.Add(3)
+	testutil.AssertEqual(t, follow.Add(9), 9.0)
+}
+
+func TestNewEMARejectsBadAlpha(t *testing.T) {
+	for _, alpha := range []float64{0, -0.1, 1.5} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewEMA(%v) did not panic", alpha)
+				}
+			}()
+			NewEMA(alpha)
+		}()
+	}
+}
+
+func TestMovingAverage(t *testing.T) {
+	in := sendAll(2.0, 4, 6, 8, 10, 3)
+	got := drain(t, MovingAverage(context.Background(), in, 3))
+
+	// Averages over the values available until the window of 3 fills
+	want := []float64{2, 3, 4, 6, 8, 7}
+	testutil.AssertEqual(t, len(got), len(want))
+	for i := range want {
+		assertClose(t, got[i], want[i])
+	}
+}
+
+func TestMovingAverageWindowOfOne(t *testing.T) {
+	got := drain(t, MovingAverage(context.Background(), sendAll(5.0, -1, 7), 0))
+	testutil.AssertEqual(t, len(got), 3)
+	testutil.AssertEqual(t, got[1], -1.0)
+}
+
+func TestMovingAverageCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan float64)
+	out := MovingAverage(ctx, in, 4)
+	in <- 1
+	testutil.AssertEqual(t, <-out, 1.0)
+	cancel()
+	drain(t, out)
+}
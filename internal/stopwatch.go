@@ -0,0 +1,134 @@
+// stopwatch.go
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// StopwatchLap is one named stage measured by a Stopwatch
+type StopwatchLap struct {
+	Name     string
+	Duration time.Duration
+}
+
+// Stopwatch times the stages of a multi-step operation. Each Lap records
+// the time since the previous lap (or Start) under a name, and Report
+// renders the laps with their share of the total. It is safe for
+// concurrent use.
+type Stopwatch struct {
+	mu      sync.Mutex
+	clock   Clock
+	start   time.Time
+	lastLap time.Time
+	stop    time.Time
+	running bool
+	laps    []StopwatchLap
+}
+
+// NewStopwatch creates a stopped stopwatch. A nil clock uses the system
+// clock.
+func NewStopwatch(clock Clock) *Stopwatch {
+	return &Stopwatch{clock: clockOrSystem(clock)}
+}
+
+// Start begins timing, discarding any previous laps
+func (s *Stopwatch) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.start = s.clock.Now()
+	s.lastLap = s.start
+	s.running = true
+	s.laps = nil
+}
+
+// Lap records the time since the previous lap as the stage called name.
+// It does nothing unless the stopwatch is running.
+func (s *Stopwatch) Lap(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.running {
+		return
+	}
+	now := s.clock.Now()
+	s.laps = append(s.laps, StopwatchLap{Name: name, Duration: now.Sub(s.lastLap)})
+	s.lastLap = now
+}
+
+// Stop ends timing. Time after the last lap still counts toward Elapsed.
+func (s *Stopwatch) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		s.stop = s.clock.Now()
+		s.running = false
+	}
+}
+
+// Elapsed returns the time from Start to Stop, or to now while running
+func (s *Stopwatch) Elapsed() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.elapsed()
+}
+
+func (s *Stopwatch) elapsed() time.Duration {
+	if s.running {
+		return s.clock.Now().Sub(s.start)
+	}
+	return s.stop.Sub(s.start)
+}
+
+// Laps returns the recorded laps in order
+func (s *Stopwatch) Laps() []StopwatchLap {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]StopwatchLap(nil), s.laps...)
+}
+
+// Report renders the laps in order as a table with each lap's duration
+// and share of the total, the slowest lap highlighted, and a total row.
+// Time after the last lap appears as "(unlapped)".
+func (s *Stopwatch) Report() string {
+	s.mu.Lock()
+	laps := append([]StopwatchLap(nil), s.laps...)
+	total := s.elapsed()
+	s.mu.Unlock()
+
+	var lapped time.Duration
+	slowest := -1
+	for i, lap := range laps {
+		lapped += lap.Duration
+		if slowest < 0 || lap.Duration > laps[slowest].Duration {
+			slowest = i
+		}
+	}
+	if rest := total - lapped; rest > 0 && len(laps) > 0 {
+		laps = append(laps, StopwatchLap{Name: "(unlapped)", Duration: rest})
+	}
+
+	table := NewTable("Stage", "Time", "Share")
+	table.Options = TableOptions{Align: []Alignment{AlignLeft, AlignRight, AlignRight}, MaxWidth: -1}
+	for i, lap := range laps {
+		name, duration := lap.Name, HumanizeDuration(lap.Duration)
+		if i == slowest {
+			name, duration = Yellow(name), Yellow(duration)
+		}
+		table.AddRow(name, duration, lapShare(lap.Duration, total))
+	}
+	table.AddRow(Bold("total"), Bold(HumanizeDuration(total)), lapShare(total, total))
+
+	var b strings.Builder
+	table.Render(&b)
+	return b.String()
+}
+
+// lapShare formats part as a percentage of total
+func lapShare(part, total time.Duration) string {
+	if total <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", 100*float64(part)/float64(total))
+}
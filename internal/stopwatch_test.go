@@ -0,0 +1,70 @@
+// stopwatch_test.go
+package internal
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestStopwatchLapsAndTotal(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	sw := NewStopwatch(clock)
+	sw.Lap("ignored") // not running yet
+
+	sw.Start()
+	clock.Advance(100 * time.Millisecond)
+	sw.Lap("validate")
+	clock.Advance(300 * time.Millisecond)
+	sw.Lap("charge")
+	testutil.AssertEqual(t, sw.Elapsed(), 400*time.Millisecond)
+	clock.Advance(100 * time.Millisecond)
+	sw.Stop()
+	clock.Advance(time.Hour) // stopped, so this is not counted
+
+	laps := sw.Laps()
+	testutil.AssertEqual(t, len(laps), 2)
+	testutil.AssertEqual(t, laps[0], StopwatchLap{Name: "validate", Duration: 100 * time.Millisecond})
+	testutil.AssertEqual(t, laps[1], StopwatchLap{Name: "charge", Duration: 300 * time.Millisecond})
+	testutil.AssertEqual(t, sw.Elapsed(), 500*time.Millisecond)
+}
+
+func TestStopwatchReportListsLapsInOrder(t *testing.T) {
+	withColorMode(t, ColorNever)
+	clock := NewManualClock(time.Unix(0, 0))
+	sw := NewStopwatch(clock)
+	sw.Start()
+	for _, step := range []struct {
+		name string
+		d    time.Duration
+	}{{"validate", 100 * time.Millisecond}, {"charge", 600 * time.Millisecond}, {"ship", 200 * time.Millisecond}} {
+		clock.Advance(step.d)
+		sw.Lap(step.name)
+	}
+	clock.Advance(100 * time.Millisecond)
+	sw.Stop()
+
+	report := sw.Report()
+	order := []string{"validate", "charge", "ship", "(unlapped)", "total"}
+	last := -1
+	for _, name := range order {
+		at := strings.Index(report, name)
+		testutil.AssertEqual(t, at > last, true)
+		last = at
+	}
+	testutil.AssertContains(t, report, "60.0%")
+	testutil.AssertContains(t, report, "100.0%")
+}
+
+func TestStopwatchRestartDiscardsLaps(t *testing.T) {
+	clock := NewManualClock(time.Unix(0, 0))
+	sw := NewStopwatch(clock)
+	sw.Start()
+	clock.Advance(time.Second)
+	sw.Lap("first run")
+	sw.Start()
+	testutil.AssertEqual(t, len(sw.Laps()), 0)
+	testutil.AssertEqual(t, sw.Elapsed(), time.Duration(0))
+}
@@ -2,11 +2,17 @@
 package internal
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
+	"io"
+	"os"
 	"strconv"
 	"strings"
+	"testing/iotest"
 	"time"
 	"unicode/utf8"
+	"unsafe"
 )
 
 // RunStringFormattingExamples - main function to run all string formatting examples
@@ -20,6 +26,7 @@ func RunStringFormattingExamples() {
 	unicodeStringExample()
 	stringBuilderExample()
 	stringTemplateExample()
+	stringInterningExample()
 }
 
 func basicFormattingExample() {
@@ -82,8 +89,24 @@ func numericFormattingExample() {
 	// fmt.Printf("Price with commas: $%,.2f\n", price) // Note: Go doesn't have built-in comma formatting
 
 	// Percentage formatting
-	ratio := 0.85
-	fmt.Printf("Success rate: %.1f%%\n", ratio*100)
+	successRate, err := Ratio(17, 20)
+	if err != nil {
+		fmt.Printf("Error computing rate: %v\n", err)
+	} else {
+		fmt.Printf("Success rate: %s\n", FormatPercent(successRate, 1, RoundHalfUp))
+	}
+	fmt.Printf("Growth: %s, change: %s\n",
+		FormatPercent(1.5, 0, RoundHalfUp), FormatPercent(-0.0325, 1, RoundHalfUp))
+	for _, mode := range []struct {
+		name string
+		mode RoundMode
+	}{{"half-up", RoundHalfUp}, {"half-even", RoundHalfEven}, {"truncate", RoundTruncate}} {
+		fmt.Printf("0.125 and 0.135 rounded %-9s -> %s, %s\n", mode.name,
+			FormatPercent(0.125, 0, mode.mode), FormatPercent(0.135, 0, mode.mode))
+	}
+	if _, err := Ratio(3, 0); err != nil {
+		fmt.Printf("Empty denominator: %v\n", err)
+	}
 
 	// Large numbers
 	bigNumber := 1234567890
@@ -127,6 +150,11 @@ func stringManipulationExample() {
 	// String repetition
 	pattern := "Go! "
 	fmt.Printf("Repeated: %s\n", strings.Repeat(pattern, 5))
+
+	// Word wrapping: to a fixed width, or to the terminal with width 0
+	paragraph := "Go strings are immutable byte sequences; wrapping counts runes, so text such as naïve café stays aligned."
+	fmt.Printf("Wrapped to 30 columns:\n%s\n", WrapText(paragraph, 30))
+	fmt.Printf("Terminal width: %d\n", TerminalWidth())
 }
 
 func advancedFormattingExample() {
@@ -221,6 +249,21 @@ func stringConversionExample() {
 	fmt.Printf("Binary: %s\n", strconv.FormatInt(int64(number), 2))
 	fmt.Printf("Octal: %s\n", strconv.FormatInt(int64(number), 8))
 	fmt.Printf("Hex: %s\n", strconv.FormatInt(int64(number), 16))
+
+	// The same conversions over a stream, keeping the input's layout
+	dump := "ff 10 7f\n-1a 0 cafe\n"
+	fmt.Println("Hex dump as decimal:")
+	if err := ConvertBases(strings.NewReader(dump), os.Stdout, 16, 10); err != nil {
+		fmt.Printf("Error converting: %v\n", err)
+	}
+	fmt.Println("Decimal flags as binary:")
+	if err := ConvertBases(strings.NewReader("5 12 255\n"), os.Stdout, 10, 2); err != nil {
+		fmt.Printf("Error converting: %v\n", err)
+	}
+	var discard strings.Builder
+	if err := ConvertBases(strings.NewReader("101 110\n11 12 1\n"), &discard, 2, 10); err != nil {
+		fmt.Printf("Bad binary input: %s\n", ErrorText(err.Error()))
+	}
 }
 
 func unicodeStringExample() {
@@ -261,6 +304,21 @@ func unicodeStringExample() {
 	fmt.Printf("Emoji string: %s\n", emojis)
 	fmt.Printf("Emoji byte length: %d\n", len(emojis))
 	fmt.Printf("Emoji rune count: %d\n", utf8.RuneCountInString(emojis))
+
+	// Repairing invalid UTF-8: 0xff is never valid and "\xe4\xb8" is a
+	// truncated 世
+	broken := "caf\xc3\xa9 \xff ok \xe4\xb8"
+	fmt.Printf("Broken valid? %t -> sanitized %q (valid: %t)\n",
+		utf8.ValidString(broken), SanitizeUTF8(broken), utf8.ValidString(SanitizeUTF8(broken)))
+
+	// The streaming reader gets the same answer even when characters are
+	// split across reads: é and 世 arrive one byte at a time here
+	stream := NewUTF8SanitizingReader(iotest.OneByteReader(strings.NewReader(broken + "\xe4\xb8\x96")))
+	sanitized, err := io.ReadAll(stream)
+	if err != nil {
+		fmt.Printf("Error sanitizing stream: %v\n", err)
+	}
+	fmt.Printf("Streamed one byte per read: %q\n", sanitized)
 }
 
 func stringBuilderExample() {
@@ -308,8 +366,39 @@ func stringBuilderExample() {
 	}
 	str3 := strings.Join(parts, " ")
 	fmt.Printf("Method 3 (Join): %s\n", str3)
+
+	// Method 4: Reusing buffers from a pool (efficient when building often)
+	buf := stringBufferPool.Get()
+	for i := 0; i < 3; i++ {
+		fmt.Fprintf(buf, "Part %d ", i)
+	}
+	fmt.Printf("Method 4 (BufferPool): %s\n", buf.String())
+	stringBufferPool.Put(buf)
+
+	build := func(buf *bytes.Buffer) {
+		for i := 0; i < 50; i++ {
+			buf.WriteString("Some content here\n")
+		}
+	}
+	fresh := MeasureAlloc(func() {
+		for i := 0; i < 1000; i++ {
+			build(new(bytes.Buffer))
+		}
+	})
+	pooled := MeasureAlloc(func() {
+		for i := 0; i < 1000; i++ {
+			buf := stringBufferPool.Get()
+			build(buf)
+			stringBufferPool.Put(buf)
+		}
+	})
+	fmt.Printf("1000 builds with new buffers: %s\n", FormatBytes(fresh.TotalAllocBytes))
+	fmt.Printf("1000 builds with pooled buffers: %s\n", FormatBytes(pooled.TotalAllocBytes))
 }
 
+// stringBufferPool recycles the buffers used by the string-building examples
+var stringBufferPool = NewBufferPool()
+
 func stringTemplateExample() {
 	fmt.Println(InfoText("8. String Templates and Patterns:"))
 
@@ -361,19 +450,22 @@ Best regards,
 	fullURL := fmt.Sprintf("%s%s/%s", baseURL, endpoint, userID)
 	fmt.Printf("Built URL: %s\n", fullURL)
 
-	// Query parameter building
-	params := map[string]string{
-		"format": "json",
-		"limit":  "10",
-		"offset": "0",
+	// Query parameter building from a tagged struct: keys come out sorted and
+	// values are escaped, unlike joining a map by hand
+	type userQuery struct {
+		Format string   `url:"format"`
+		Limit  int      `url:"limit"`
+		Offset int      `url:"offset,omitempty"`
+		Search string   `url:"q,omitempty"`
+		Tags   []string `url:"tag,omitempty"`
 	}
+	params := userQuery{Format: "json", Limit: 10, Search: "gopher & friends", Tags: []string{"go", "dev"}}
 
-	var queryParts []string
-	for key, value := range params {
-		queryParts = append(queryParts, fmt.Sprintf("%s=%s", key, value))
+	queryString, err := EncodeQuery(params)
+	if err != nil {
+		fmt.Printf("Error encoding query: %v\n", err)
+		return
 	}
-
-	queryString := strings.Join(queryParts, "&")
 	fullURLWithParams := fmt.Sprintf("%s?%s", fullURL, queryString)
 	fmt.Printf("URL with params: %s\n", fullURLWithParams)
 }
@@ -385,3 +477,30 @@ func getStatus(active bool) string {
 	}
 	return "Inactive"
 }
+
+func stringInterningExample() {
+	fmt.Println(InfoText("9. String Interning:"))
+
+	// Simulated log input where a handful of levels repeat many times
+	var input strings.Builder
+	levels := []string{"INFO", "WARN", "ERROR", "DEBUG"}
+	for i := 0; i < 1000; i++ {
+		input.WriteString(levels[i%len(levels)] + " ")
+	}
+
+	interner := NewInterner()
+	scanner := bufio.NewScanner(strings.NewReader(input.String()))
+	scanner.Split(bufio.ScanWords)
+
+	var tokens []string
+	for scanner.Scan() {
+		// scanner.Text allocates a new string each call; interning folds
+		// the duplicates back onto one copy per distinct value
+		tokens = append(tokens, interner.Intern(scanner.Text()))
+	}
+
+	fmt.Printf("Tokens parsed: %d, distinct strings kept: %d\n", len(tokens), interner.Len())
+	fmt.Printf("tokens[0] == tokens[4]: %v, same backing data: %v\n",
+		tokens[0] == tokens[4], unsafe.StringData(tokens[0]) == unsafe.StringData(tokens[4]))
+	fmt.Println()
+}
@@ -0,0 +1,61 @@
+// strip_ansi.go
+package internal
+
+import (
+	"io"
+	"regexp"
+)
+
+// ansiCSI matches a complete ANSI CSI sequence: ESC [, parameter bytes,
+// intermediate bytes and one final byte. This covers colors (ESC[31m),
+// cursor movement and line clearing.
+var ansiCSI = regexp.MustCompile("\x1b\\[[0-?]*[ -/]*[@-~]")
+
+// ansiPartial matches a CSI sequence cut off at the end of the input
+var ansiPartial = regexp.MustCompile("\x1b(\\[[0-?]*[ -/]*)?$")
+
+// StripANSI removes ANSI CSI escape sequences, turning output of the color
+// helpers back into plain text
+func StripANSI(s string) string {
+	return ansiCSI.ReplaceAllString(s, "")
+}
+
+// StrippingWriter removes ANSI CSI sequences from everything written
+// through it before passing it on to w. A sequence split across Write
+// calls is held back until it is complete, so it is stripped as well.
+type StrippingWriter struct {
+	w       io.Writer
+	pending []byte
+}
+
+// NewStrippingWriter returns a writer forwarding escape-free output to w
+func NewStrippingWriter(w io.Writer) *StrippingWriter {
+	return &StrippingWriter{w: w}
+}
+
+// Write strips p and writes the result, reporting len(p) on success
+func (s *StrippingWriter) Write(p []byte) (int, error) {
+	data := append(s.pending, p...)
+	s.pending = nil
+	if loc := ansiPartial.FindIndex(data); loc != nil {
+		s.pending = append([]byte(nil), data[loc[0]:]...)
+		data = data[:loc[0]]
+	}
+	if len(data) > 0 {
+		if _, err := s.w.Write(ansiCSI.ReplaceAll(data, nil)); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush writes out a held-back partial sequence as-is. Input that ends
+// mid-sequence was never a valid escape, so it is not dropped.
+func (s *StrippingWriter) Flush() error {
+	if len(s.pending) == 0 {
+		return nil
+	}
+	_, err := s.w.Write(s.pending)
+	s.pending = nil
+	return err
+}
@@ -0,0 +1,42 @@
+// strip_ansi_test.go
+package internal
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestStripANSIRoundTripsColorHelpers(t *testing.T) {
+	withColorMode(t, ColorAlways)
+	for _, colored := range []string{
+		Red("plain"), Bold(Cyan("plain")), Purple("plain"), Dim(Yellow("plain")),
+	} {
+		testutil.AssertEqual(t, colored != "plain", true)
+		testutil.AssertEqual(t, StripANSI(colored), "plain")
+	}
+	testutil.AssertEqual(t, StripANSI("\x1b[2K\x1b[1;1Hdone"), "done")
+	testutil.AssertEqual(t, StripANSI("no escapes"), "no escapes")
+}
+
+func TestStrippingWriterSplitSequence(t *testing.T) {
+	var out bytes.Buffer
+	w := NewStrippingWriter(&out)
+	for _, chunk := range []string{"a\x1b", "[3", "1mred\x1b[", "0m b"} {
+		n, err := w.Write([]byte(chunk))
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, n, len(chunk))
+	}
+	testutil.AssertNoError(t, w.Flush())
+	testutil.AssertEqual(t, out.String(), "ared b")
+}
+
+func TestStrippingWriterFlushKeepsUnfinishedEscape(t *testing.T) {
+	var out bytes.Buffer
+	w := NewStrippingWriter(&out)
+	w.Write([]byte("tail\x1b[1"))
+	testutil.AssertEqual(t, out.String(), "tail")
+	testutil.AssertNoError(t, w.Flush())
+	testutil.AssertEqual(t, out.String(), "tail\x1b[1")
+}
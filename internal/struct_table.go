@@ -0,0 +1,49 @@
+// struct_table.go
+package internal
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// StructTable renders the fields of the struct v (or pointer to struct) as
+// a table of name, type, tag and current value. Nested and embedded struct
+// fields are expanded one level with dotted names such as Address.City;
+// structs nested deeper, and time.Time, are shown as a single value.
+func StructTable(w io.Writer, v interface{}) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		fmt.Fprintf(w, "StructTable: %T is not a struct\n", v)
+		return
+	}
+
+	table := NewTable("Field", "Type", "Tag", "Value")
+	addStructRows(table, rv, "", 1, func(name string, field reflect.StructField, value reflect.Value) []string {
+		// fmt prints the value held by a reflect.Value, even an unexported one
+		return []string{name, field.Type.String(), string(field.Tag), fmt.Sprintf("%v", value)}
+	})
+	table.Render(w)
+}
+
+// addStructRows appends one row per field of rv, built by cells from the
+// dotted field name, its StructField and its value. Struct fields are
+// expanded while depth allows.
+func addStructRows(table *Table, rv reflect.Value, prefix string, depth int,
+	cells func(name string, field reflect.StructField, value reflect.Value) []string) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		value := rv.Field(i)
+		name := joinFieldPath(prefix, field.Name)
+
+		if value.Kind() == reflect.Struct && field.Type != timeType && depth > 0 {
+			addStructRows(table, value, name, depth-1, cells)
+			continue
+		}
+		table.AddRow(cells(name, field, value)...)
+	}
+}
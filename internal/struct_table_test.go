@@ -0,0 +1,59 @@
+// struct_table_test.go
+package internal
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestStructTableAccountUser(t *testing.T) {
+	t.Setenv("COLUMNS", "200") // keep long tags on one line
+	var b strings.Builder
+	StructTable(&b, &AccountUser{ID: 7, Name: "Ada", Email: "ada@example.com", Age: 36, IsActive: true})
+	out := b.String()
+
+	for _, want := range []string{
+		"| Field ", "| Type ", "| Tag ", "| Value ",
+		"| ID ", "| int ", `json:"id" validate:"required"`, "| 7 ",
+		"| Name ", "| string ", `json:"name" validate:"required,min=2"`, "| Ada ",
+		"| Email ", `json:"email" validate:"required,email"`, "ada@example.com",
+		"| Age ", `json:"age" validate:"min=0,max=120"`, "| 36 ",
+		"| IsActive ", "| bool ", `json:"is_active"`, "| true ",
+	} {
+		testutil.AssertContains(t, out, want)
+	}
+}
+
+func TestStructTableNested(t *testing.T) {
+	t.Setenv("COLUMNS", "200")
+	type inner struct {
+		City string
+		Deep struct{ Level int }
+	}
+	type outer struct {
+		Name    string
+		Address inner
+		When    time.Time
+		secret  int
+	}
+	var b strings.Builder
+	StructTable(&b, outer{Name: "n", Address: inner{City: "Oslo"}, secret: 3})
+	out := b.String()
+
+	testutil.AssertContains(t, out, "| Address.City ")
+	testutil.AssertContains(t, out, "| Oslo ")
+	testutil.AssertContains(t, out, "| Address.Deep ") // expanded only one level
+	testutil.AssertEqual(t, strings.Contains(out, "Address.Deep.Level"), false)
+	testutil.AssertContains(t, out, "| When ")
+	testutil.AssertContains(t, out, "| time.Time ")
+	testutil.AssertContains(t, out, "| secret ")
+}
+
+func TestStructTableNonStruct(t *testing.T) {
+	var b strings.Builder
+	StructTable(&b, 42)
+	testutil.AssertEqual(t, b.String(), "StructTable: int is not a struct\n")
+}
@@ -0,0 +1,215 @@
+// table.go
+package internal
+
+import (
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// Alignment positions text within a table column
+type Alignment int
+
+const (
+	AlignLeft Alignment = iota
+	AlignRight
+)
+
+// TableOptions controls how a Table is drawn
+type TableOptions struct {
+	Align    []Alignment // per-column alignment; missing entries are AlignLeft
+	NoBorder bool        // drop the box and separate columns with two spaces
+	MaxWidth int         // total width limit; 0 means TerminalWidth(), negative means none
+}
+
+// minWrapWidth is the narrowest a column is squeezed to when fitting a
+// table into MaxWidth
+const minWrapWidth = 8
+
+// Table is a header plus rows of cells rendered with columns padded to the
+// widest cell. Widths count runes and ignore color escapes, so accented,
+// multibyte and colored text lines up. Rows shorter than the header are
+// padded with empty cells. When the table is wider than the terminal, the
+// widest columns are narrowed and their cells wrapped onto several lines.
+type Table struct {
+	Header  []string
+	Rows    [][]string
+	Options TableOptions
+}
+
+// NewTable creates a table with the given column headings
+func NewTable(header ...string) *Table {
+	return &Table{Header: header}
+}
+
+// AddRow appends one row of cells
+func (t *Table) AddRow(cells ...string) {
+	t.Rows = append(t.Rows, cells)
+}
+
+func (t *Table) columnWidths() []int {
+	columns := len(t.Header)
+	for _, row := range t.Rows {
+		columns = max(columns, len(row))
+	}
+
+	widths := make([]int, columns)
+	measure := func(cells []string) {
+		for i, cell := range cells {
+			widths[i] = max(widths[i], cellWidth(cell))
+		}
+	}
+	measure(t.Header)
+	for _, row := range t.Rows {
+		measure(row)
+	}
+
+	maxWidth := t.Options.MaxWidth
+	if maxWidth == 0 {
+		maxWidth = TerminalWidth()
+	}
+	if maxWidth > 0 {
+		t.fitWidths(widths, maxWidth)
+	}
+	return widths
+}
+
+// cellWidth is the number of runes a cell occupies on screen
+func cellWidth(cell string) int {
+	return utf8.RuneCountInString(StripANSI(cell))
+}
+
+// fitWidths narrows the widest columns, one rune at a time, until the
+// rendered table fits in maxWidth or every column is at minWrapWidth
+func (t *Table) fitWidths(widths []int, maxWidth int) {
+	overhead := 2 * (len(widths) - 1) // "  " between columns
+	if !t.Options.NoBorder {
+		overhead = 3*len(widths) + 1 // "| " ... " | " ... " |"
+	}
+	total := overhead
+	for _, width := range widths {
+		total += width
+	}
+
+	for total > maxWidth {
+		widest := 0
+		for i, width := range widths {
+			if width > widths[widest] {
+				widest = i
+			}
+		}
+		if widths[widest] <= minWrapWidth {
+			return
+		}
+		widths[widest]--
+		total--
+	}
+}
+
+// Render writes the table to w
+func (t *Table) Render(w io.Writer) {
+	widths := t.columnWidths()
+	var b strings.Builder
+
+	separator := func(edge, fill, cross string) {
+		b.WriteString(edge)
+		for i, width := range widths {
+			if i > 0 {
+				b.WriteString(cross)
+			}
+			b.WriteString(strings.Repeat(fill, width+2))
+		}
+		b.WriteString(edge + "\n")
+	}
+
+	line := func(cells []string) {
+		var line strings.Builder
+		if !t.Options.NoBorder {
+			line.WriteString("| ")
+		}
+		for i, width := range widths {
+			if i > 0 {
+				if t.Options.NoBorder {
+					line.WriteString("  ")
+				} else {
+					line.WriteString(" | ")
+				}
+			}
+			cell := cells[i]
+			pad := strings.Repeat(" ", width-cellWidth(cell))
+			if i < len(t.Options.Align) && t.Options.Align[i] == AlignRight {
+				line.WriteString(pad + cell)
+			} else {
+				line.WriteString(cell + pad)
+			}
+		}
+		if t.Options.NoBorder {
+			// Without a closing border, padding would only leave trailing spaces
+			b.WriteString(strings.TrimRight(line.String(), " "))
+		} else {
+			b.WriteString(line.String() + " |")
+		}
+		b.WriteString("\n")
+	}
+
+	// row wraps cells wider than their column and writes as many physical
+	// lines as the tallest cell needs
+	row := func(cells []string) {
+		wrapped := make([][]string, len(widths))
+		height := 1
+		for i, width := range widths {
+			var cell string
+			if i < len(cells) {
+				cell = cells[i]
+			}
+			if cellWidth(cell) > width {
+				wrapped[i] = wrapLine(cell, width)
+			} else {
+				wrapped[i] = []string{cell}
+			}
+			height = max(height, len(wrapped[i]))
+		}
+
+		for n := 0; n < height; n++ {
+			physical := make([]string, len(widths))
+			for i := range widths {
+				if n < len(wrapped[i]) {
+					physical[i] = wrapped[i][n]
+				}
+			}
+			line(physical)
+		}
+	}
+
+	if t.Options.NoBorder {
+		row(t.Header)
+		dashes := make([]string, len(widths))
+		for i, width := range widths {
+			dashes[i] = strings.Repeat("-", width)
+		}
+		b.WriteString(strings.Join(dashes, "  ") + "\n")
+		for _, r := range t.Rows {
+			row(r)
+		}
+	} else {
+		separator("+", "-", "+")
+		row(t.Header)
+		separator("+", "=", "+")
+		for _, r := range t.Rows {
+			row(r)
+		}
+		separator("+", "-", "+")
+	}
+
+	io.WriteString(w, b.String())
+}
+
+// RenderCSVTable renders parsed CSV records as an aligned table, treating
+// the first record as the header
+func RenderCSVTable(w io.Writer, records [][]string, opts TableOptions) {
+	if len(records) == 0 {
+		return
+	}
+	table := &Table{Header: records[0], Rows: records[1:], Options: opts}
+	table.Render(w)
+}
@@ -0,0 +1,53 @@
+// table_test.go
+package internal
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func renderCSV(records [][]string, opts TableOptions) string {
+	var b strings.Builder
+	RenderCSVTable(&b, records, opts)
+	return b.String()
+}
+
+func TestRenderCSVTableAlignment(t *testing.T) {
+	got := renderCSV([][]string{
+		{"name", "city", "age"},
+		{"Zoë", "München", "31"},
+		{"Bob", "NY", "7"},
+	}, TableOptions{MaxWidth: -1})
+
+	want := "" +
+		"+------+---------+-----+\n" +
+		"| name | city    | age |\n" +
+		"+======+=========+=====+\n" +
+		"| Zoë  | München | 31  |\n" +
+		"| Bob  | NY      | 7   |\n" +
+		"+------+---------+-----+\n"
+	testutil.AssertEqual(t, got, want)
+}
+
+func TestRenderCSVTableNoBorderRightAlign(t *testing.T) {
+	got := renderCSV([][]string{
+		{"item", "qty"},
+		{"日本茶", "12"},
+		{"tea", "3"},
+	}, TableOptions{MaxWidth: -1, NoBorder: true, Align: []Alignment{AlignLeft, AlignRight}})
+
+	want := "" +
+		"item  qty\n" +
+		"----  ---\n" +
+		"日本茶    12\n" +
+		"tea     3\n"
+	testutil.AssertEqual(t, got, want)
+}
+
+func TestRenderCSVTableShortRowsAndEmpty(t *testing.T) {
+	got := renderCSV([][]string{{"a", "b"}, {"x"}}, TableOptions{MaxWidth: -1, NoBorder: true})
+	testutil.AssertEqual(t, got, "a  b\n-  -\nx\n")
+	testutil.AssertEqual(t, renderCSV(nil, TableOptions{}), "")
+}
@@ -0,0 +1,74 @@
+// terminal.go
+package internal
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// defaultTerminalWidth is used when stdout is not a terminal and COLUMNS is
+// unset or invalid
+const defaultTerminalWidth = 80
+
+// TerminalWidth returns the width of the terminal attached to stdout. When
+// stdout is not a terminal (piped, redirected, under go test) it falls back
+// to the COLUMNS environment variable, then to 80.
+func TerminalWidth() int {
+	if width, ok := stdoutTerminalWidth(); ok && width > 0 {
+		return width
+	}
+	return columnsFromEnv()
+}
+
+func columnsFromEnv() int {
+	if width, err := strconv.Atoi(strings.TrimSpace(os.Getenv("COLUMNS"))); err == nil && width > 0 {
+		return width
+	}
+	return defaultTerminalWidth
+}
+
+// WrapText breaks text into lines of at most width runes, splitting at
+// spaces where possible and hard-breaking words longer than a line.
+// Existing newlines are kept. A width of 0 or less means TerminalWidth().
+func WrapText(text string, width int) string {
+	if width <= 0 {
+		width = TerminalWidth()
+	}
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		lines = append(lines, wrapLine(paragraph, width)...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// wrapLine wraps a single line of text; it always returns at least one
+// (possibly empty) line
+func wrapLine(text string, width int) []string {
+	width = max(width, 1)
+	var lines []string
+	var current []rune
+
+	for _, word := range strings.Fields(text) {
+		runes := []rune(word)
+		for len(runes) > 0 {
+			switch {
+			case len(current) == 0 && len(runes) <= width:
+				current, runes = runes, nil
+			case len(current) > 0 && len(current)+1+len(runes) <= width:
+				current = append(append(current, ' '), runes...)
+				runes = nil
+			case len(current) > 0:
+				lines = append(lines, string(current))
+				current = nil
+			default: // a word longer than the whole line
+				lines = append(lines, string(runes[:width]))
+				runes = runes[width:]
+			}
+		}
+	}
+	if len(current) > 0 || len(lines) == 0 {
+		lines = append(lines, string(current))
+	}
+	return lines
+}
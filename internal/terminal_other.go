@@ -0,0 +1,11 @@
+// terminal_other.go
+
+//go:build !linux && !darwin
+
+package internal
+
+// stdoutTerminalWidth has no portable implementation on this platform, so
+// TerminalWidth relies on COLUMNS and the default
+func stdoutTerminalWidth() (int, bool) {
+	return 0, false
+}
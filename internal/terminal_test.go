@@ -0,0 +1,64 @@
+// terminal_test.go
+package internal
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestColumnsFromEnv(t *testing.T) {
+	cases := []struct {
+		columns string
+		want    int
+	}{
+		{"120", 120},
+		{" 42 ", 42},
+		{"", defaultTerminalWidth},
+		{"wide", defaultTerminalWidth},
+		{"0", defaultTerminalWidth},
+		{"-5", defaultTerminalWidth},
+	}
+	for _, c := range cases {
+		t.Setenv("COLUMNS", c.columns)
+		testutil.AssertEqual(t, columnsFromEnv(), c.want)
+	}
+}
+
+func TestTerminalWidthWithoutTTY(t *testing.T) {
+	if _, ok := stdoutTerminalWidth(); ok {
+		t.Skip("stdout is a terminal")
+	}
+	t.Setenv("COLUMNS", "33")
+	testutil.AssertEqual(t, TerminalWidth(), 33)
+	t.Setenv("COLUMNS", "")
+	testutil.AssertEqual(t, TerminalWidth(), 80)
+}
+
+func TestWrapText(t *testing.T) {
+	testutil.AssertEqual(t, WrapText("the quick brown fox jumps", 10), "the quick\nbrown fox\njumps")
+	testutil.AssertEqual(t, WrapText("abcdefghij", 4), "abcd\nefgh\nij")
+	testutil.AssertEqual(t, WrapText("keep\n\nbreaks", 20), "keep\n\nbreaks")
+	testutil.AssertEqual(t, WrapText("naïve café au lait", 10), "naïve café\nau lait")
+}
+
+func TestWrapTextAndTableDefaultToTerminalWidth(t *testing.T) {
+	if _, ok := stdoutTerminalWidth(); ok {
+		t.Skip("stdout is a terminal")
+	}
+	t.Setenv("COLUMNS", "12")
+	testutil.AssertEqual(t, WrapText("one two three four", 0), "one two\nthree four")
+
+	table := NewTable("key", "description")
+	table.AddRow("a", "a rather long description")
+	var b strings.Builder
+	table.Render(&b)
+	// "key" keeps its width; the description shrinks to minWrapWidth and wraps
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	testutil.AssertEqual(t, len(lines), 9)
+	testutil.AssertEqual(t, lines[4], "| a   | a rather |")
+	for _, line := range lines {
+		testutil.AssertEqual(t, len([]rune(line)), 18)
+	}
+}
@@ -0,0 +1,53 @@
+// internal/testutil/assert.go
+
+// Package testutil holds small assertion helpers for tests of the internal
+// package. It lives in its own package so the example binary never links
+// in "testing". Each helper calls t.Helper, so failures point at the
+// calling line, and reports through t.Errorf so one test can surface
+// several mismatches.
+package testutil
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// AssertEqual fails t when got != want
+func AssertEqual[T comparable](t testing.TB, got, want T) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+// AssertNoError fails t when err is non-nil
+func AssertNoError(t testing.TB, err error) {
+	t.Helper()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+// AssertErrorIs fails t unless errors.Is(err, target). A nil target
+// asserts that err is nil too.
+func AssertErrorIs(t testing.TB, err, target error) {
+	t.Helper()
+	switch {
+	case err == nil && target == nil:
+	case err == nil:
+		t.Errorf("got no error, want %v", target)
+	case target == nil:
+		t.Errorf("got error %q, want none", err)
+	case !errors.Is(err, target):
+		t.Errorf("got error %q, want one wrapping %q", err, target)
+	}
+}
+
+// AssertContains fails t unless needle occurs in haystack
+func AssertContains(t testing.TB, haystack, needle string) {
+	t.Helper()
+	if !strings.Contains(haystack, needle) {
+		t.Errorf("%q does not contain %q", haystack, needle)
+	}
+}
@@ -0,0 +1,56 @@
+// internal/testutil/assert_test.go
+
+package testutil
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// fakeTB records Errorf calls instead of failing the real test. Embedding
+// testing.TB satisfies its unexported method; anything beyond Helper and
+// Errorf would panic on the nil interface, which is what we want.
+type fakeTB struct {
+	testing.TB
+	errors []string
+}
+
+func (f *fakeTB) Helper() {}
+
+func (f *fakeTB) Errorf(format string, args ...interface{}) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertHelpers(t *testing.T) {
+	errBase := errors.New("base")
+	wrapped := fmt.Errorf("outer: %w", errBase)
+
+	tests := []struct {
+		name     string
+		run      func(tb testing.TB)
+		wantFail bool
+	}{
+		{"equal pass", func(tb testing.TB) { AssertEqual(tb, 3, 3) }, false},
+		{"equal fail", func(tb testing.TB) { AssertEqual(tb, "a", "b") }, true},
+		{"no error pass", func(tb testing.TB) { AssertNoError(tb, nil) }, false},
+		{"no error fail", func(tb testing.TB) { AssertNoError(tb, errBase) }, true},
+		{"error is wrapped", func(tb testing.TB) { AssertErrorIs(tb, wrapped, errBase) }, false},
+		{"error is both nil", func(tb testing.TB) { AssertErrorIs(tb, nil, nil) }, false},
+		{"error is missing", func(tb testing.TB) { AssertErrorIs(tb, nil, errBase) }, true},
+		{"error is unexpected", func(tb testing.TB) { AssertErrorIs(tb, errBase, nil) }, true},
+		{"error is mismatch", func(tb testing.TB) { AssertErrorIs(tb, errors.New("other"), errBase) }, true},
+		{"contains pass", func(tb testing.TB) { AssertContains(tb, "haystack", "st") }, false},
+		{"contains fail", func(tb testing.TB) { AssertContains(tb, "haystack", "needle") }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeTB{}
+			tt.run(fake)
+			if failed := len(fake.errors) > 0; failed != tt.wantFail {
+				t.Errorf("failed = %v (%q), want %v", failed, fake.errors, tt.wantFail)
+			}
+		})
+	}
+}
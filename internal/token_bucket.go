@@ -0,0 +1,98 @@
+// token_bucket.go
+package internal
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// TokenBucket is a rate limiter that refills at rate tokens per second up
+// to burst tokens. Each Allow or Wait spends one token.
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	clock  Clock
+}
+
+// NewTokenBucket creates a full bucket allowing rate events per second with
+// bursts of up to burst events
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	return newTokenBucket(rate, burst, nil)
+}
+
+// newTokenBucket is NewTokenBucket with an injectable clock (nil means the
+// system clock)
+func newTokenBucket(rate float64, burst int, clock Clock) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	clock = clockOrSystem(clock)
+	return &TokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   clock.Now(),
+		clock:  clock,
+	}
+}
+
+// refill adds the tokens earned since the last call; the caller holds b.mu
+func (b *TokenBucket) refill() {
+	now := b.clock.Now()
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+}
+
+// Allow spends a token if one is available, without blocking
+func (b *TokenBucket) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill()
+	if b.tokens >= 1 {
+		b.tokens--
+		return true
+	}
+	return false
+}
+
+// Wait blocks until a token is available and spends it, or returns the
+// context's error if ctx is done first
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		b.refill()
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+		rate, missing := b.rate, 1-b.tokens
+		b.mu.Unlock()
+
+		if rate <= 0 {
+			// A zero rate never refills; only cancellation ends the wait
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		wait := time.Duration(missing / rate * float64(time.Second))
+		if wait < time.Nanosecond {
+			wait = time.Nanosecond
+		}
+
+		select {
+		case <-b.clock.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// token_bucket_test.go
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestTokenBucketBurstThenRefill(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	b := newTokenBucket(10, 3, clock) // one token every 100ms
+
+	for i := 0; i < 3; i++ {
+		testutil.AssertEqual(t, b.Allow(), true)
+	}
+	testutil.AssertEqual(t, b.Allow(), false)
+
+	clock.Advance(50 * time.Millisecond)
+	testutil.AssertEqual(t, b.Allow(), false)
+	clock.Advance(50 * time.Millisecond)
+	testutil.AssertEqual(t, b.Allow(), true)
+	testutil.AssertEqual(t, b.Allow(), false)
+
+	// A long idle period refills only up to the burst size
+	clock.Advance(time.Hour)
+	for i := 0; i < 3; i++ {
+		testutil.AssertEqual(t, b.Allow(), true)
+	}
+	testutil.AssertEqual(t, b.Allow(), false)
+}
+
+func TestTokenBucketWaitSpacesCalls(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	b := newTokenBucket(10, 1, clock)
+	testutil.AssertNoError(t, b.Wait(context.Background())) // the initial token
+
+	done := make(chan error, 1)
+	go func() { done <- b.Wait(context.Background()) }()
+
+	waitForWaiters(t, clock, 1)
+	select {
+	case <-done:
+		t.Fatalf("Wait returned before a token refilled")
+	default:
+	}
+	clock.Advance(100 * time.Millisecond)
+	select {
+	case err := <-done:
+		testutil.AssertNoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatalf("Wait did not return after the refill interval")
+	}
+}
+
+func TestTokenBucketWaitCancelled(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	b := newTokenBucket(1, 1, clock)
+	b.Allow()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- b.Wait(ctx) }()
+
+	waitForWaiters(t, clock, 1)
+	cancel()
+	select {
+	case err := <-done:
+		testutil.AssertErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatalf("Wait ignored cancellation")
+	}
+}
+
+func TestTokenBucketZeroRateWaitsForContext(t *testing.T) {
+	b := newTokenBucket(0, 1, NewManualClock(time.Unix(0, 0)))
+	testutil.AssertEqual(t, b.Allow(), true)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := b.Wait(ctx)
+	testutil.AssertEqual(t, errors.Is(err, context.DeadlineExceeded), true)
+}
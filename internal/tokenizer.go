@@ -0,0 +1,286 @@
+// tokenizer.go
+package internal
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// TokenKind classifies a Token
+type TokenKind int
+
+const (
+	TokenIdent      TokenKind = iota // names such as foo or _bar2
+	TokenNumber                      // integers and decimals: 42, 3.14
+	TokenString                      // double-quoted text; Value holds the unescaped content
+	TokenOperator                    // punctuation and operators: + == := ( ,
+	TokenWhitespace                  // a run of spaces, tabs and newlines
+)
+
+var tokenKindNames = [...]string{"Ident", "Number", "String", "Operator", "Whitespace"}
+
+func (k TokenKind) String() string {
+	if int(k) < len(tokenKindNames) {
+		return tokenKindNames[k]
+	}
+	return fmt.Sprintf("TokenKind(%d)", int(k))
+}
+
+// Token is one lexical unit together with the position of its first rune.
+// Line and Col are 1-based; Col counts runes, not bytes.
+type Token struct {
+	Kind  TokenKind
+	Value string
+	Line  int
+	Col   int
+}
+
+func (t Token) String() string {
+	return fmt.Sprintf("%d:%d %s %q", t.Line, t.Col, t.Kind, t.Value)
+}
+
+// TokenizeError reports malformed input at a specific position
+type TokenizeError struct {
+	Line int
+	Col  int
+	Msg  string
+}
+
+func (e *TokenizeError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Col, e.Msg)
+}
+
+// twoCharOperators are matched greedily before falling back to a single rune
+var twoCharOperators = map[string]bool{
+	"==": true, "!=": true, "<=": true, ">=": true, "&&": true, "||": true,
+	":=": true, "+=": true, "-=": true, "*=": true, "/=": true, "++": true,
+	"--": true, "->": true, "<-": true,
+}
+
+// stringEscapes maps the rune after a backslash to the rune it stands for
+var stringEscapes = map[rune]rune{'n': '\n', 't': '\t', 'r': '\r', '\\': '\\', '"': '"'}
+
+// Tokenize scans r rune by rune into tokens, tracking the line and column of
+// each one
+func Tokenize(r io.Reader) ([]Token, error) {
+	lx := &lexer{in: bufio.NewReader(r), line: 1, col: 1}
+
+	var tokens []Token
+	for {
+		tok, err := lx.next()
+		if errors.Is(err, io.EOF) {
+			return tokens, nil
+		}
+		if err != nil {
+			return tokens, err
+		}
+		tokens = append(tokens, tok)
+	}
+}
+
+// lexer wraps a bufio.Reader with position tracking and unlimited pushback,
+// which decimals need ("3." is only a number if a digit follows the dot)
+type lexer struct {
+	in      *bufio.Reader
+	pending []lexRune
+	line    int
+	col     int
+}
+
+type lexRune struct {
+	r         rune
+	line, col int
+}
+
+func (lx *lexer) read() (lexRune, error) {
+	if n := len(lx.pending); n > 0 {
+		lr := lx.pending[n-1]
+		lx.pending = lx.pending[:n-1]
+		return lr, nil
+	}
+
+	r, _, err := lx.in.ReadRune()
+	if err != nil {
+		return lexRune{}, err
+	}
+	lr := lexRune{r: r, line: lx.line, col: lx.col}
+	if r == '\n' {
+		lx.line++
+		lx.col = 1
+	} else {
+		lx.col++
+	}
+	return lr, nil
+}
+
+func (lx *lexer) unread(lr lexRune) {
+	lx.pending = append(lx.pending, lr)
+}
+
+// peek returns the next rune without consuming it; ok is false at EOF
+func (lx *lexer) peek() (rune, bool, error) {
+	lr, err := lx.read()
+	if errors.Is(err, io.EOF) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	lx.unread(lr)
+	return lr.r, true, nil
+}
+
+// readWhile consumes runes matching keep into b
+func (lx *lexer) readWhile(b *strings.Builder, keep func(rune) bool) error {
+	for {
+		lr, err := lx.read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !keep(lr.r) {
+			lx.unread(lr)
+			return nil
+		}
+		b.WriteRune(lr.r)
+	}
+}
+
+func (lx *lexer) next() (Token, error) {
+	first, err := lx.read()
+	if err != nil {
+		return Token{}, err
+	}
+
+	tok := Token{Line: first.line, Col: first.col}
+	var b strings.Builder
+	b.WriteRune(first.r)
+
+	switch r := first.r; {
+	case unicode.IsSpace(r):
+		tok.Kind = TokenWhitespace
+		err = lx.readWhile(&b, unicode.IsSpace)
+
+	case r == '_' || unicode.IsLetter(r):
+		tok.Kind = TokenIdent
+		err = lx.readWhile(&b, func(r rune) bool {
+			return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+		})
+
+	case isDecimalDigit(r):
+		tok.Kind = TokenNumber
+		err = lx.readNumber(&b)
+
+	case r == '"':
+		tok.Kind = TokenString
+		b.Reset()
+		err = lx.readString(&b, first)
+
+	case unicode.IsPunct(r) || unicode.IsSymbol(r):
+		tok.Kind = TokenOperator
+		err = lx.readOperator(&b, r)
+
+	default:
+		return Token{}, &TokenizeError{Line: first.line, Col: first.col,
+			Msg: fmt.Sprintf("unexpected character %q", r)}
+	}
+
+	if err != nil {
+		return Token{}, err
+	}
+	tok.Value = b.String()
+	return tok, nil
+}
+
+func isDecimalDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// readNumber consumes the rest of an integer and an optional fraction
+func (lx *lexer) readNumber(b *strings.Builder) error {
+	if err := lx.readWhile(b, isDecimalDigit); err != nil {
+		return err
+	}
+
+	dot, err := lx.read()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if dot.r != '.' {
+		lx.unread(dot)
+		return nil
+	}
+
+	next, ok, err := lx.peek()
+	if err != nil {
+		return err
+	}
+	if !ok || !isDecimalDigit(next) {
+		// Leave the dot for the operator scanner, e.g. "3.String"
+		lx.unread(dot)
+		return nil
+	}
+	b.WriteRune('.')
+	return lx.readWhile(b, isDecimalDigit)
+}
+
+// readString consumes a double-quoted string, decoding escapes. An
+// unterminated string is reported at the position of its opening quote.
+func (lx *lexer) readString(b *strings.Builder, open lexRune) error {
+	unterminated := &TokenizeError{Line: open.line, Col: open.col, Msg: "unterminated string"}
+	for {
+		lr, err := lx.read()
+		if errors.Is(err, io.EOF) {
+			return unterminated
+		}
+		if err != nil {
+			return err
+		}
+
+		switch lr.r {
+		case '"':
+			return nil
+		case '\n':
+			return unterminated
+		case '\\':
+			esc, err := lx.read()
+			if errors.Is(err, io.EOF) {
+				return unterminated
+			}
+			if err != nil {
+				return err
+			}
+			decoded, ok := stringEscapes[esc.r]
+			if !ok {
+				return &TokenizeError{Line: lr.line, Col: lr.col,
+					Msg: fmt.Sprintf("unknown escape sequence \\%c", esc.r)}
+			}
+			b.WriteRune(decoded)
+		default:
+			b.WriteRune(lr.r)
+		}
+	}
+}
+
+// readOperator extends a single-rune operator to a two-rune one when the
+// pair is a known operator
+func (lx *lexer) readOperator(b *strings.Builder, first rune) error {
+	second, ok, err := lx.peek()
+	if err != nil || !ok {
+		return err
+	}
+	if twoCharOperators[string([]rune{first, second})] {
+		lx.read()
+		b.WriteRune(second)
+	}
+	return nil
+}
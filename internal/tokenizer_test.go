@@ -0,0 +1,60 @@
+// tokenizer_test.go
+package internal
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// tokenSummary renders tokens without whitespace, for compact comparison
+func tokenSummary(tokens []Token) string {
+	var parts []string
+	for _, tok := range tokens {
+		if tok.Kind != TokenWhitespace {
+			parts = append(parts, tok.String())
+		}
+	}
+	return strings.Join(parts, " | ")
+}
+
+func TestTokenizeNumbersAndOperators(t *testing.T) {
+	tokens, err := Tokenize(strings.NewReader("rate := 3.14 * x2\nn >= 42."))
+	testutil.AssertNoError(t, err)
+	want := `1:1 Ident "rate" | 1:6 Operator ":=" | 1:9 Number "3.14" | 1:14 Operator "*" | 1:16 Ident "x2" | ` +
+		`2:1 Ident "n" | 2:3 Operator ">=" | 2:6 Number "42" | 2:8 Operator "."`
+	testutil.AssertEqual(t, tokenSummary(tokens), want)
+	testutil.AssertEqual(t, tokens[1].Kind, TokenWhitespace)
+}
+
+func TestTokenizeStringEscapes(t *testing.T) {
+	tokens, err := Tokenize(strings.NewReader(`"naïve \"quote\"\tend" ok`))
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, tokens[0].Kind, TokenString)
+	testutil.AssertEqual(t, tokens[0].Value, "naïve \"quote\"\tend")
+	// columns count runes, so the multi-byte ï does not shift later tokens
+	testutil.AssertEqual(t, tokens[2].Col, 24)
+}
+
+func TestTokenizeErrors(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"x = 1\ny = \"open", "line 2, column 5: unterminated string"},
+		{"\"broken\nline\"", "line 1, column 1: unterminated string"},
+		{`"bad \q"`, `line 1, column 6: unknown escape sequence \q`},
+	}
+	for _, tt := range tests {
+		_, err := Tokenize(strings.NewReader(tt.in))
+		var tokErr *TokenizeError
+		testutil.AssertEqual(t, errors.As(err, &tokErr), true)
+		testutil.AssertEqual(t, err.Error(), tt.want)
+	}
+}
+
+func TestTokenKindString(t *testing.T) {
+	testutil.AssertEqual(t, TokenOperator.String(), "Operator")
+	testutil.AssertEqual(t, TokenKind(9).String(), "TokenKind(9)")
+}
@@ -0,0 +1,46 @@
+// trace_logger.go
+package internal
+
+import "context"
+
+const (
+	traceIDKey contextKey = "traceID"
+	loggerKey  contextKey = "logger"
+)
+
+// defaultLogger is used by LoggerFromContext when ctx carries no logger
+var defaultLogger = NewLogger("APP")
+
+// WithTrace returns a context carrying traceID for request correlation
+func WithTrace(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDKey, traceID)
+}
+
+// TraceID returns the trace ID stored by WithTrace, or "" if there is none
+func TraceID(ctx context.Context) string {
+	id, _ := ctx.Value(traceIDKey).(string)
+	return id
+}
+
+// WithLogger returns a context whose LoggerFromContext builds on l
+func WithLogger(ctx context.Context, l *Logger) context.Context {
+	return context.WithValue(ctx, loggerKey, l)
+}
+
+// LoggerFromContext returns the logger stored by WithLogger (or the default
+// logger) set up to tag every line with ctx's trace ID. Without a trace ID
+// the base logger is returned unchanged.
+func LoggerFromContext(ctx context.Context) *Logger {
+	base, ok := ctx.Value(loggerKey).(*Logger)
+	if !ok || base == nil {
+		base = defaultLogger
+	}
+
+	id := TraceID(ctx)
+	if id == "" {
+		return base
+	}
+	traced := *base
+	traced.trace = id
+	return &traced
+}
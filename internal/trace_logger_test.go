@@ -0,0 +1,53 @@
+// trace_logger_test.go
+package internal
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func TestLoggerFromContextTagsTraceID(t *testing.T) {
+	var out strings.Builder
+	base := NewLogger("ORDERS")
+	base.SetOutput(&out)
+
+	ctx := WithTrace(WithLogger(context.Background(), base), "req-42")
+	testutil.AssertEqual(t, TraceID(ctx), "req-42")
+
+	logger := LoggerFromContext(ctx)
+	logger.Log("received")
+	logger.Log("shipped")
+	base.Log("untraced") // the base logger itself is not modified
+
+	testutil.AssertEqual(t, out.String(),
+		"[ORDERS] [trace=req-42] received\n"+
+			"[ORDERS] [trace=req-42] shipped\n"+
+			"[ORDERS] untraced\n")
+}
+
+func TestLoggerFromContextDefaults(t *testing.T) {
+	testutil.AssertEqual(t, TraceID(context.Background()), "")
+	testutil.AssertEqual(t, LoggerFromContext(context.Background()), defaultLogger)
+
+	traced := LoggerFromContext(WithTrace(context.Background(), "abc"))
+	testutil.AssertEqual(t, traced.tag(), "[APP] [trace=abc]")
+}
+
+func TestServiceLogsCarryTraceID(t *testing.T) {
+	var out strings.Builder
+	base := NewLogger("DB")
+	base.SetOutput(&out)
+	ctx := WithTrace(WithLogger(context.Background(), base), "t-1")
+
+	_, err := (&DatabaseService{}).GetUser(ctx, "u1")
+	testutil.AssertNoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	testutil.AssertEqual(t, len(lines), 2)
+	for _, line := range lines {
+		testutil.AssertContains(t, line, "[trace=t-1]")
+	}
+}
@@ -0,0 +1,86 @@
+// tree.go
+package internal
+
+// TreeNode is one node of a Tree: a value and its ordered children
+type TreeNode[T any] struct {
+	Value    T
+	Children []*TreeNode[T]
+}
+
+// AddChild appends a child holding value and returns it, so deeper levels
+// can be built from the result
+func (n *TreeNode[T]) AddChild(value T) *TreeNode[T] {
+	child := &TreeNode[T]{Value: value}
+	n.Children = append(n.Children, child)
+	return child
+}
+
+// Tree is a rooted tree of T values. Each traversal calls visit with a
+// value and its depth (the root is depth 0) and stops as soon as visit
+// returns false.
+type Tree[T any] struct {
+	Root *TreeNode[T]
+}
+
+// NewTree creates a tree holding a single root value
+func NewTree[T any](root T) *Tree[T] {
+	return &Tree[T]{Root: &TreeNode[T]{Value: root}}
+}
+
+// PreOrder visits each node before its children
+func (t *Tree[T]) PreOrder(visit func(value T, depth int) bool) {
+	var walk func(n *TreeNode[T], depth int) bool
+	walk = func(n *TreeNode[T], depth int) bool {
+		if !visit(n.Value, depth) {
+			return false
+		}
+		for _, child := range n.Children {
+			if !walk(child, depth+1) {
+				return false
+			}
+		}
+		return true
+	}
+	if t.Root != nil {
+		walk(t.Root, 0)
+	}
+}
+
+// PostOrder visits each node after all of its children
+func (t *Tree[T]) PostOrder(visit func(value T, depth int) bool) {
+	var walk func(n *TreeNode[T], depth int) bool
+	walk = func(n *TreeNode[T], depth int) bool {
+		for _, child := range n.Children {
+			if !walk(child, depth+1) {
+				return false
+			}
+		}
+		return visit(n.Value, depth)
+	}
+	if t.Root != nil {
+		walk(t.Root, 0)
+	}
+}
+
+// LevelOrder visits the nodes breadth-first: the root, then every node at
+// depth 1 from left to right, and so on
+func (t *Tree[T]) LevelOrder(visit func(value T, depth int) bool) {
+	if t.Root == nil {
+		return
+	}
+	type queued struct {
+		node  *TreeNode[T]
+		depth int
+	}
+	queue := []queued{{t.Root, 0}}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		if !visit(next.node.Value, next.depth) {
+			return
+		}
+		for _, child := range next.node.Children {
+			queue = append(queue, queued{child, next.depth + 1})
+		}
+	}
+}
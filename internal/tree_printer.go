@@ -0,0 +1,91 @@
+// tree_printer.go
+package internal
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// TreeOptions tunes PrintTree
+type TreeOptions struct {
+	MaxDepth   int      // levels below root to show; 0 means no limit
+	Extensions []string // when set, only files with these extensions (".go" or "go") are listed
+}
+
+// PrintTree renders the directory tree under root in the style of the
+// tree command, with ├──, └── and │ connectors, directories in blue and
+// files in the default color, followed by a count of what was shown.
+// Entries are sorted by name. Unreadable directories are reported inline
+// rather than aborting the listing.
+func PrintTree(w io.Writer, root string, opts TreeOptions) {
+	extensions := make(map[string]bool, len(opts.Extensions))
+	for _, ext := range opts.Extensions {
+		extensions[strings.ToLower(strings.TrimPrefix(ext, "."))] = true
+	}
+
+	t := &treePrinter{w: w, opts: opts, extensions: extensions}
+	fmt.Fprintln(w, Blue(root))
+	t.walk(root, "", 1)
+	fmt.Fprintf(w, "\n%d %s, %d %s\n", t.dirs, plural(t.dirs, "directory", "directories"),
+		t.files, plural(t.files, "file", "files"))
+}
+
+type treePrinter struct {
+	w          io.Writer
+	opts       TreeOptions
+	extensions map[string]bool
+	dirs       int
+	files      int
+}
+
+// walk prints dir's entries; prefix carries the │ columns of the ancestors
+func (t *treePrinter) walk(dir, prefix string, depth int) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		fmt.Fprintf(t.w, "%s└── %s\n", prefix, Red(fmt.Sprintf("[error: %v]", err)))
+		return
+	}
+
+	var shown []os.DirEntry
+	for _, entry := range entries {
+		if entry.IsDir() || t.keepFile(entry.Name()) {
+			shown = append(shown, entry)
+		}
+	}
+
+	for i, entry := range shown {
+		connector, childPrefix := "├── ", prefix+"│   "
+		if i == len(shown)-1 {
+			connector, childPrefix = "└── ", prefix+"    "
+		}
+
+		if !entry.IsDir() {
+			t.files++
+			fmt.Fprintf(t.w, "%s%s%s\n", prefix, connector, entry.Name())
+			continue
+		}
+		t.dirs++
+		fmt.Fprintf(t.w, "%s%s%s\n", prefix, connector, Blue(entry.Name()))
+		if t.opts.MaxDepth == 0 || depth < t.opts.MaxDepth {
+			t.walk(filepath.Join(dir, entry.Name()), childPrefix, depth+1)
+		}
+	}
+}
+
+func (t *treePrinter) keepFile(name string) bool {
+	if len(t.extensions) == 0 {
+		return true
+	}
+	return t.extensions[strings.ToLower(strings.TrimPrefix(filepath.Ext(name), "."))]
+}
+
+// plural picks the singular or plural word for n
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
@@ -0,0 +1,78 @@
+// tree_printer_test.go
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// makeTree creates the given files (and their directories) under a temp root
+func makeTree(t *testing.T, files ...string) string {
+	t.Helper()
+	root := t.TempDir()
+	for _, name := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		testutil.AssertNoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		testutil.AssertNoError(t, os.WriteFile(path, nil, 0o644))
+	}
+	return root
+}
+
+// printTree renders root with colors off, dropping the root line itself
+func printTree(t *testing.T, root string, opts TreeOptions) string {
+	t.Helper()
+	withColorMode(t, ColorNever)
+	var b strings.Builder
+	PrintTree(&b, root, opts)
+	_, body, _ := strings.Cut(b.String(), "\n")
+	return body
+}
+
+func TestPrintTreeConnectors(t *testing.T) {
+	root := makeTree(t, "README.md", "cmd/main.go", "internal/a.go", "internal/b.go", "internal/sub/c.go")
+	want := "" +
+		"├── README.md\n" +
+		"├── cmd\n" +
+		"│   └── main.go\n" +
+		"└── internal\n" +
+		"    ├── a.go\n" +
+		"    ├── b.go\n" +
+		"    └── sub\n" +
+		"        └── c.go\n" +
+		"\n3 directories, 5 files\n"
+	testutil.AssertEqual(t, printTree(t, root, TreeOptions{}), want)
+}
+
+func TestPrintTreeMaxDepthAndExtensions(t *testing.T) {
+	root := makeTree(t, "notes.txt", "x.go", "pkg/y.go", "pkg/deep/z.go")
+
+	got := printTree(t, root, TreeOptions{MaxDepth: 2, Extensions: []string{"GO"}})
+	testutil.AssertEqual(t, got, ""+
+		"├── pkg\n"+
+		"│   ├── deep\n"+
+		"│   └── y.go\n"+
+		"└── x.go\n"+
+		"\n2 directories, 2 files\n")
+
+	got = printTree(t, root, TreeOptions{MaxDepth: 1, Extensions: []string{".txt"}})
+	testutil.AssertEqual(t, got, "├── notes.txt\n└── pkg\n\n1 directory, 1 file\n")
+}
+
+func TestPrintTreeColorsDirectories(t *testing.T) {
+	root := makeTree(t, "dir/file.txt")
+	withColorMode(t, ColorAlways)
+	var b strings.Builder
+	PrintTree(&b, root, TreeOptions{})
+	testutil.AssertContains(t, b.String(), "└── "+Blue("dir")+"\n")
+	testutil.AssertContains(t, b.String(), "    └── file.txt\n")
+}
+
+func TestPrintTreeUnreadableRoot(t *testing.T) {
+	got := printTree(t, filepath.Join(t.TempDir(), "missing"), TreeOptions{})
+	testutil.AssertContains(t, got, "└── [error: ")
+	testutil.AssertContains(t, got, "0 directories, 0 files")
+}
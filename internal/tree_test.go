@@ -0,0 +1,63 @@
+// tree_test.go
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// sampleTree builds
+//
+//	A
+//	├── B
+//	│   ├── D
+//	│   └── E
+//	└── C
+//	    └── F
+func sampleTree() *Tree[string] {
+	tree := NewTree("A")
+	b := tree.Root.AddChild("B")
+	b.AddChild("D")
+	b.AddChild("E")
+	tree.Root.AddChild("C").AddChild("F")
+	return tree
+}
+
+// visitOrder records "value:depth" for every node visited, stopping after
+// limit visits when limit is positive
+func visitOrder(traverse func(func(string, int) bool), limit int) string {
+	var visited []string
+	traverse(func(value string, depth int) bool {
+		visited = append(visited, fmt.Sprintf("%s:%d", value, depth))
+		return limit <= 0 || len(visited) < limit
+	})
+	return strings.Join(visited, " ")
+}
+
+func TestTreeTraversalOrders(t *testing.T) {
+	tree := sampleTree()
+	testutil.AssertEqual(t, visitOrder(tree.PreOrder, 0), "A:0 B:1 D:2 E:2 C:1 F:2")
+	testutil.AssertEqual(t, visitOrder(tree.PostOrder, 0), "D:2 E:2 B:1 F:2 C:1 A:0")
+	testutil.AssertEqual(t, visitOrder(tree.LevelOrder, 0), "A:0 B:1 C:1 D:2 E:2 F:2")
+}
+
+func TestTreeTraversalStopsEarly(t *testing.T) {
+	tree := sampleTree()
+	testutil.AssertEqual(t, visitOrder(tree.PreOrder, 3), "A:0 B:1 D:2")
+	testutil.AssertEqual(t, visitOrder(tree.PostOrder, 3), "D:2 E:2 B:1")
+	testutil.AssertEqual(t, visitOrder(tree.LevelOrder, 2), "A:0 B:1")
+	testutil.AssertEqual(t, visitOrder(tree.PreOrder, 1), "A:0")
+}
+
+func TestTreeEmptyAndSingle(t *testing.T) {
+	empty := &Tree[string]{}
+	testutil.AssertEqual(t, visitOrder(empty.PreOrder, 0), "")
+	testutil.AssertEqual(t, visitOrder(empty.PostOrder, 0), "")
+	testutil.AssertEqual(t, visitOrder(empty.LevelOrder, 0), "")
+
+	single := NewTree("only")
+	testutil.AssertEqual(t, visitOrder(single.LevelOrder, 0), "only:0")
+}
@@ -0,0 +1,118 @@
+// ttl_cache.go
+package internal
+
+import (
+	"sync"
+	"time"
+)
+
+// defaultSweepInterval replaces a sweep interval that is zero or negative
+const defaultSweepInterval = time.Minute
+
+// TTLCache is a goroutine-safe cache whose entries expire after a per-entry
+// TTL. A background sweeper evicts expired entries on a fixed interval until
+// Close is called.
+type TTLCache[K comparable, V any] struct {
+	mu      sync.Mutex
+	entries map[K]ttlEntry[V]
+	clock   Clock
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+type ttlEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// NewTTLCache creates a cache and starts its sweeper goroutine. A nil clock
+// uses the system clock; a sweepInterval <= 0, which would make the
+// sweeper spin, means defaultSweepInterval.
+func NewTTLCache[K comparable, V any](sweepInterval time.Duration, clock Clock) *TTLCache[K, V] {
+	if sweepInterval <= 0 {
+		sweepInterval = defaultSweepInterval
+	}
+	c := &TTLCache[K, V]{
+		entries: make(map[K]ttlEntry[V]),
+		clock:   clockOrSystem(clock),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go c.sweepLoop(sweepInterval)
+	return c
+}
+
+// Set stores a value that expires after ttl
+func (c *TTLCache[K, V]) Set(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = ttlEntry[V]{value: value, expiresAt: c.clock.Now().Add(ttl)}
+}
+
+// Get returns the value for key if it exists and has not expired
+func (c *TTLCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+	entry, ok := c.entries[key]
+	if !ok {
+		return zero, false
+	}
+	if !c.clock.Now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// Len returns the number of entries that have not expired
+func (c *TTLCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now()
+	count := 0
+	for _, entry := range c.entries {
+		if now.Before(entry.expiresAt) {
+			count++
+		}
+	}
+	return count
+}
+
+// Close stops the sweeper and waits for it to exit. It is safe to call more
+// than once.
+func (c *TTLCache[K, V]) Close() {
+	c.closeOnce.Do(func() {
+		close(c.stop)
+	})
+	<-c.done
+}
+
+func (c *TTLCache[K, V]) sweepLoop(interval time.Duration) {
+	defer close(c.done)
+	for {
+		select {
+		case <-c.clock.After(interval):
+			c.sweep()
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+// sweep removes every expired entry
+func (c *TTLCache[K, V]) sweep() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now()
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+}
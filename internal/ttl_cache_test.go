@@ -0,0 +1,90 @@
+// ttl_cache_test.go
+package internal
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+// waitForWaiters blocks until the sweeper is parked on the manual clock
+func waitForWaiters(t *testing.T, clock *ManualClock, n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for clock.Waiters() < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("sweeper never waited on the clock")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestTTLCacheExpiry(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	cache := NewTTLCache[string, int](time.Minute, clock)
+	defer cache.Close()
+
+	cache.Set("a", 1, time.Second)
+	cache.Set("b", 2, time.Hour)
+	v, ok := cache.Get("a")
+	testutil.AssertEqual(t, ok, true)
+	testutil.AssertEqual(t, v, 1)
+	testutil.AssertEqual(t, cache.Len(), 2)
+
+	clock.Advance(time.Second)
+	_, ok = cache.Get("a")
+	testutil.AssertEqual(t, ok, false)
+	testutil.AssertEqual(t, cache.Len(), 1)
+}
+
+func TestTTLCacheSweeps(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	cache := NewTTLCache[string, int](time.Minute, clock)
+	defer cache.Close()
+	cache.Set("a", 1, time.Second)
+
+	waitForWaiters(t, clock, 1)
+	clock.Advance(time.Minute)
+	waitForWaiters(t, clock, 1) // the sweep ran and the sweeper re-armed
+
+	cache.mu.Lock()
+	remaining := len(cache.entries)
+	cache.mu.Unlock()
+	testutil.AssertEqual(t, remaining, 0)
+}
+
+func TestTTLCacheNonPositiveInterval(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		cache := NewTTLCache[string, int](interval, clock)
+		waitForWaiters(t, clock, 1) // parked, not spinning on a zero delay
+		cache.Close()
+	}
+}
+
+func TestTTLCacheCloseStopsSweeper(t *testing.T) {
+	before := runtime.NumGoroutine()
+	caches := make([]*TTLCache[string, int], 10)
+	for i := range caches {
+		caches[i] = NewTTLCache[string, int](time.Hour, nil)
+	}
+	if running := runtime.NumGoroutine(); running < before+len(caches) {
+		t.Fatalf("%d goroutines after starting %d caches, want at least %d", running, len(caches), before+len(caches))
+	}
+
+	for _, cache := range caches {
+		cache.Close()
+		cache.Close() // safe to repeat
+	}
+	// Close waits for the sweeper to signal done; give the runtime a moment
+	// to retire the goroutines after that
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if running := runtime.NumGoroutine(); running > before {
+		t.Errorf("%d goroutines after Close, want at most %d", running, before)
+	}
+}
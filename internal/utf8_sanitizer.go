@@ -0,0 +1,88 @@
+// utf8_sanitizer.go
+package internal
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// replacementChar is U+FFFD encoded as UTF-8
+const replacementChar = "�"
+
+// UTF8SanitizingReader passes through valid UTF-8 from the wrapped reader
+// and replaces each invalid byte with U+FFFD. A multibyte character split
+// across two reads of the underlying reader is held back until it is
+// complete, so splitting never introduces replacements of its own.
+type UTF8SanitizingReader struct {
+	r       io.Reader
+	partial []byte // trailing bytes of an incomplete character
+	out     []byte // sanitized bytes not yet returned
+	buf     []byte
+	err     error // sticky error from r, reported once out is drained
+}
+
+// NewUTF8SanitizingReader wraps r
+func NewUTF8SanitizingReader(r io.Reader) *UTF8SanitizingReader {
+	return &UTF8SanitizingReader{r: r, buf: make([]byte, 4096)}
+}
+
+func (s *UTF8SanitizingReader) Read(p []byte) (int, error) {
+	for len(s.out) == 0 {
+		if s.err != nil {
+			return 0, s.err
+		}
+
+		n, err := s.r.Read(s.buf)
+		data := append(s.partial, s.buf[:n]...)
+		s.partial = nil
+		if err != nil {
+			s.err = err
+		}
+		s.sanitize(data, errors.Is(err, io.EOF))
+	}
+
+	n := copy(p, s.out)
+	s.out = s.out[n:]
+	return n, nil
+}
+
+// sanitize appends data to s.out, keeping an incomplete final character in
+// s.partial unless the input has ended
+func (s *UTF8SanitizingReader) sanitize(data []byte, atEOF bool) {
+	for len(data) > 0 {
+		if !atEOF && !utf8.FullRune(data) {
+			s.partial = append(s.partial[:0], data...)
+			return
+		}
+		r, size := utf8.DecodeRune(data)
+		if r == utf8.RuneError && size == 1 {
+			s.out = append(s.out, replacementChar...)
+		} else {
+			s.out = append(s.out, data[:size]...)
+		}
+		data = data[size:]
+	}
+}
+
+// SanitizeUTF8 returns s with each invalid byte replaced by U+FFFD, the
+// same replacement UTF8SanitizingReader applies to a stream
+func SanitizeUTF8(s string) string {
+	if utf8.ValidString(s) {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i, r := range s {
+		if r == utf8.RuneError {
+			if _, size := utf8.DecodeRuneInString(s[i:]); size == 1 {
+				b.WriteString(replacementChar)
+				continue
+			}
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,49 @@
+// utf8_sanitizer_test.go
+package internal
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func sanitizeStream(t *testing.T, r io.Reader) string {
+	t.Helper()
+	data, err := io.ReadAll(NewUTF8SanitizingReader(r))
+	testutil.AssertNoError(t, err)
+	return string(data)
+}
+
+func TestUTF8SanitizingReaderOneByteAtATime(t *testing.T) {
+	// "€" is E2 82 AC; a lone E2 82 before "A" is invalid and straddles reads
+	input := "h€llo \xe2\x82A ok \xff日本"
+	got := sanitizeStream(t, iotest.OneByteReader(strings.NewReader(input)))
+	testutil.AssertEqual(t, got, "h€llo ��A ok �日本")
+	testutil.AssertEqual(t, got, SanitizeUTF8(input))
+}
+
+func TestUTF8SanitizingReaderValidPassthrough(t *testing.T) {
+	input := strings.Repeat("héllo wörld 日本語 🎉 ", 500) // spans several internal reads
+	testutil.AssertEqual(t, sanitizeStream(t, iotest.HalfReader(strings.NewReader(input))), input)
+}
+
+func TestUTF8SanitizingReaderTruncatedAtEOF(t *testing.T) {
+	got := sanitizeStream(t, iotest.OneByteReader(strings.NewReader("ab\xe6\x97")))
+	testutil.AssertEqual(t, got, "ab��")
+}
+
+func TestUTF8SanitizingReaderPropagatesErrors(t *testing.T) {
+	r := io.MultiReader(strings.NewReader("ok"), iotest.ErrReader(io.ErrUnexpectedEOF))
+	data, err := io.ReadAll(NewUTF8SanitizingReader(r))
+	testutil.AssertEqual(t, string(data), "ok")
+	testutil.AssertErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestSanitizeUTF8(t *testing.T) {
+	testutil.AssertEqual(t, SanitizeUTF8("plain"), "plain")
+	testutil.AssertEqual(t, SanitizeUTF8("a\x80b\xc3"), "a�b�")
+	testutil.AssertEqual(t, SanitizeUTF8("keeps real �"), "keeps real �")
+}
@@ -0,0 +1,97 @@
+// walk_fields.go
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrSkipField can be returned by a WalkFields callback to visit a field
+// without descending into it, like fs.SkipDir for directory walks
+var ErrSkipField = errors.New("skip this field")
+
+// WalkFields calls fn for every exported field of the struct v (or the
+// struct a pointer v points to), depth first, then descends into fields
+// holding structs, struct pointers, and slices or arrays of them. path is
+// the dotted Go path from the root, with indexes for elements:
+// "Database.Host", "Servers[1].Port". Nil pointers are visited but not
+// descended into, and a pointer already on the walk is not followed again,
+// so cyclic structures terminate. An error from fn stops the walk and is
+// returned, except ErrSkipField, which only prunes that field.
+func WalkFields(v interface{}, fn func(path string, field reflect.StructField, value reflect.Value) error) error {
+	w := fieldWalker{fn: fn, seen: make(map[pointerKey]bool)}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		w.seen[pointerKeyOf(rv)] = true
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("WalkFields needs a struct or struct pointer, got %T", v)
+	}
+	return w.walkStruct("", rv)
+}
+
+type fieldWalker struct {
+	fn   func(path string, field reflect.StructField, value reflect.Value) error
+	seen map[pointerKey]bool
+}
+
+func (w *fieldWalker) walkStruct(prefix string, v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		path := field.Name
+		if prefix != "" {
+			path = prefix + "." + field.Name
+		}
+
+		value := v.Field(i)
+		if err := w.fn(path, field, value); err != nil {
+			if errors.Is(err, ErrSkipField) {
+				continue
+			}
+			return err
+		}
+		if err := w.descend(path, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// descend walks into value if it holds further struct fields
+func (w *fieldWalker) descend(path string, value reflect.Value) error {
+	switch value.Kind() {
+	case reflect.Struct:
+		return w.walkStruct(path, value)
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		if value.Kind() == reflect.Ptr {
+			key := pointerKeyOf(value)
+			if w.seen[key] {
+				return nil
+			}
+			w.seen[key] = true
+			defer delete(w.seen, key) // shared, non-cyclic pointers are walked each time
+		}
+		return w.descend(path, value.Elem())
+	case reflect.Slice, reflect.Array:
+		switch value.Type().Elem().Kind() {
+		case reflect.Struct, reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Array:
+		default:
+			return nil // elements cannot hold struct fields
+		}
+		for i := 0; i < value.Len(); i++ {
+			if err := w.descend(fmt.Sprintf("%s[%d]", path, i), value.Index(i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// walk_fields_test.go
+package internal
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/amirk1998/GoEdge-Base-to-Mastery/internal/testutil"
+)
+
+func collectPaths(t *testing.T, v interface{}, skip string) []string {
+	t.Helper()
+	var paths []string
+	err := WalkFields(v, func(path string, field reflect.StructField, value reflect.Value) error {
+		paths = append(paths, path)
+		if field.Name == skip {
+			return ErrSkipField
+		}
+		return nil
+	})
+	testutil.AssertNoError(t, err)
+	return paths
+}
+
+func TestWalkFieldsJSONConfigPaths(t *testing.T) {
+	config := JSONConfig{
+		Servers: []ServerConfig{{Name: "web-1"}, {Name: "web-2"}},
+	}
+	want := []string{
+		"AppName", "Version", "Debug",
+		"Database", "Database.Host", "Database.Port", "Database.Username", "Database.Password", "Database.SSL",
+		"Features",
+		"Servers",
+		"Servers[0].Name", "Servers[0].Host", "Servers[0].Port", "Servers[0].Weight",
+		"Servers[1].Name", "Servers[1].Host", "Servers[1].Port", "Servers[1].Weight",
+		"Metadata",
+	}
+
+	testutil.AssertEqual(t, strings.Join(collectPaths(t, config, ""), " "), strings.Join(want, " "))
+	// a pointer to the struct walks the same fields
+	testutil.AssertEqual(t, strings.Join(collectPaths(t, &config, ""), " "), strings.Join(want, " "))
+}
+
+func TestWalkFieldsSkipNilAndCycles(t *testing.T) {
+	config := JSONConfig{Servers: []ServerConfig{{Name: "web-1"}}}
+	paths := collectPaths(t, config, "Servers")
+	testutil.AssertEqual(t, strings.Contains(strings.Join(paths, " "), "Servers[0]"), false)
+	testutil.AssertEqual(t, paths[len(paths)-1], "Metadata")
+
+	root := &treeNode{Name: "root"}
+	child := &treeNode{Name: "child", Parent: root}
+	root.Children = []*treeNode{child}
+	testutil.AssertEqual(t, strings.Join(collectPaths(t, root, ""), " "),
+		"Name Parent Children Children[0].Name Children[0].Parent Children[0].Children")
+
+	leaf := treeNode{Name: "leaf"} // nil Parent, nil Children
+	testutil.AssertEqual(t, strings.Join(collectPaths(t, leaf, ""), " "), "Name Parent Children")
+}
+
+func TestWalkFieldsErrors(t *testing.T) {
+	errStop := errors.New("stop")
+	visited := 0
+	err := WalkFields(JSONConfig{}, func(path string, field reflect.StructField, value reflect.Value) error {
+		visited++
+		if path == "Database.Port" {
+			return errStop
+		}
+		return nil
+	})
+	testutil.AssertErrorIs(t, err, errStop)
+	testutil.AssertEqual(t, visited, 6)
+
+	err = WalkFields(42, func(string, reflect.StructField, reflect.Value) error { return nil })
+	if err == nil {
+		t.Fatal("WalkFields accepted a non-struct")
+	}
+	testutil.AssertContains(t, err.Error(), "got int")
+}